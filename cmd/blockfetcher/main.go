@@ -5,30 +5,62 @@ import (
 	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"flag"
 	"fmt"
 	"log"
 	"log/slog"
 	"os"
 	"os/signal"
+	"strings"
+	"sync"
 	"syscall"
+	"time"
 
 	ouroboros "github.com/blinklabs-io/gouroboros"
 	"github.com/blinklabs-io/gouroboros/ledger"
 	"github.com/blinklabs-io/gouroboros/protocol/chainsync"
 	"github.com/blinklabs-io/gouroboros/protocol/common"
+	"github.com/blinklabs-io/gouroboros/protocol/localstatequery"
+	"github.com/no-witness-labs/firehose-cardano/convert"
+	"github.com/no-witness-labs/firehose-cardano/epoch"
+	"github.com/no-witness-labs/firehose-cardano/era"
+	"github.com/no-witness-labs/firehose-cardano/ledgerstate"
+	"github.com/no-witness-labs/firehose-cardano/mempool"
+	"github.com/no-witness-labs/firehose-cardano/pparams"
+	"github.com/no-witness-labs/firehose-cardano/praos"
+	pbmempool "github.com/no-witness-labs/firehose-cardano/types/pb/sf/cardano/mempool/v1"
+	pbcardano "github.com/no-witness-labs/firehose-cardano/types/pb/sf/cardano/type/v1"
+	"github.com/no-witness-labs/firehose-cardano/utxo"
+	"github.com/no-witness-labs/firehose-cardano/verify"
 	"google.golang.org/protobuf/proto"
 )
 
 type BlockFetcherConfig struct {
-	Address       string
-	SocketPath    string
-	Network       string
-	NetworkMagic  uint32
-	PipelineLimit uint32
-	StartSlot     uint64
-	StartHash     string
-	CursorFile    string
+	Address         string
+	SocketPath      string
+	Network         string
+	NetworkMagic    uint32
+	PipelineLimit   uint32
+	StartSlot       uint64
+	StartHash       string
+	CursorFile      string
+	RawCBOR         bool
+	DecodeMetadata  bool
+	CIP68           bool
+	UTxOStore       string
+	UTxOUndoDepth   uint64
+	PParamsStore    string
+	EpochStore      string
+	LedgerSnapshots bool
+	Mempool         bool
+	MempoolInterval time.Duration
+	VerifyBlocks    bool
+	PraosStore      string
+	PraosStrict     bool
+	ShelleyGenesis  string
+	AlonzoGenesis   string
+	ConwayGenesis   string
 }
 
 type CursorPoint struct {
@@ -52,23 +84,6 @@ func (c *BlockFetcherConfig) setDefaults() {
 	}
 }
 
-type SlotConfig struct {
-	ZeroTime   int64
-	ZeroSlot   uint64
-	SlotLength int64
-}
-
-var SlotConfigNetwork = map[string]SlotConfig{
-	"mainnet": {ZeroTime: 1596059091000, ZeroSlot: 4492800, SlotLength: 1000},
-	"preview": {ZeroTime: 1666656000000, ZeroSlot: 0, SlotLength: 1000},
-	"preprod": {ZeroTime: 1654041600000 + 1728000000, ZeroSlot: 86400, SlotLength: 1000},
-}
-
-func slotToBeginUnixTime(slot uint64, slotConfig SlotConfig) int64 {
-	msAfterBegin := int64(slot-slotConfig.ZeroSlot) * slotConfig.SlotLength
-	return slotConfig.ZeroTime + msAfterBegin
-}
-
 func shouldStoreCursorPoint(currentSlot, baseSlot uint64) bool {
 	if baseSlot == 0 {
 		return true
@@ -133,16 +148,28 @@ func saveCursorState(filename string, points []common.Point) error {
 }
 
 type FirehoseInstrumentation struct {
-	blockTypeURL string
-	logger       *log.Logger
-	slotConfig   SlotConfig
+	blockTypeURL   string
+	logger         *log.Logger
+	eraHistory     *era.History
+	convertOptions []convert.Option
+	utxoStore      *utxo.Store
+	pparamsTracker *pparams.Tracker
+	epochTracker   *epoch.Tracker
+	praosValidator *praos.Validator
+	praosStrict    bool
+	stateQuery     *localstatequery.Client // Set on node-to-client connections
+	snapshots      bool                    // Ledger snapshots are taken with stateQuery
+
+	// Blocks and mempool events are written from different goroutines.
+	outputMu sync.Mutex
 }
 
-func NewFirehoseInstrumentation(blockTypeURL string, logger *log.Logger, slotConfig SlotConfig) *FirehoseInstrumentation {
+func NewFirehoseInstrumentation(blockTypeURL string, logger *log.Logger, eraHistory *era.History, convertOptions ...convert.Option) *FirehoseInstrumentation {
 	return &FirehoseInstrumentation{
-		blockTypeURL: blockTypeURL,
-		logger:       logger,
-		slotConfig:   slotConfig,
+		blockTypeURL:   blockTypeURL,
+		logger:         logger,
+		eraHistory:     eraHistory,
+		convertOptions: convertOptions,
 	}
 }
 
@@ -151,21 +178,35 @@ func (f *FirehoseInstrumentation) Init() {
 }
 
 func (f *FirehoseInstrumentation) OutputBlock(block ledger.Block) error {
+	blockData, err := f.serializeBlock(block)
+	if err != nil {
+		return fmt.Errorf("failed to serialize block: %w", err)
+	}
+
+	fireBlock := f.blockLine(block, blockData)
+	f.outputMu.Lock()
+	defer f.outputMu.Unlock()
+	fmt.Println(fireBlock)
+	return nil
+}
+
+// blockLine returns the FIRE BLOCK line of a block and its serialized
+// payload.
+func (f *FirehoseInstrumentation) blockLine(block ledger.Block, blockData []byte) string {
 	blockNumber := block.BlockNumber()
 	blockHash := block.Hash()
 	parentNumber := blockNumber - 1
+	// An epoch boundary block has the number of the block before it.
+	if _, ok := block.(*ledger.ByronEpochBoundaryBlock); ok {
+		parentNumber = blockNumber
+	}
 	parentHash := block.Header().PrevHash()
 	libNum := blockNumber - 2160
-	timestampMs := slotToBeginUnixTime(block.SlotNumber(), f.slotConfig)
+	timestampMs := f.eraHistory.SlotToUnixMilli(block.SlotNumber())
 	timestamp := timestampMs * 1000000
-
-	blockData, err := f.serializeBlock(block)
-	if err != nil {
-		return fmt.Errorf("failed to serialize block: %w", err)
-	}
 	encodedData := base64.StdEncoding.EncodeToString(blockData)
 
-	fireBlock := fmt.Sprintf(
+	return fmt.Sprintf(
 		"FIRE BLOCK %d %s %d %s %d %d %s",
 		blockNumber,  // Block slot number
 		blockHash,    // Block hash
@@ -175,57 +216,154 @@ func (f *FirehoseInstrumentation) OutputBlock(block ledger.Block) error {
 		timestamp,    // Block timestamp (nanoseconds)
 		encodedData,  // Base64 encoded block payload
 	)
-	fmt.Println(fireBlock)
+}
+
+func (f *FirehoseInstrumentation) OutputMempoolEvent(event *pbmempool.Event) error {
+	line, err := mempool.FormatLine(event)
+	if err != nil {
+		return err
+	}
+
+	f.outputMu.Lock()
+	defer f.outputMu.Unlock()
+	fmt.Println(line)
 	return nil
 }
 
 func (f *FirehoseInstrumentation) serializeBlock(block ledger.Block) ([]byte, error) {
-	utxoBlock, err := block.Utxorpc()
+	cardanoBlock, err := convert.Block(block, f.convertOptions...)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get UTXO RPC: %w", err)
+		return nil, err
+	}
+	if f.utxoStore != nil {
+		if err := f.utxoStore.ApplyBlock(block, cardanoBlock); err != nil {
+			return nil, fmt.Errorf("failed to apply block to UTxO store: %w", err)
+		}
+	}
+	if f.pparamsTracker != nil {
+		if err := f.pparamsTracker.ApplyBlock(cardanoBlock); err != nil {
+			return nil, fmt.Errorf("failed to track protocol parameters: %w", err)
+		}
+	}
+	if f.praosValidator != nil {
+		// The extra entropy of the parameters in force is needed at epoch
+		// transitions, hence after the protocol parameter tracker.
+		err := f.praosValidator.ApplyBlock(block, cardanoBlock.ProtocolParams)
+		var invalid *praos.HeaderError
+		switch {
+		case errors.As(err, &invalid) && !f.praosStrict:
+			f.logger.Printf("Warning: %v", err)
+		case errors.As(err, &invalid):
+			return nil, err
+		case err != nil:
+			return nil, fmt.Errorf("failed to validate Praos header: %w", err)
+		}
+	}
+	if f.epochTracker != nil {
+		if err := f.epochTracker.ApplyBlock(cardanoBlock); err != nil {
+			return nil, fmt.Errorf("failed to track epochs: %w", err)
+		}
 	}
-	data, err := proto.Marshal(utxoBlock)
+	if f.snapshots && f.stateQuery != nil && cardanoBlock.EpochTransition != nil {
+		point := common.NewPoint(block.SlotNumber(), cardanoBlock.Header.Hash)
+		snapshot, err := ledgerstate.Snapshot(f.stateQuery, point, cardanoBlock.EpochTransition.Epoch)
+		switch {
+		case errors.Is(err, localstatequery.ErrAcquireFailurePointTooOld):
+			f.logger.Printf("Skipping ledger snapshot of epoch %d, the node tip is too far ahead", cardanoBlock.EpochTransition.Epoch)
+		case errors.Is(err, ledgerstate.ErrEpochState):
+			f.logger.Printf("Warning: ledger snapshot of epoch %d without treasury, reserves and governance state: %v", cardanoBlock.EpochTransition.Epoch, err)
+			cardanoBlock.LedgerSnapshot = snapshot
+		case err != nil:
+			return nil, fmt.Errorf("failed to take ledger snapshot: %w", err)
+		default:
+			cardanoBlock.LedgerSnapshot = snapshot
+		}
+	}
+	data, err := proto.Marshal(cardanoBlock)
 	if err != nil {
-		return nil, fmt.Errorf("failed to marshal UTXO block: %w", err)
+		return nil, fmt.Errorf("failed to marshal block: %w", err)
 	}
 	return data, nil
 }
 
+// nodeLedger gives the protocol parameter tracker the parameters in force of
+// the local node, when connected to one.
+type nodeLedger struct {
+	firehose *FirehoseInstrumentation
+}
+
+func (l nodeLedger) Params(point common.Point, epoch uint64) (*pbcardano.PParams, error) {
+	if l.firehose.stateQuery == nil {
+		return nil, nil
+	}
+	params, err := ledgerstate.Params(l.firehose.stateQuery, point)
+	if errors.Is(err, localstatequery.ErrAcquireFailurePointTooOld) {
+		l.firehose.logger.Printf("Warning: protocol parameters of epoch %d are unreliable, the node tip is too far ahead to tell the enacted governance actions", epoch)
+		return nil, nil
+	}
+	return params, err
+}
+
 type BlockFetcher struct {
 	config       *BlockFetcherConfig
 	connection   *ouroboros.Connection
 	logger       *log.Logger
 	slogger      *slog.Logger
 	firehose     *FirehoseInstrumentation
-	slotConfig   SlotConfig
+	eraHistory   *era.History
 	cursorPoints []common.Point // Store points using exponential strategy
 	baseSlot     uint64         // Base slot for exponential calculation
+
+	peers        []string   // Node-to-node addresses, switched on failures
+	peer         int        // Index of the current peer
+	failedPeers  int        // Peers in a row that were unreachable or served an invalid block
+	invalidBlock chan error // Verification failure of the current connection
 }
 
 func NewBlockFetcher(cfg *BlockFetcherConfig, logger *log.Logger) *BlockFetcher {
-	slotConfig, exists := SlotConfigNetwork[cfg.Network]
-	if !exists {
-		slotConfig = SlotConfigNetwork["mainnet"]
-		logger.Printf("Warning: Unknown network '%s', defaulting to mainnet slot config", cfg.Network)
+	eraHistory, err := era.ForNetwork(cfg.Network)
+	if err != nil {
+		eraHistory, _ = era.ForNetwork("mainnet")
+		logger.Printf("Warning: Unknown network '%s', defaulting to mainnet era history", cfg.Network)
 	}
 
-	firehose := NewFirehoseInstrumentation("type.googleapis.com/sf.cardano.type.v1.Block", logger, slotConfig)
+	var convertOptions []convert.Option
+	if cfg.RawCBOR {
+		convertOptions = append(convertOptions, convert.WithRawCBOR())
+	}
+	if cfg.DecodeMetadata {
+		convertOptions = append(convertOptions, convert.WithDecodedMetadata())
+	}
+	if cfg.CIP68 {
+		convertOptions = append(convertOptions, convert.WithCIP68())
+	}
+
+	firehose := NewFirehoseInstrumentation("type.googleapis.com/sf.cardano.type.v1.Block", logger, eraHistory, convertOptions...)
 
 	slogger := slog.Default()
 
+	var peers []string
+	for _, address := range strings.Split(cfg.Address, ",") {
+		if address = strings.TrimSpace(address); address != "" {
+			peers = append(peers, address)
+		}
+	}
+
 	return &BlockFetcher{
-		config:     cfg,
-		logger:     logger,
-		slogger:    slogger,
-		firehose:   firehose,
-		slotConfig: slotConfig,
+		config:       cfg,
+		logger:       logger,
+		slogger:      slogger,
+		firehose:     firehose,
+		eraHistory:   eraHistory,
+		peers:        peers,
+		invalidBlock: make(chan error, 1),
 	}
 }
 
 func parseFlags() *BlockFetcherConfig {
 	cfg := &BlockFetcherConfig{}
 
-	flag.StringVar(&cfg.Address, "address", "", "Cardano node address (e.g., backbone.cardano.iog.io:3001)")
+	flag.StringVar(&cfg.Address, "address", "", "Cardano node address (e.g., backbone.cardano.iog.io:3001), or comma-separated addresses switched to when a peer serves an invalid block")
 	flag.StringVar(&cfg.SocketPath, "socket-path", "", "Unix socket path for local node connection")
 	flag.StringVar(&cfg.Network, "network", "mainnet", "Network: mainnet, preview, preprod")
 	flag.StringVar(&cfg.CursorFile, "cursor-file", "", "File to store/read cursor state for resuming (e.g., cursor.json)")
@@ -255,6 +393,22 @@ func parseFlags() *BlockFetcherConfig {
 	})
 	flag.Uint64Var(&cfg.StartSlot, "start-slot", 0, "Starting slot number (0 = current tip)")
 	flag.StringVar(&cfg.StartHash, "start-hash", "", "Starting block hash (empty = use current tip)")
+	flag.BoolVar(&cfg.RawCBOR, "raw-cbor", false, "Embed the original block and transaction CBOR in emitted blocks")
+	flag.BoolVar(&cfg.DecodeMetadata, "decode-metadata", false, "Decode CIP-20, CIP-25 and CIP-36 metadata records into Tx.decoded_metadata")
+	flag.BoolVar(&cfg.CIP68, "cip68", false, "Report CIP-68 tokens and the reference datums they get (Tx.cip68_tokens, Tx.cip68_updates)")
+	flag.StringVar(&cfg.UTxOStore, "utxo-store", "", "Path of the local UTxO database used to resolve transaction inputs (empty = disabled)")
+	flag.Uint64Var(&cfg.UTxOUndoDepth, "utxo-undo-depth", utxo.DefaultUndoDepth, "Number of blocks the UTxO store can roll back")
+	flag.StringVar(&cfg.PParamsStore, "pparams-store", "", "Path of the local database tracking protocol parameters, attached to the first block of each epoch (empty = disabled). Enacted Conway governance actions are taken from the node of -socket-path near its tip; otherwise the parameters are flagged unreliable (Block.protocol_params_unreliable) once such actions were proposed")
+	flag.StringVar(&cfg.ShelleyGenesis, "shelley-genesis", "", "Shelley genesis file of the network, required with -pparams-store and -praos-store")
+	flag.StringVar(&cfg.AlonzoGenesis, "alonzo-genesis", "", "Alonzo genesis file of the network, required with -pparams-store from the Alonzo era")
+	flag.StringVar(&cfg.ConwayGenesis, "conway-genesis", "", "Conway genesis file of the network, required with -pparams-store from the Conway era")
+	flag.StringVar(&cfg.EpochStore, "epoch-store", "", "Path of the local database counting the blocks and transactions of each epoch, marking the first block of each epoch (empty = disabled)")
+	flag.BoolVar(&cfg.Mempool, "mempool", false, "Monitor the mempool of the node and write its transactions as FIRE MEMPOOL lines, requires -socket-path")
+	flag.DurationVar(&cfg.MempoolInterval, "mempool-poll-interval", time.Second, "Interval between two polls of the mempool")
+	flag.BoolVar(&cfg.VerifyBlocks, "verify-blocks", true, "Recompute the body hash of each block and refuse the blocks not matching their header, switching peers")
+	flag.StringVar(&cfg.PraosStore, "praos-store", "", "Path of the local database tracking the nonces and operational certificate counters used to validate block headers: KES signature, operational certificate and VRF proofs (empty = disabled). Up to Alonzo, VRF proofs are only checked with -pparams-store, which gives the extra entropy of the epoch nonces")
+	flag.BoolVar(&cfg.PraosStrict, "praos-strict", false, "Stop the fetcher on an invalid block header instead of logging it, with -praos-store")
+	flag.BoolVar(&cfg.LedgerSnapshots, "ledger-snapshots", false, "Query the ledger state of the node at the first block of each epoch (Block.ledger_snapshot), requires -socket-path and -epoch-store")
 
 	flag.Parse()
 
@@ -275,13 +429,15 @@ func (bf *BlockFetcher) processBlock(block ledger.Block) error {
 	if err := bf.firehose.OutputBlock(block); err != nil {
 		return err
 	}
+	bf.failedPeers = 0
 
-	if bf.config.CursorFile != "" {
-		hashBytes := block.Hash()
-		currentPoint := common.NewPoint(block.SlotNumber(), hashBytes[:])
-
-		bf.addCursorPoint(currentPoint)
+	// The points are kept without cursor file too, to resume from them when
+	// switching peers.
+	hashBytes := block.Hash()
+	currentPoint := common.NewPoint(block.SlotNumber(), hashBytes[:])
+	bf.addCursorPoint(currentPoint)
 
+	if bf.config.CursorFile != "" {
 		if err := saveCursorState(bf.config.CursorFile, bf.cursorPoints); err != nil {
 			bf.logger.Printf("Warning: Failed to save cursor state: %v", err)
 		}
@@ -303,6 +459,11 @@ func (bf *BlockFetcher) resolveNetworkMagic() error {
 }
 
 func (bf *BlockFetcher) getStartPoints() ([]common.Point, error) {
+	if len(bf.cursorPoints) > 0 {
+		bf.logger.Printf("Resuming from emitted blocks (count=%d, latest slot=%d)", len(bf.cursorPoints), bf.cursorPoints[0].Slot)
+		return bf.cursorPoints, nil
+	}
+
 	if bf.config.CursorFile != "" {
 		if cursor, err := loadCursorState(bf.config.CursorFile); err == nil && len(cursor.Points) > 0 {
 			points := make([]common.Point, 0, len(cursor.Points))
@@ -363,23 +524,70 @@ func (bf *BlockFetcher) chainSyncRollForwardHandler(
 		if err != nil {
 			return fmt.Errorf("failed to fetch block: %w", err)
 		}
+		if bf.config.VerifyBlocks {
+			if err := verify.Fetched(v, block); err != nil {
+				return bf.refuseBlock(err)
+			}
+		}
 	default:
 		return fmt.Errorf("unexpected block data type: %T", blockData)
 	}
 
+	if bf.config.VerifyBlocks {
+		if err := verify.Body(block); err != nil {
+			return bf.refuseBlock(err)
+		}
+	}
+
 	if err := bf.processBlock(block); err != nil {
+		var invalid *praos.HeaderError
+		if errors.As(err, &invalid) {
+			return bf.refuseBlock(err)
+		}
 		return fmt.Errorf("failed to process block: %w", err)
 	}
 
 	return nil
 }
 
+// refuseBlock reports a block that failed verification to start, which
+// switches peers on a body mismatch and fails on an invalid header in strict
+// mode, and returns the error, dropping the connection.
+func (bf *BlockFetcher) refuseBlock(err error) error {
+	select {
+	case bf.invalidBlock <- err:
+	default:
+	}
+	return err
+}
+
 func (bf *BlockFetcher) chainSyncRollBackwardHandler(
 	ctx chainsync.CallbackContext,
 	point common.Point,
 	tip chainsync.Tip,
 ) error {
 	bf.logger.Printf("ChainSync roll backward: point = %#v, tip = %#v", point, tip)
+
+	if bf.firehose.utxoStore != nil {
+		if err := bf.firehose.utxoStore.Rollback(point); err != nil {
+			return fmt.Errorf("failed to roll back UTxO store: %w", err)
+		}
+	}
+	if bf.firehose.pparamsTracker != nil {
+		if err := bf.firehose.pparamsTracker.Rollback(point); err != nil {
+			return fmt.Errorf("failed to roll back protocol parameter tracker: %w", err)
+		}
+	}
+	if bf.firehose.epochTracker != nil {
+		if err := bf.firehose.epochTracker.Rollback(point); err != nil {
+			return fmt.Errorf("failed to roll back epoch tracker: %w", err)
+		}
+	}
+	if bf.firehose.praosValidator != nil {
+		if err := bf.firehose.praosValidator.Rollback(point); err != nil {
+			return fmt.Errorf("failed to roll back Praos validator: %w", err)
+		}
+	}
 	return nil
 }
 
@@ -399,7 +607,7 @@ func (bf *BlockFetcher) connect(ctx context.Context) error {
 	var protocol, address string
 	var isN2N bool
 	if bf.config.Address != "" {
-		protocol, address = "tcp", bf.config.Address
+		protocol, address = "tcp", bf.peers[bf.peer]
 		isN2N = true
 	} else if bf.config.SocketPath != "" {
 		protocol, address = "unix", bf.config.SocketPath
@@ -439,7 +647,16 @@ func (bf *BlockFetcher) connect(ctx context.Context) error {
 	}
 
 	bf.connection = conn
-	bf.logger.Printf("Successfully connected to %s", bf.config.Address)
+	if bf.config.Mempool && conn.LocalTxMonitor() == nil {
+		return fmt.Errorf("the node does not support LocalTxMonitor, needed by -mempool")
+	}
+	if bf.config.LedgerSnapshots && conn.LocalStateQuery() == nil {
+		return fmt.Errorf("the node does not support LocalStateQuery, needed by -ledger-snapshots")
+	}
+	if conn.LocalStateQuery() != nil {
+		bf.firehose.stateQuery = conn.LocalStateQuery().Client
+	}
+	bf.logger.Printf("Successfully connected to %s", address)
 	return nil
 }
 
@@ -459,13 +676,54 @@ func (bf *BlockFetcher) start(ctx context.Context) error {
 		return fmt.Errorf("chain sync failed: %w", err)
 	}
 
-	<-ctx.Done()
+	mempoolErr := make(chan error, 1)
+	if bf.config.Mempool {
+		bf.logger.Printf("Monitoring mempool every %s", bf.config.MempoolInterval)
+		monitor := mempool.NewMonitor(bf.connection.LocalTxMonitor().Client, bf.config.MempoolInterval, bf.logger, bf.firehose.convertOptions...)
+		go func() {
+			mempoolErr <- monitor.Run(ctx, bf.firehose.OutputMempoolEvent)
+		}()
+	}
+
+	select {
+	case <-ctx.Done():
+	case err := <-bf.invalidBlock:
+		return err
+	case err := <-mempoolErr:
+		if ctx.Err() == nil {
+			return fmt.Errorf("mempool monitor failed: %w", err)
+		}
+	}
 	bf.logger.Println("Context cancelled, stopping chain sync...")
 
 	return ctx.Err()
 }
 
 func (bf *BlockFetcher) close() error {
+	if bf.firehose.utxoStore != nil {
+		if err := bf.firehose.utxoStore.Close(); err != nil {
+			bf.logger.Printf("Warning: Failed to close UTxO store: %v", err)
+		}
+	}
+	if bf.firehose.pparamsTracker != nil {
+		if err := bf.firehose.pparamsTracker.Close(); err != nil {
+			bf.logger.Printf("Warning: Failed to close protocol parameter tracker: %v", err)
+		}
+	}
+	if bf.firehose.epochTracker != nil {
+		if err := bf.firehose.epochTracker.Close(); err != nil {
+			bf.logger.Printf("Warning: Failed to close epoch tracker: %v", err)
+		}
+	}
+	if bf.firehose.praosValidator != nil {
+		if err := bf.firehose.praosValidator.Close(); err != nil {
+			bf.logger.Printf("Warning: Failed to close Praos validator: %v", err)
+		}
+	}
+	return bf.disconnect()
+}
+
+func (bf *BlockFetcher) disconnect() error {
 	if bf.connection != nil {
 		bf.logger.Println("Closing connection...")
 		if err := bf.connection.Close(); err != nil {
@@ -489,16 +747,147 @@ func (bf *BlockFetcher) Run() error {
 		cancel()
 	}()
 
-	if err := bf.connect(ctx); err != nil {
-		return fmt.Errorf("failed to connect: %w", err)
+	if bf.config.Mempool && (bf.config.SocketPath == "" || bf.config.Address != "") {
+		return fmt.Errorf("-mempool requires -socket-path")
+	}
+	if bf.config.LedgerSnapshots && (bf.config.SocketPath == "" || bf.config.Address != "" || bf.config.EpochStore == "") {
+		return fmt.Errorf("-ledger-snapshots requires -socket-path and -epoch-store")
 	}
+	bf.firehose.snapshots = bf.config.LedgerSnapshots
+
+	if bf.config.UTxOStore != "" {
+		store, err := utxo.Open(bf.config.UTxOStore, bf.config.UTxOUndoDepth)
+		if err != nil {
+			return err
+		}
+		bf.firehose.utxoStore = store
+		bf.firehose.convertOptions = append(bf.firehose.convertOptions,
+			convert.WithUTxOResolver(store),
+			convert.WithDatumIndex(store),
+			convert.WithScriptRegistry(store),
+		)
+
+		tip, err := store.Tip()
+		if err != nil {
+			return fmt.Errorf("failed to read UTxO store tip: %w", err)
+		}
+		if tip != nil {
+			bf.logger.Printf("Using UTxO store %s (tip slot=%d, hash=%x)", bf.config.UTxOStore, tip.Slot, tip.Hash)
+		} else {
+			bf.logger.Printf("Using empty UTxO store %s, inputs of outputs created before the start point stay unresolved", bf.config.UTxOStore)
+		}
+	}
+
+	if bf.config.PParamsStore != "" {
+		if bf.config.ShelleyGenesis == "" {
+			return fmt.Errorf("-shelley-genesis is required to track protocol parameters")
+		}
+		genesis, err := pparams.LoadGenesis(bf.config.ShelleyGenesis, bf.config.AlonzoGenesis, bf.config.ConwayGenesis)
+		if err != nil {
+			return err
+		}
+		tracker, err := pparams.Open(bf.config.PParamsStore, bf.eraHistory, genesis, pparams.WithLedger(nodeLedger{bf.firehose}))
+		if err != nil {
+			return err
+		}
+		bf.firehose.pparamsTracker = tracker
+
+		tip, err := tracker.Tip()
+		if err != nil {
+			return fmt.Errorf("failed to read protocol parameter tracker tip: %w", err)
+		}
+		if tip != nil {
+			bf.logger.Printf("Using protocol parameter tracker %s (tip slot=%d, hash=%x)", bf.config.PParamsStore, tip.Slot, tip.Hash)
+		} else {
+			bf.logger.Printf("Using empty protocol parameter tracker %s, the chain has to be followed from the first Shelley epoch", bf.config.PParamsStore)
+		}
+	}
+
+	if bf.config.EpochStore != "" {
+		tracker, err := epoch.Open(bf.config.EpochStore, bf.eraHistory)
+		if err != nil {
+			return err
+		}
+		bf.firehose.epochTracker = tracker
+
+		tip, err := tracker.Tip()
+		if err != nil {
+			return fmt.Errorf("failed to read epoch tracker tip: %w", err)
+		}
+		if tip != nil {
+			bf.logger.Printf("Using epoch tracker %s (tip slot=%d, hash=%x)", bf.config.EpochStore, tip.Slot, tip.Hash)
+		} else {
+			bf.logger.Printf("Using empty epoch tracker %s, the counts of the first epoch followed are partial unless starting from genesis", bf.config.EpochStore)
+		}
+	}
+
+	if bf.config.PraosStore != "" {
+		if bf.config.ShelleyGenesis == "" {
+			return fmt.Errorf("-shelley-genesis is required to validate Praos headers")
+		}
+		params, err := praos.LoadParams(bf.config.ShelleyGenesis)
+		if err != nil {
+			return err
+		}
+		validator, err := praos.Open(bf.config.PraosStore, bf.eraHistory, params)
+		if err != nil {
+			return err
+		}
+		bf.firehose.praosValidator = validator
+		bf.firehose.praosStrict = bf.config.PraosStrict
+
+		tip, err := validator.Tip()
+		if err != nil {
+			return fmt.Errorf("failed to read Praos validator tip: %w", err)
+		}
+		if tip != nil {
+			bf.logger.Printf("Using Praos validator %s (tip slot=%d, hash=%x)", bf.config.PraosStore, tip.Slot, tip.Hash)
+		} else {
+			bf.logger.Printf("Using empty Praos validator %s, VRF proofs are only checked when following the chain from the first Shelley epoch", bf.config.PraosStore)
+		}
+	}
+
 	defer func() {
 		if err := bf.close(); err != nil {
 			bf.logger.Printf("Error during cleanup: %v", err)
 		}
 	}()
 
-	return bf.start(ctx)
+	for {
+		// An unreachable peer is skipped like one serving an invalid block.
+		// A local node, or every peer failing in a row, leaves nothing to
+		// switch to.
+		if err := bf.connect(ctx); err != nil {
+			bf.failedPeers++
+			if bf.failedPeers >= len(bf.peers) || ctx.Err() != nil {
+				return fmt.Errorf("failed to connect: %w", err)
+			}
+			bf.logger.Printf("Failed to connect to %s: %v", bf.peers[bf.peer], err)
+		} else {
+			err := bf.start(ctx)
+			var mismatch *verify.MismatchError
+			if !errors.As(err, &mismatch) {
+				return err
+			}
+
+			// The block was not emitted, the next peer resumes from the
+			// last emitted one.
+			bf.failedPeers++
+			if bf.failedPeers >= len(bf.peers) {
+				return fmt.Errorf("refused invalid block: %w", err)
+			}
+			bf.logger.Printf("Refused invalid block from %s: %v", bf.peers[bf.peer], err)
+		}
+		if err := bf.disconnect(); err != nil {
+			bf.logger.Printf("Warning: %v", err)
+		}
+		select {
+		case <-bf.invalidBlock:
+		default:
+		}
+		bf.peer = (bf.peer + 1) % len(bf.peers)
+		bf.logger.Printf("Switching to peer %s", bf.peers[bf.peer])
+	}
 }
 
 func main() {
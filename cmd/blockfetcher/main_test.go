@@ -0,0 +1,157 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/blinklabs-io/gouroboros/cbor"
+	"github.com/blinklabs-io/gouroboros/ledger"
+	"github.com/blinklabs-io/gouroboros/ledger/byron"
+	"github.com/blinklabs-io/gouroboros/protocol/common"
+	"github.com/no-witness-labs/firehose-cardano/era"
+	"github.com/no-witness-labs/firehose-cardano/internal/nodetest"
+	"github.com/no-witness-labs/firehose-cardano/praos"
+)
+
+func TestPraosAgainstNode(t *testing.T) {
+	block := nodetest.ConwayBlock(t)
+	node := &nodetest.Node{Chain: []ledger.Block{block}}
+	address := node.ListenTCP(t)
+
+	for _, tc := range []struct {
+		name              string
+		slotsPerKESPeriod uint64
+		valid             bool
+	}{
+		{name: "valid header", slotsPerKESPeriod: 129600, valid: true},
+		// Other KES periods put the slot outside of the certificate ones.
+		{name: "invalid header", slotsPerKESPeriod: 1},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := &BlockFetcherConfig{
+				Address:      address,
+				NetworkMagic: nodetest.NetworkMagic,
+				VerifyBlocks: true,
+				PraosStrict:  true,
+			}
+			cfg.setDefaults()
+			bf := NewBlockFetcher(cfg, log.New(io.Discard, "", 0))
+			// Following from the origin, the chain of the node being the one
+			// block.
+			bf.cursorPoints = []common.Point{common.NewPointOrigin()}
+
+			validator, err := praos.Open(filepath.Join(t.TempDir(), "praos.db"), bf.eraHistory, &praos.Params{
+				SlotsPerKESPeriod:         tc.slotsPerKESPeriod,
+				MaxKESEvolutions:          62,
+				StabilityWindow:           129600,
+				RandomnessStabilityWindow: 172800,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			bf.firehose.praosValidator = validator
+			bf.firehose.praosStrict = cfg.PraosStrict
+			defer bf.close()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if err := bf.connect(ctx); err != nil {
+				t.Fatal(err)
+			}
+			done := make(chan error, 1)
+			go func() { done <- bf.start(ctx) }()
+
+			timeout := time.After(10 * time.Second)
+			for tc.valid {
+				tip, err := validator.Tip()
+				if err != nil {
+					t.Fatal(err)
+				}
+				if tip != nil && tip.Slot == block.SlotNumber() {
+					cancel()
+					break
+				}
+				select {
+				case err := <-done:
+					t.Fatalf("fetcher stopped: %v", err)
+				case <-timeout:
+					t.Fatal("block not validated")
+				case <-time.After(10 * time.Millisecond):
+				}
+			}
+
+			select {
+			case err := <-done:
+				var invalid *praos.HeaderError
+				if tc.valid && !errors.Is(err, context.Canceled) {
+					t.Errorf("fetcher stopped: %v", err)
+				}
+				if !tc.valid && !errors.As(err, &invalid) {
+					t.Errorf("fetcher stopped: %v, expected an invalid header", err)
+				}
+			case <-timeout:
+				t.Fatal("fetcher did not stop")
+			}
+		})
+	}
+}
+
+// epochBoundaryBlock returns the epoch boundary block of Byron epoch 1, after
+// the main block 21599.
+func epochBoundaryBlock(t *testing.T) ledger.Block {
+	t.Helper()
+	header := &byron.ByronEpochBoundaryBlockHeader{
+		ProtocolMagic: nodetest.NetworkMagic,
+		PrevBlock:     ledger.Blake2b256{0x01},
+		BodyProof:     make([]byte, 32),
+		ExtraData:     []any{map[any]any{}},
+	}
+	header.ConsensusData.Epoch = 1
+	header.ConsensusData.Difficulty.Value = 21599
+	data, err := cbor.Encode([]any{header, []any{}, []any{map[any]any{}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	block, err := ledger.NewBlockFromCbor(ledger.BlockTypeByronEbb, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return block
+}
+
+func TestBlockLine(t *testing.T) {
+	history, err := era.ForNetwork("mainnet")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := NewFirehoseInstrumentation("type.googleapis.com/sf.cardano.type.v1.Block", log.New(io.Discard, "", 0), history)
+
+	for _, tc := range []struct {
+		name   string
+		block  ledger.Block
+		parent uint64
+	}{
+		{name: "main block", block: nodetest.ConwayBlock(t), parent: 0xb82b21 - 1},
+		{name: "epoch boundary block", block: epochBoundaryBlock(t), parent: 21599},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			fields := strings.Fields(f.blockLine(tc.block, []byte{0x01}))
+			if len(fields) != 9 {
+				t.Fatalf("line fields %v", fields)
+			}
+			if fields[4] != strconv.FormatUint(tc.parent, 10) {
+				t.Errorf("parent number %s, expected %d", fields[4], tc.parent)
+			}
+			if fields[5] != tc.block.Header().PrevHash().String() {
+				t.Errorf("parent hash %s, expected %s", fields[5], tc.block.Header().PrevHash())
+			}
+		})
+	}
+}
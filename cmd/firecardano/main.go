@@ -1,10 +1,14 @@
 package main
 
 import (
+	"github.com/no-witness-labs/firehose-cardano/transform"
+	pbtransform "github.com/no-witness-labs/firehose-cardano/types/pb/sf/cardano/transform/v1"
 	pbcardano "github.com/no-witness-labs/firehose-cardano/types/pb/sf/cardano/type/v1"
 	firecore "github.com/streamingfast/firehose-core"
 	fhCMD "github.com/streamingfast/firehose-core/cmd"
 	info "github.com/streamingfast/firehose-core/firehose/info"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
 func main() {
@@ -12,14 +16,23 @@ func main() {
 	firecore.UnsafeRunningFromFirecore = true
 	firecore.UnsafeAllowExecutableNameToBeEmpty = true
 
+	registerMempoolApp()
+	registerSubmitApp()
+	registerUtxorpcApp()
+
 	fhCMD.Main(&firecore.Chain[*pbcardano.Block]{
 		ShortName:            "cardano",
 		LongName:             "Cardano",
 		FullyQualifiedModule: "github.com/no-witness-labs/firehose-cardano",
 		Version:              version,
 		BlockFactory:         func() firecore.Block { return new(pbcardano.Block) },
-		ConsoleReaderFactory: firecore.NewConsoleReader,
+		ConsoleReaderFactory: newConsoleReader,
 		InfoResponseFiller:   info.DefaultInfoResponseFiller,
-		Tools:                &firecore.ToolsConfig[*pbcardano.Block]{},
+		BlockTransformerFactories: map[protoreflect.FullName]firecore.BlockTransformerFactory{
+			proto.MessageName(&pbtransform.StripRawCBOR{}): transform.StripRawCBORFactory,
+		},
+		Tools: &firecore.ToolsConfig[*pbcardano.Block]{
+			RegisterExtraCmd: registerTools,
+		},
 	})
 }
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/no-witness-labs/firehose-cardano/mempool"
+	pbmempool "github.com/no-witness-labs/firehose-cardano/types/pb/sf/cardano/mempool/v1"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	firecore "github.com/streamingfast/firehose-core"
+	"github.com/streamingfast/firehose-core/launcher"
+	"github.com/streamingfast/firehose-core/node-manager/mindreader"
+	"github.com/streamingfast/logging"
+	"github.com/streamingfast/shutter"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+var zlog, _ = logging.PackageLogger("firecardano", "github.com/no-witness-labs/firehose-cardano/cmd/firecardano")
+
+// mempoolHub holds the mempool the fetcher of the reader node reports, the
+// mempool app has to run in the same process as the reader node.
+var mempoolHub = mempool.NewHub()
+
+// newConsoleReader reads the fetcher output: FIRE MEMPOOL lines go to the
+// mempool hub, the others to the Firehose console reader whose blocks are
+// shown to the submit tracker.
+func newConsoleReader(lines chan string, blockEncoder firecore.BlockEncoder, logger *zap.Logger, tracer logging.Tracer) (mindreader.ConsolerReader, error) {
+	blockLines := make(chan string, cap(lines))
+	go func() {
+		defer close(blockLines)
+		for line := range lines {
+			payload, ok := strings.CutPrefix(line, mempool.LinePrefix)
+			if !ok {
+				blockLines <- line
+				continue
+			}
+			event, err := mempool.ParseLine(payload)
+			if err != nil {
+				logger.Warn("skipping mempool line", zap.Error(err))
+				continue
+			}
+			mempoolHub.Publish(event)
+		}
+	}()
+	reader, err := firecore.NewConsoleReader(blockLines, blockEncoder, logger, tracer)
+	if err != nil {
+		return nil, err
+	}
+	return &trackingConsoleReader{ConsolerReader: reader, logger: logger}, nil
+}
+
+func registerMempoolApp() {
+	launcher.RegisterApp(zlog, &launcher.AppDef{
+		ID:          "mempool",
+		Title:       "Mempool",
+		Description: "Streams the pending transactions the fetcher of the reader node reports",
+		RegisterFlags: func(cmd *cobra.Command) error {
+			cmd.Flags().String("mempool-grpc-listen-addr", ":10018", "Address to listen for incoming sf.cardano.mempool.v1.Mempool gRPC requests")
+			return nil
+		},
+		FactoryFunc: func(runtime *launcher.Runtime) (launcher.App, error) {
+			return &mempoolApp{
+				Shutter:    shutter.New(),
+				listenAddr: viper.GetString("mempool-grpc-listen-addr"),
+			}, nil
+		},
+	})
+}
+
+type mempoolApp struct {
+	*shutter.Shutter
+	listenAddr string
+}
+
+func (a *mempoolApp) Run() error {
+	listener, err := net.Listen("tcp", a.listenAddr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", a.listenAddr, err)
+	}
+
+	server := grpc.NewServer()
+	pbmempool.RegisterMempoolServer(server, mempool.NewServer(mempoolHub))
+	// Streams only end with their client, they are not waited for.
+	a.OnTerminating(func(error) {
+		server.Stop()
+	})
+
+	zlog.Info("serving mempool", zap.String("listen_addr", a.listenAddr))
+	go func() {
+		a.Shutdown(server.Serve(listener))
+	}()
+	return nil
+}
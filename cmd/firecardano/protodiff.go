@@ -0,0 +1,105 @@
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// protoDiff compares two messages of the same type field by field and
+// returns one line per differing leaf, prefixed with its field path.
+func protoDiff(expected, actual proto.Message) []string {
+	var diffs []string
+	diffMessage("", expected.ProtoReflect(), actual.ProtoReflect(), &diffs)
+	return diffs
+}
+
+func diffMessage(path string, a, b protoreflect.Message, diffs *[]string) {
+	fields := a.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		fieldPath := joinPath(path, string(fd.Name()))
+
+		switch {
+		case fd.IsList():
+			diffList(fieldPath, fd, a.Get(fd).List(), b.Get(fd).List(), diffs)
+		case fd.IsMap():
+			diffMap(fieldPath, fd, a.Get(fd).Map(), b.Get(fd).Map(), diffs)
+		case fd.Message() != nil:
+			hasA, hasB := a.Has(fd), b.Has(fd)
+			switch {
+			case !hasA && !hasB:
+			case hasA != hasB:
+				*diffs = append(*diffs, fmt.Sprintf("%s: stored set=%t, node set=%t", fieldPath, hasA, hasB))
+			default:
+				diffMessage(fieldPath, a.Get(fd).Message(), b.Get(fd).Message(), diffs)
+			}
+		default:
+			diffScalar(fieldPath, fd, a.Get(fd), b.Get(fd), diffs)
+		}
+	}
+}
+
+func diffList(path string, fd protoreflect.FieldDescriptor, a, b protoreflect.List, diffs *[]string) {
+	if a.Len() != b.Len() {
+		*diffs = append(*diffs, fmt.Sprintf("%s: stored has %d items, node has %d", path, a.Len(), b.Len()))
+	}
+	for i := 0; i < a.Len() && i < b.Len(); i++ {
+		itemPath := fmt.Sprintf("%s[%d]", path, i)
+		if fd.Message() != nil {
+			diffMessage(itemPath, a.Get(i).Message(), b.Get(i).Message(), diffs)
+		} else {
+			diffScalar(itemPath, fd, a.Get(i), b.Get(i), diffs)
+		}
+	}
+}
+
+func diffMap(path string, fd protoreflect.FieldDescriptor, a, b protoreflect.Map, diffs *[]string) {
+	keys := map[string]protoreflect.MapKey{}
+	a.Range(func(k protoreflect.MapKey, _ protoreflect.Value) bool { keys[k.String()] = k; return true })
+	b.Range(func(k protoreflect.MapKey, _ protoreflect.Value) bool { keys[k.String()] = k; return true })
+
+	sorted := make([]string, 0, len(keys))
+	for k := range keys {
+		sorted = append(sorted, k)
+	}
+	sort.Strings(sorted)
+
+	for _, name := range sorted {
+		key := keys[name]
+		entryPath := fmt.Sprintf("%s[%s]", path, name)
+		hasA, hasB := a.Has(key), b.Has(key)
+		if hasA != hasB {
+			*diffs = append(*diffs, fmt.Sprintf("%s: stored set=%t, node set=%t", entryPath, hasA, hasB))
+			continue
+		}
+		if fd.MapValue().Message() != nil {
+			diffMessage(entryPath, a.Get(key).Message(), b.Get(key).Message(), diffs)
+		} else {
+			diffScalar(entryPath, fd.MapValue(), a.Get(key), b.Get(key), diffs)
+		}
+	}
+}
+
+func diffScalar(path string, fd protoreflect.FieldDescriptor, a, b protoreflect.Value, diffs *[]string) {
+	if fd.Kind() == protoreflect.BytesKind {
+		if !bytes.Equal(a.Bytes(), b.Bytes()) {
+			*diffs = append(*diffs, fmt.Sprintf("%s: stored=%s node=%s", path, hex.EncodeToString(a.Bytes()), hex.EncodeToString(b.Bytes())))
+		}
+		return
+	}
+	if !a.Equal(b) {
+		*diffs = append(*diffs, fmt.Sprintf("%s: stored=%v node=%v", path, a.Interface(), b.Interface()))
+	}
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
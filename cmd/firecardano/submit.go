@@ -0,0 +1,121 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	ouroboros "github.com/blinklabs-io/gouroboros"
+	"github.com/no-witness-labs/firehose-cardano/submit"
+	pbsubmit "github.com/no-witness-labs/firehose-cardano/types/pb/sf/cardano/submit/v1"
+	pbcardano "github.com/no-witness-labs/firehose-cardano/types/pb/sf/cardano/type/v1"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	pbbstream "github.com/streamingfast/bstream/pb/sf/bstream/v1"
+	"github.com/streamingfast/firehose-core/launcher"
+	"github.com/streamingfast/firehose-core/node-manager/mindreader"
+	"github.com/streamingfast/shutter"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+// submitTracker watches the blocks of the reader node for the tracked
+// submissions, tracking needs the submit app to run in the same process as
+// the reader node.
+var submitTracker *submit.Tracker
+
+// trackingConsoleReader shows the blocks it reads to the submit tracker.
+type trackingConsoleReader struct {
+	mindreader.ConsolerReader
+	logger *zap.Logger
+}
+
+func (r *trackingConsoleReader) ReadBlock() (*pbbstream.Block, error) {
+	block, err := r.ConsolerReader.ReadBlock()
+	if err != nil || submitTracker == nil || !submitTracker.Tracking() {
+		return block, err
+	}
+
+	decoded := &pbcardano.Block{}
+	if err := block.Payload.UnmarshalTo(decoded); err != nil {
+		r.logger.Warn("skipping block for submission tracking", zap.Uint64("block_num", block.Number), zap.Error(err))
+		return block, nil
+	}
+	submitTracker.ApplyBlock(decoded)
+	return block, nil
+}
+
+func (r *trackingConsoleReader) Close() error {
+	if closer, ok := r.ConsolerReader.(mindreader.CloseableConsoleReader); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
+func registerSubmitApp() {
+	launcher.RegisterApp(zlog, &launcher.AppDef{
+		ID:          "submit",
+		Title:       "Submit",
+		Description: "Forwards signed transactions to a node",
+		RegisterFlags: func(cmd *cobra.Command) error {
+			cmd.Flags().String("submit-grpc-listen-addr", ":10019", "Address to listen for incoming sf.cardano.submit.v1.Submit gRPC requests")
+			cmd.Flags().String("submit-node-address", "", "Cardano node address to submit to over node-to-node (TxSubmission)")
+			cmd.Flags().String("submit-node-socket-path", "", "Unix socket path of a local node to submit to over node-to-client (LocalTxSubmission), preferred over --submit-node-address")
+			cmd.Flags().String("submit-network", "mainnet", "Network: mainnet, preview, preprod")
+			cmd.Flags().Uint64("submit-network-magic", 0, "Network magic number (0 = derived from --submit-network)")
+			cmd.Flags().Duration("submit-track-retention", time.Hour, "How long a tracked submission is watched for in the blocks of the reader node")
+			return nil
+		},
+		FactoryFunc: func(runtime *launcher.Runtime) (launcher.App, error) {
+			cfg := submit.Config{
+				Address:      viper.GetString("submit-node-address"),
+				SocketPath:   viper.GetString("submit-node-socket-path"),
+				NetworkMagic: uint32(viper.GetUint64("submit-network-magic")),
+			}
+			if cfg.Address == "" && cfg.SocketPath == "" {
+				return nil, errors.New("either --submit-node-address or --submit-node-socket-path is required")
+			}
+			if cfg.NetworkMagic == 0 {
+				network, ok := ouroboros.NetworkByName(viper.GetString("submit-network"))
+				if !ok {
+					return nil, fmt.Errorf("invalid network specified: %s", viper.GetString("submit-network"))
+				}
+				cfg.NetworkMagic = network.NetworkMagic
+			}
+
+			submitTracker = submit.NewTracker(viper.GetDuration("submit-track-retention"))
+			return &submitApp{
+				Shutter:    shutter.New(),
+				listenAddr: viper.GetString("submit-grpc-listen-addr"),
+				node:       submit.NewNode(cfg, zlog),
+			}, nil
+		},
+	})
+}
+
+type submitApp struct {
+	*shutter.Shutter
+	listenAddr string
+	node       *submit.Node
+}
+
+func (a *submitApp) Run() error {
+	listener, err := net.Listen("tcp", a.listenAddr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", a.listenAddr, err)
+	}
+
+	server := grpc.NewServer()
+	pbsubmit.RegisterSubmitServer(server, submit.NewServer(a.node, submitTracker))
+	a.OnTerminating(func(error) {
+		server.Stop()
+		a.node.Close()
+	})
+
+	zlog.Info("serving submit", zap.String("listen_addr", a.listenAddr))
+	go func() {
+		a.Shutdown(server.Serve(listener))
+	}()
+	return nil
+}
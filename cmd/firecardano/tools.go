@@ -0,0 +1,17 @@
+package main
+
+import (
+	pbcardano "github.com/no-witness-labs/firehose-cardano/types/pb/sf/cardano/type/v1"
+	"github.com/spf13/cobra"
+	firecore "github.com/streamingfast/firehose-core"
+	"github.com/streamingfast/logging"
+	"go.uber.org/zap"
+)
+
+func registerTools(chain *firecore.Chain[*pbcardano.Block], toolsCmd *cobra.Command, logger *zap.Logger, tracer logging.Tracer) error {
+	toolsCmd.AddCommand(newToolsCheckChainCmd(chain, logger))
+	toolsCmd.AddCommand(newToolsVerifyAgainstNodeCmd(chain, logger))
+	toolsCmd.AddCommand(newToolsPrintJSONCmd(chain))
+
+	return nil
+}
@@ -0,0 +1,313 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"maps"
+	"regexp"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/no-witness-labs/firehose-cardano/era"
+	pbcardano "github.com/no-witness-labs/firehose-cardano/types/pb/sf/cardano/type/v1"
+	"github.com/spf13/cobra"
+	"github.com/streamingfast/bstream"
+	pbbstream "github.com/streamingfast/bstream/pb/sf/bstream/v1"
+	"github.com/streamingfast/cli/sflags"
+	"github.com/streamingfast/dstore"
+	firecore "github.com/streamingfast/firehose-core"
+	"github.com/streamingfast/firehose-core/cmd/tools/check"
+	"github.com/streamingfast/firehose-core/types"
+	"go.uber.org/zap"
+)
+
+var bundleNumberRegex = regexp.MustCompile(`(\d{10})`)
+
+func newToolsCheckChainCmd(chain *firecore.Chain[*pbcardano.Block], logger *zap.Logger) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "check-chain <merged-blocks-store-url>",
+		Short: "Walks merged blocks and verifies the chain is contiguous, linked and consistent with the era history",
+		Long: `Walks merged blocks and verifies, for every block, that block numbers are contiguous,
+slots strictly increase, the parent hash equals the previous block's hash, the
+timestamp matches the network era history and the payload decodes as a
+sf.cardano.type.v1.Block. Every hole and fork is reported with the bundle file
+it was found in and the command exits with a non-zero status if any was found.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			blockRange, err := types.GetBlockRangeFromFlagDefault(cmd, "range", types.NewOpenRange(0))
+			if err != nil {
+				return err
+			}
+
+			history, err := era.ForNetwork(sflags.MustGetString(cmd, "network"))
+			if err != nil {
+				return err
+			}
+
+			checker := &chainChecker{
+				history:       history,
+				logger:        logger,
+				fileBlockSize: 100,
+				maxIssues:     sflags.MustGetInt(cmd, "max-issues"),
+				// Without an explicit range, the store's first bundle is where
+				// checking starts and missing earlier bundles are not holes.
+				checkLeadingBundles: sflags.MustGetString(cmd, "range") != "",
+			}
+
+			return checker.Check(cmd.Context(), args[0], blockRange)
+		},
+	}
+
+	cmd.Flags().StringP("range", "r", "", "Block range to check")
+	cmd.Flags().String("network", "mainnet", "Network whose era history is used to verify timestamps: mainnet, preview, preprod")
+	cmd.Flags().Int("max-issues", 0, "Stop after this many issues were found (0 = report all)")
+
+	cmd.Example = firecore.ExamplePrefixed(chain, "tools check-chain", `
+		"./firehose-data/storage/merged-blocks"
+		"gs://<project>/<bucket>/<path>" -r "10_000_000:10_100_000" --network=preprod
+	`)
+
+	return cmd
+}
+
+type chainIssue struct {
+	bundle string
+	block  uint64
+	kind   string
+	msg    string
+}
+
+type chainChecker struct {
+	history       *era.History
+	logger        *zap.Logger
+	fileBlockSize uint64
+	maxIssues     int
+
+	checkLeadingBundles bool
+
+	prev        *pbbstream.Block
+	prevSlot    uint64
+	prevEBB     bool
+	prevBundle  string
+	issues      []chainIssue
+	blockCount  uint64
+	expectedNum uint64
+}
+
+var errTooManyIssues = fmt.Errorf("too many issues")
+
+func (c *chainChecker) report(bundle string, block uint64, kind string, msg string, args ...any) error {
+	issue := chainIssue{bundle: bundle, block: block, kind: kind, msg: fmt.Sprintf(msg, args...)}
+	c.issues = append(c.issues, issue)
+	fmt.Printf("❌ %s at block #%d (%s): %s\n", issue.kind, issue.block, issue.bundle, issue.msg)
+
+	if c.maxIssues > 0 && len(c.issues) >= c.maxIssues {
+		return errTooManyIssues
+	}
+	return nil
+}
+
+func (c *chainChecker) Check(ctx context.Context, storeURL string, blockRange types.BlockRange) error {
+	store, err := dstore.NewDBinStore(storeURL)
+	if err != nil {
+		return fmt.Errorf("unable to create store at %s: %w", storeURL, err)
+	}
+
+	fmt.Printf("Checking chain integrity on %s (range %s, network %s)\n", storeURL, blockRange, c.history.Network)
+
+	c.expectedNum = types.RoundToBundleStartBlock(uint64(blockRange.Start), c.fileBlockSize)
+	walkPrefix := check.WalkBlockPrefix(blockRange, c.fileBlockSize)
+
+	err = store.Walk(ctx, walkPrefix, func(filename string) error {
+		match := bundleNumberRegex.FindStringSubmatch(filename)
+		if match == nil {
+			return nil
+		}
+
+		baseNum, _ := strconv.ParseUint(match[1], 10, 64)
+		if baseNum+c.fileBlockSize-1 < uint64(blockRange.Start) {
+			return nil
+		}
+		if blockRange.IsClosed() && baseNum >= *blockRange.Stop {
+			return dstore.StopIteration
+		}
+
+		if baseNum > c.expectedNum && (c.prevBundle != "" || c.checkLeadingBundles) {
+			missing := types.NewClosedRange(int64(c.expectedNum), baseNum-1)
+			if err := c.report(filename, c.expectedNum, "hole", "missing bundles for range %s, previous bundle is %s", missing, c.prevBundle); err != nil {
+				return err
+			}
+
+			// Linkage across a missing bundle cannot be checked, restart from
+			// this bundle's first block.
+			c.prev = nil
+			c.prevSlot = 0
+		}
+		c.expectedNum = baseNum + c.fileBlockSize
+
+		if err := c.checkBundle(ctx, store, filename, blockRange); err != nil {
+			return err
+		}
+		c.prevBundle = filename
+
+		return nil
+	})
+	if err != nil && err != errTooManyIssues {
+		return err
+	}
+
+	fmt.Println()
+	fmt.Println("Summary:")
+	fmt.Printf("> %d blocks checked\n", c.blockCount)
+	if len(c.issues) == 0 {
+		fmt.Println("> 🆗 No hole or fork found")
+		return nil
+	}
+
+	counts := map[string]int{}
+	for _, issue := range c.issues {
+		counts[issue.kind]++
+	}
+	for _, kind := range slices.Sorted(maps.Keys(counts)) {
+		fmt.Printf("> 🆘 %d %s issue(s)\n", counts[kind], kind)
+	}
+
+	return fmt.Errorf("chain check found %d issue(s)", len(c.issues))
+}
+
+func (c *chainChecker) checkBundle(ctx context.Context, store dstore.Store, filename string, blockRange types.BlockRange) error {
+	c.logger.Debug("checking merged blocks bundle", zap.String("filename", filename))
+
+	reader, err := store.OpenObject(ctx, filename)
+	if err != nil {
+		return fmt.Errorf("unable to open bundle %s: %w", filename, err)
+	}
+	defer reader.Close()
+
+	blockReader, err := bstream.NewDBinBlockReader(reader)
+	if err != nil {
+		return c.report(filename, 0, "corrupt", "unable to read bundle: %s", err)
+	}
+
+	for {
+		block, err := blockReader.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return c.report(filename, 0, "corrupt", "unable to read block after #%d: %s", c.prevNumber(), err)
+		}
+
+		if block.Number < uint64(blockRange.Start) {
+			continue
+		}
+		if blockRange.IsClosed() && block.Number >= *blockRange.Stop {
+			return dstore.StopIteration
+		}
+
+		if err := c.checkBlock(filename, block); err != nil {
+			return err
+		}
+	}
+}
+
+func (c *chainChecker) prevNumber() uint64 {
+	if c.prev == nil {
+		return 0
+	}
+	return c.prev.Number
+}
+
+func (c *chainChecker) checkBlock(filename string, block *pbbstream.Block) error {
+	c.blockCount++
+
+	var payload pbcardano.Block
+	decoded := true
+	if err := block.Payload.UnmarshalTo(&payload); err != nil {
+		decoded = false
+		if err := c.report(filename, block.Number, "payload", "unable to decode payload as sf.cardano.type.v1.Block: %s", err); err != nil {
+			return err
+		}
+	} else if payload.Header == nil {
+		decoded = false
+		if err := c.report(filename, block.Number, "payload", "payload carries no block header"); err != nil {
+			return err
+		}
+	}
+
+	if decoded {
+		if id := hex.EncodeToString(payload.Header.Hash); id != block.Id {
+			if err := c.report(filename, block.Number, "payload", "payload hash %s differs from block id %s", id, block.Id); err != nil {
+				return err
+			}
+		}
+		if payload.Header.Height != block.Number {
+			if err := c.report(filename, block.Number, "payload", "payload height %d differs from block number", payload.Header.Height); err != nil {
+				return err
+			}
+		}
+
+		expected := c.history.SlotToTime(payload.Header.Slot)
+		if actual := block.Time(); !actual.Equal(expected) {
+			if err := c.report(filename, block.Number, "timestamp", "timestamp %s does not match slot %d which starts at %s", actual.UTC().Format(time.RFC3339), payload.Header.Slot, expected.Format(time.RFC3339)); err != nil {
+				return err
+			}
+		}
+	}
+
+	ebb := decoded && c.epochBoundary(block, &payload)
+
+	if c.prev != nil {
+		switch {
+		case block.Number > c.prev.Number+1:
+			if err := c.report(filename, block.Number, "hole", "blocks #%d to #%d are missing after %s", c.prev.Number+1, block.Number-1, c.prev.AsRef()); err != nil {
+				return err
+			}
+		case block.Number <= c.prev.Number && !ebb:
+			if err := c.report(filename, block.Number, "fork", "block %s does not follow %s", block.AsRef(), c.prev.AsRef()); err != nil {
+				return err
+			}
+		}
+
+		if block.ParentId != c.prev.Id {
+			if err := c.report(filename, block.Number, "fork", "parent hash %s differs from previous block %s", block.ParentId, c.prev.AsRef()); err != nil {
+				return err
+			}
+		}
+
+		// The main block after an EBB is in the same slot.
+		sameSlotAsEBB := c.prevEBB && payload.Header.Slot == c.prevSlot
+		if decoded && c.prevSlot != 0 && payload.Header.Slot <= c.prevSlot && !sameSlotAsEBB {
+			if err := c.report(filename, block.Number, "slot", "slot %d does not increase over previous slot %d", payload.Header.Slot, c.prevSlot); err != nil {
+				return err
+			}
+		}
+	}
+
+	c.prev = block
+	c.prevEBB = ebb
+	if decoded {
+		c.prevSlot = payload.Header.Slot
+	}
+
+	return nil
+}
+
+// epochBoundary reports whether block is a Byron epoch boundary block (EBB)
+// following the previous block. An EBB carries the number of the main block
+// before it, so it is only told apart from a fork by its parent being that
+// block, and by its payload or, for blocks converted without the Byron
+// payloads, its slot being the first of a Byron epoch.
+func (c *chainChecker) epochBoundary(block *pbbstream.Block, payload *pbcardano.Block) bool {
+	if c.prev == nil || block.Number != c.prev.Number || block.ParentId != c.prev.Id {
+		return false
+	}
+	if payload.Byron.GetEpochBoundary() {
+		return true
+	}
+	slot := payload.Header.Slot
+	return c.history.EraAt(slot).Name == "byron" && c.history.EpochFirstSlot(c.history.SlotToEpoch(slot)) == slot
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/no-witness-labs/firehose-cardano/era"
+	pbcardano "github.com/no-witness-labs/firehose-cardano/types/pb/sf/cardano/type/v1"
+	pbbstream "github.com/streamingfast/bstream/pb/sf/bstream/v1"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func checkChainBlock(t *testing.T, history *era.History, number, slot uint64, hash, parent byte, ebb bool) *pbbstream.Block {
+	t.Helper()
+	payload := &pbcardano.Block{
+		Header: &pbcardano.BlockHeader{Slot: slot, Height: number, Hash: []byte{hash}},
+	}
+	if ebb {
+		payload.Byron = &pbcardano.ByronBlock{EpochBoundary: true}
+	}
+	anyPayload, err := anypb.New(payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &pbbstream.Block{
+		Number:    number,
+		Id:        hex.EncodeToString([]byte{hash}),
+		ParentId:  hex.EncodeToString([]byte{parent}),
+		Timestamp: timestamppb.New(history.SlotToTime(slot)),
+		Payload:   anyPayload,
+	}
+}
+
+func TestCheckChainByronEpochBoundary(t *testing.T) {
+	history, err := era.ForNetwork("mainnet")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		name   string
+		ebb    bool
+		issues int
+	}{
+		{"flagged EBB", true, 0},
+		// Blocks converted before the Byron payloads were carried.
+		{"unflagged EBB", false, 0},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			checker := &chainChecker{history: history, logger: zap.NewNop()}
+			blocks := []*pbbstream.Block{
+				checkChainBlock(t, history, 21_599, 21_599, 1, 0, false),
+				checkChainBlock(t, history, 21_599, 21_600, 2, 1, tc.ebb),
+				checkChainBlock(t, history, 21_600, 21_600, 3, 2, false),
+			}
+			for _, block := range blocks {
+				if err := checker.checkBlock("bundle", block); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if len(checker.issues) != tc.issues {
+				t.Errorf("got issues %+v", checker.issues)
+			}
+		})
+	}
+
+	t.Run("fork", func(t *testing.T) {
+		checker := &chainChecker{history: history, logger: zap.NewNop()}
+		blocks := []*pbbstream.Block{
+			checkChainBlock(t, history, 21_599, 21_599, 1, 0, false),
+			checkChainBlock(t, history, 21_599, 21_599, 2, 0, false),
+		}
+		for _, block := range blocks {
+			if err := checker.checkBlock("bundle", block); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if len(checker.issues) == 0 || checker.issues[0].kind != "fork" {
+			t.Errorf("expected a fork, got %+v", checker.issues)
+		}
+	})
+}
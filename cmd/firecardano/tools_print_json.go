@@ -0,0 +1,92 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	pbcardano "github.com/no-witness-labs/firehose-cardano/types/pb/sf/cardano/type/v1"
+	"github.com/spf13/cobra"
+	pbbstream "github.com/streamingfast/bstream/pb/sf/bstream/v1"
+	"github.com/streamingfast/cli/sflags"
+	"github.com/streamingfast/dstore"
+	firecore "github.com/streamingfast/firehose-core"
+	"github.com/streamingfast/firehose-core/cmd/tools/check"
+	"github.com/streamingfast/firehose-core/types"
+)
+
+func newToolsPrintJSONCmd(chain *firecore.Chain[*pbcardano.Block]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "print-json <merged-blocks-store-url>",
+		Short: "Prints merged blocks as Cardano JSON, one block per line",
+		Long: `Prints the sf.cardano.type.v1.Block payloads of a closed block range as Cardano
+JSON: hashes and policy ids in hex, addresses in bech32, Plutus data in the
+cardano-cli detailed schema and metadata in the detailed metadata schema.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			blockRange, err := types.GetBlockRangeFromFlag(cmd, "range")
+			if err != nil {
+				return err
+			}
+			if !blockRange.IsClosed() {
+				return fmt.Errorf("a closed block range is required, got %s", blockRange)
+			}
+
+			out := bufio.NewWriter(os.Stdout)
+			defer out.Flush()
+
+			return printJSON(cmd.Context(), out, args[0], blockRange, sflags.MustGetBool(cmd, "indent"))
+		},
+	}
+
+	cmd.Flags().StringP("range", "r", "", "Closed block range to print")
+	cmd.Flags().Bool("indent", false, "Indent the JSON output")
+
+	cmd.Example = firecore.ExamplePrefixed(chain, "tools print-json", `
+		"./firehose-data/storage/merged-blocks" -r "12_000_000:12_000_010"
+	`)
+
+	return cmd
+}
+
+func printJSON(ctx context.Context, out io.Writer, storeURL string, blockRange types.BlockRange, indent bool) error {
+	store, err := dstore.NewDBinStore(storeURL)
+	if err != nil {
+		return fmt.Errorf("unable to create store at %s: %w", storeURL, err)
+	}
+
+	return store.Walk(ctx, check.WalkBlockPrefix(blockRange, 100), func(filename string) error {
+		return readMergedBlocks(ctx, store, filename, func(block *pbbstream.Block) error {
+			if block.Number < uint64(blockRange.Start) {
+				return nil
+			}
+			if block.Number >= *blockRange.Stop {
+				return dstore.StopIteration
+			}
+
+			payload := &pbcardano.Block{}
+			if err := block.Payload.UnmarshalTo(payload); err != nil {
+				return fmt.Errorf("unable to decode block %s from %s: %w", block.AsRef(), filename, err)
+			}
+
+			data, err := pbcardano.MarshalJSON(payload)
+			if err != nil {
+				return fmt.Errorf("unable to render block %s: %w", block.AsRef(), err)
+			}
+			if indent {
+				buf := &bytes.Buffer{}
+				if err := json.Indent(buf, data, "", "  "); err != nil {
+					return err
+				}
+				data = buf.Bytes()
+			}
+
+			_, err = fmt.Fprintf(out, "%s\n", data)
+			return err
+		})
+	})
+}
@@ -0,0 +1,458 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"time"
+
+	ouroboros "github.com/blinklabs-io/gouroboros"
+	"github.com/blinklabs-io/gouroboros/ledger"
+	"github.com/blinklabs-io/gouroboros/protocol/chainsync"
+	"github.com/blinklabs-io/gouroboros/protocol/common"
+	"github.com/no-witness-labs/firehose-cardano/convert"
+	pbcardano "github.com/no-witness-labs/firehose-cardano/types/pb/sf/cardano/type/v1"
+	"github.com/spf13/cobra"
+	"github.com/streamingfast/bstream"
+	pbbstream "github.com/streamingfast/bstream/pb/sf/bstream/v1"
+	"github.com/streamingfast/cli/sflags"
+	"github.com/streamingfast/dstore"
+	firecore "github.com/streamingfast/firehose-core"
+	"github.com/streamingfast/firehose-core/cmd/tools/check"
+	"github.com/streamingfast/firehose-core/types"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+func newToolsVerifyAgainstNodeCmd(chain *firecore.Chain[*pbcardano.Block], logger *zap.Logger) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-against-node <merged-blocks-store-url>",
+		Short: "Re-fetches stored blocks from a Cardano node, converts them again and diffs them field by field",
+		Long: `Reads a closed block range from the merged blocks store, fetches the same points
+from a Cardano node and runs them through the current conversion. Every field
+that differs between the stored and the freshly converted block is reported,
+which surfaces conversion regressions (e.g. after a gouroboros upgrade) before
+they reach consumers. The command exits with a non-zero status if any block
+differs.
+
+Inputs resolved from a UTxO store, datums from a datum index and scripts from
+a script registry cannot be fetched from the node: they are taken from the
+stored block, the fields derived from them are verified.
+
+Over a TCP address (node-to-node) every block is fetched with block-fetch. Over
+a unix socket (node-to-client) chain-sync is used, intersecting at the first
+stored block of the range which is therefore not verified itself.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			blockRange, err := types.GetBlockRangeFromFlag(cmd, "range")
+			if err != nil {
+				return err
+			}
+			if !blockRange.IsResolved() {
+				return fmt.Errorf("a closed block range is required, got %s", blockRange)
+			}
+
+			source, err := newNodeBlockSource(nodeConfig{
+				Address:      sflags.MustGetString(cmd, "address"),
+				SocketPath:   sflags.MustGetString(cmd, "socket-path"),
+				Network:      sflags.MustGetString(cmd, "network"),
+				NetworkMagic: uint32(sflags.MustGetUint64(cmd, "network-magic")),
+			}, logger)
+			if err != nil {
+				return err
+			}
+			defer source.Close()
+
+			verifier := &nodeVerifier{
+				source:   source,
+				logger:   logger,
+				maxDiffs: sflags.MustGetInt(cmd, "max-diffs-per-block"),
+			}
+
+			return verifier.Verify(cmd.Context(), args[0], blockRange)
+		},
+	}
+
+	cmd.Flags().StringP("range", "r", "", "Closed block range to verify")
+	cmd.Flags().String("address", "", "Cardano node address to fetch blocks from over node-to-node (e.g., backbone.cardano.iog.io:3001)")
+	cmd.Flags().String("socket-path", "", "Unix socket path of a local node to fetch blocks from over node-to-client")
+	cmd.Flags().String("network", "mainnet", "Network: mainnet, preview, preprod")
+	cmd.Flags().Uint64("network-magic", 0, "Network magic number (0 = derived from --network)")
+	cmd.Flags().Int("max-diffs-per-block", 20, "Maximum number of differing fields printed per block (0 = all)")
+
+	cmd.Example = firecore.ExamplePrefixed(chain, "tools verify-against-node", `
+		"./firehose-data/storage/merged-blocks" -r "12_000_000:12_000_100" --address=backbone.cardano.iog.io:3001
+		"./firehose-data/storage/merged-blocks" -r "2_000_000:2_000_100" --socket-path=/ipc/node.socket --network=preprod
+	`)
+
+	return cmd
+}
+
+type nodeVerifier struct {
+	source   nodeBlockSource
+	logger   *zap.Logger
+	maxDiffs int
+
+	prevPoint      *common.Point
+	verifiedCount  int
+	differentCount int
+	skippedCount   int
+}
+
+func (v *nodeVerifier) Verify(ctx context.Context, storeURL string, blockRange types.BlockRange) error {
+	store, err := dstore.NewDBinStore(storeURL)
+	if err != nil {
+		return fmt.Errorf("unable to create store at %s: %w", storeURL, err)
+	}
+
+	fmt.Printf("Verifying blocks %s of %s against node\n", blockRange, storeURL)
+
+	err = store.Walk(ctx, check.WalkBlockPrefix(blockRange, 100), func(filename string) error {
+		return readMergedBlocks(ctx, store, filename, func(block *pbbstream.Block) error {
+			if block.Number < uint64(blockRange.Start) {
+				return nil
+			}
+			if block.Number >= *blockRange.Stop {
+				return dstore.StopIteration
+			}
+			return v.verifyBlock(filename, block)
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Println()
+	fmt.Println("Summary:")
+	fmt.Printf("> %d blocks verified, %d skipped\n", v.verifiedCount, v.skippedCount)
+	if v.differentCount == 0 {
+		fmt.Println("> 🆗 Stored blocks match the node conversion")
+		return nil
+	}
+
+	fmt.Printf("> 🆘 %d blocks differ from the node conversion\n", v.differentCount)
+	return fmt.Errorf("%d block(s) differ from the node conversion", v.differentCount)
+}
+
+func (v *nodeVerifier) verifyBlock(filename string, block *pbbstream.Block) error {
+	stored := &pbcardano.Block{}
+	if err := block.Payload.UnmarshalTo(stored); err != nil {
+		return fmt.Errorf("unable to decode block %s from %s: %w", block.AsRef(), filename, err)
+	}
+	if stored.Header == nil {
+		fmt.Printf("🔶 Block %s (%s) has no header, skipping\n", block.AsRef(), filename)
+		v.skippedCount++
+		return nil
+	}
+
+	point := common.NewPoint(stored.Header.Slot, stored.Header.Hash)
+	defer func() { v.prevPoint = &point }()
+
+	nodeBlock, err := v.source.Block(v.prevPoint, point)
+	if errors.Is(err, errNoIntersection) {
+		fmt.Printf("🔶 Block %s is used as chain-sync intersection, skipping\n", block.AsRef())
+		v.skippedCount++
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("unable to fetch block %s from node: %w", block.AsRef(), err)
+	}
+
+	// Compare raw CBOR too when the stored block was fetched with it.
+	var opts []convert.Option
+	if len(stored.OriginalCbor) > 0 {
+		opts = append(opts, convert.WithRawCBOR())
+	}
+	if slices.ContainsFunc(stored.GetBody().GetTx(), func(tx *pbcardano.Tx) bool { return len(tx.DecodedMetadata) > 0 }) {
+		opts = append(opts, convert.WithDecodedMetadata())
+	}
+	if slices.ContainsFunc(stored.GetBody().GetTx(), func(tx *pbcardano.Tx) bool { return len(tx.Cip68Tokens) > 0 }) {
+		opts = append(opts, convert.WithCIP68())
+	}
+	state := newStoredState(stored)
+	if state.resolved {
+		opts = append(opts, convert.WithUTxOResolver(state))
+	}
+	opts = append(opts, convert.WithDatumIndex(state), convert.WithScriptRegistry(state))
+
+	converted, err := convert.Block(nodeBlock, opts...)
+	if err != nil {
+		return fmt.Errorf("unable to convert node block %s: %w", block.AsRef(), err)
+	}
+	// Protocol parameters, epoch transitions and ledger snapshots are chain
+	// state the fetcher tracks, not part of the block.
+	converted.ProtocolParams, converted.Era = stored.ProtocolParams, stored.Era
+	converted.EpochTransition, converted.LedgerSnapshot = stored.EpochTransition, stored.LedgerSnapshot
+
+	v.verifiedCount++
+	diffs := protoDiff(stored, converted)
+	if len(diffs) == 0 {
+		v.logger.Debug("block matches node", zap.Stringer("block", block.AsRef()))
+		return nil
+	}
+
+	v.differentCount++
+	fmt.Printf("❌ Block %s (%s) differs in %d field(s)\n", block.AsRef(), filename, len(diffs))
+	for i, diff := range diffs {
+		if v.maxDiffs > 0 && i >= v.maxDiffs {
+			fmt.Printf("    ... %d more\n", len(diffs)-i)
+			break
+		}
+		fmt.Printf("    %s\n", diff)
+	}
+
+	return nil
+}
+
+// storedState serves the resolved inputs, datums and registry scripts of a
+// stored block back to its conversion. The fetcher got them from its UTxO
+// store, datum index and script registry, which the node cannot stand in
+// for: they are taken as stored rather than verified, the fields derived from
+// them (fees, ledger effects, statistics, CIP-68 updates) still are.
+type storedState struct {
+	resolved bool // Some input was resolved, the block was fetched with a UTxO store
+	outputs  map[string]*pbcardano.TxOutput
+	datums   map[string][]byte
+	scripts  map[string]*pbcardano.Script
+}
+
+func newStoredState(block *pbcardano.Block) *storedState {
+	s := &storedState{
+		outputs: map[string]*pbcardano.TxOutput{},
+		datums:  map[string][]byte{},
+		scripts: map[string]*pbcardano.Script{},
+	}
+	addDatum := func(output *pbcardano.TxOutput) {
+		if datum := output.GetDatum(); len(datum.GetOriginalCbor()) > 0 {
+			s.datums[string(datum.Hash)] = datum.OriginalCbor
+		}
+	}
+
+	for _, tx := range block.GetBody().GetTx() {
+		inputs := append(slices.Clone(tx.Inputs), tx.ReferenceInputs...)
+		for _, input := range append(inputs, tx.GetCollateral().GetCollateral()...) {
+			if input.AsOutput != nil {
+				s.resolved = true
+				s.outputs[outputKey(input.TxHash, input.OutputIndex)] = input.AsOutput
+			}
+		}
+
+		// Only the datums of the outputs of the block are looked up, the ones
+		// of resolved inputs come along with them.
+		for _, output := range tx.Outputs {
+			addDatum(output)
+		}
+		addDatum(tx.GetCollateral().GetCollateralReturn())
+
+		for _, script := range tx.ExecutedScripts {
+			if script.Origin == pbcardano.ScriptOrigin_SCRIPT_ORIGIN_REGISTRY {
+				s.scripts[string(script.Hash)] = script.Script
+			}
+		}
+	}
+	return s
+}
+
+func outputKey(txHash []byte, index uint32) string {
+	return fmt.Sprintf("%x#%d", txHash, index)
+}
+
+func (s *storedState) Output(txHash []byte, index uint32) (*pbcardano.TxOutput, error) {
+	output, ok := s.outputs[outputKey(txHash, index)]
+	if !ok {
+		return nil, nil
+	}
+	return proto.Clone(output).(*pbcardano.TxOutput), nil
+}
+
+func (s *storedState) Datum(hash []byte) ([]byte, error) {
+	return s.datums[string(hash)], nil
+}
+
+func (s *storedState) AddDatums([][]byte) error {
+	return nil
+}
+
+func (s *storedState) Script(hash []byte) (*pbcardano.Script, error) {
+	script, ok := s.scripts[string(hash)]
+	if !ok {
+		return nil, nil
+	}
+	return proto.Clone(script).(*pbcardano.Script), nil
+}
+
+func (s *storedState) AddScripts([]*pbcardano.Script) error {
+	return nil
+}
+
+func readMergedBlocks(ctx context.Context, store dstore.Store, filename string, f func(block *pbbstream.Block) error) error {
+	reader, err := store.OpenObject(ctx, filename)
+	if err != nil {
+		return fmt.Errorf("unable to open bundle %s: %w", filename, err)
+	}
+	defer reader.Close()
+
+	blockReader, err := bstream.NewDBinBlockReader(reader)
+	if err != nil {
+		return fmt.Errorf("unable to read bundle %s: %w", filename, err)
+	}
+
+	for {
+		block, err := blockReader.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("unable to read block from bundle %s: %w", filename, err)
+		}
+		if err := f(block); err != nil {
+			return err
+		}
+	}
+}
+
+type nodeConfig struct {
+	Address      string
+	SocketPath   string
+	Network      string
+	NetworkMagic uint32
+}
+
+var errNoIntersection = errors.New("no intersection point")
+
+// nodeBlockSource serves the node's block at a point. The point of the
+// previously requested block, if any, is given for sources that follow the
+// chain rather than fetching by point.
+type nodeBlockSource interface {
+	Block(prev *common.Point, point common.Point) (ledger.Block, error)
+	Close() error
+}
+
+func newNodeBlockSource(cfg nodeConfig, logger *zap.Logger) (nodeBlockSource, error) {
+	if cfg.NetworkMagic == 0 {
+		network, ok := ouroboros.NetworkByName(cfg.Network)
+		if !ok {
+			return nil, fmt.Errorf("invalid network specified: %s", cfg.Network)
+		}
+		cfg.NetworkMagic = network.NetworkMagic
+	}
+
+	switch {
+	case cfg.Address != "":
+		conn, err := dialNode(cfg, logger, "tcp", cfg.Address, true)
+		if err != nil {
+			return nil, err
+		}
+		return &blockFetchSource{conn: conn}, nil
+	case cfg.SocketPath != "":
+		source := &chainSyncSource{blocks: make(chan ledger.Block, 10), done: make(chan struct{})}
+		conn, err := dialNode(cfg, logger, "unix", cfg.SocketPath, false,
+			ouroboros.WithChainSyncConfig(chainsync.NewConfig(
+				chainsync.WithRollForwardFunc(source.rollForward),
+				chainsync.WithRollBackwardFunc(source.rollBackward),
+			)),
+		)
+		if err != nil {
+			return nil, err
+		}
+		source.conn = conn
+		return source, nil
+	}
+
+	return nil, errors.New("either --address or --socket-path is required")
+}
+
+func dialNode(cfg nodeConfig, logger *zap.Logger, protocol, address string, isN2N bool, opts ...ouroboros.ConnectionOptionFunc) (*ouroboros.Connection, error) {
+	errorChan := make(chan error, 1)
+	go func() {
+		for err := range errorChan {
+			logger.Warn("node connection error", zap.Error(err))
+		}
+	}()
+
+	conn, err := ouroboros.NewConnection(append([]ouroboros.ConnectionOptionFunc{
+		ouroboros.WithNetworkMagic(cfg.NetworkMagic),
+		ouroboros.WithErrorChan(errorChan),
+		ouroboros.WithNodeToNode(isN2N),
+		ouroboros.WithKeepAlive(true),
+	}, opts...)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create connection: %w", err)
+	}
+
+	if err := conn.Dial(protocol, address); err != nil {
+		return nil, fmt.Errorf("failed to dial [%s] %s: %w", protocol, address, err)
+	}
+
+	logger.Info("connected to node", zap.String("protocol", protocol), zap.String("address", address), zap.Uint32("network_magic", cfg.NetworkMagic))
+	return conn, nil
+}
+
+type blockFetchSource struct {
+	conn *ouroboros.Connection
+}
+
+func (s *blockFetchSource) Block(_ *common.Point, point common.Point) (ledger.Block, error) {
+	return s.conn.BlockFetch().Client.GetBlock(point)
+}
+
+func (s *blockFetchSource) Close() error {
+	return s.conn.Close()
+}
+
+type chainSyncSource struct {
+	conn    *ouroboros.Connection
+	blocks  chan ledger.Block
+	done    chan struct{} // Closed on Close, once no more blocks are read
+	started bool
+}
+
+func (s *chainSyncSource) Block(prev *common.Point, point common.Point) (ledger.Block, error) {
+	if !s.started {
+		if prev == nil {
+			return nil, errNoIntersection
+		}
+		if err := s.conn.ChainSync().Client.Sync([]common.Point{*prev}); err != nil {
+			return nil, fmt.Errorf("chain sync failed: %w", err)
+		}
+		s.started = true
+	}
+
+	select {
+	case block := <-s.blocks:
+		if hash := block.Hash(); !bytes.Equal(hash.Bytes(), point.Hash) {
+			return nil, fmt.Errorf("node followed a different chain: got block %s at slot %d", hex.EncodeToString(hash.Bytes()), block.SlotNumber())
+		}
+		return block, nil
+	case <-time.After(time.Minute):
+		return nil, fmt.Errorf("timed out waiting for block at slot %d", point.Slot)
+	}
+}
+
+func (s *chainSyncSource) rollForward(_ chainsync.CallbackContext, _ uint, blockData any, _ chainsync.Tip) error {
+	block, ok := blockData.(ledger.Block)
+	if !ok {
+		return fmt.Errorf("unexpected block data type: %T", blockData)
+	}
+	// The node keeps sending blocks past the requested range, the buffer
+	// fills up once it is over.
+	select {
+	case s.blocks <- block:
+		return nil
+	case <-s.done:
+		return chainsync.ErrStopSyncProcess
+	}
+}
+
+func (s *chainSyncSource) rollBackward(_ chainsync.CallbackContext, _ common.Point, _ chainsync.Tip) error {
+	return nil
+}
+
+func (s *chainSyncSource) Close() error {
+	close(s.done)
+	return s.conn.Close()
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/blinklabs-io/gouroboros/ledger"
+	"github.com/blinklabs-io/gouroboros/protocol/chainsync"
+	"github.com/blinklabs-io/gouroboros/protocol/common"
+	"github.com/no-witness-labs/firehose-cardano/convert"
+	"github.com/no-witness-labs/firehose-cardano/internal/nodetest"
+	pbcardano "github.com/no-witness-labs/firehose-cardano/types/pb/sf/cardano/type/v1"
+	pbbstream "github.com/streamingfast/bstream/pb/sf/bstream/v1"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// fixedResolver resolves every input to the same output.
+type fixedResolver struct{}
+
+func (fixedResolver) Output(txHash []byte, index uint32) (*pbcardano.TxOutput, error) {
+	return &pbcardano.TxOutput{Address: []byte{0x61}, Coin: 1_000_000 + uint64(index)}, nil
+}
+
+func TestVerifyAgainstNode(t *testing.T) {
+	block := nodetest.ConwayBlock(t)
+	node := &nodetest.Node{Chain: []ledger.Block{block}}
+	address := node.ListenTCP(t)
+
+	for _, tc := range []struct {
+		name   string
+		opts   []convert.Option
+		tamper func(*pbcardano.Block)
+		differ bool
+	}{
+		{name: "equal"},
+		{name: "raw CBOR", opts: []convert.Option{convert.WithRawCBOR()}},
+		{name: "resolved inputs", opts: []convert.Option{convert.WithUTxOResolver(fixedResolver{})}},
+		{name: "tampered fee", tamper: func(b *pbcardano.Block) { b.Body.Tx[0].Fee++ }, differ: true},
+		{name: "tampered output", tamper: func(b *pbcardano.Block) { b.Body.Tx[0].Outputs[0].Coin++ }, differ: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			stored, err := convert.Block(block, tc.opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tc.tamper != nil {
+				tc.tamper(stored)
+			}
+			payload, err := anypb.New(stored)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			source, err := newNodeBlockSource(nodeConfig{Address: address, NetworkMagic: nodetest.NetworkMagic}, zap.NewNop())
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer source.Close()
+			verifier := &nodeVerifier{source: source, logger: zap.NewNop()}
+
+			err = verifier.verifyBlock("bundle", &pbbstream.Block{
+				Number:  block.BlockNumber(),
+				Id:      block.Hash().String(),
+				Payload: payload,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if verifier.verifiedCount != 1 {
+				t.Fatalf("verified %d blocks", verifier.verifiedCount)
+			}
+			if differ := verifier.differentCount > 0; differ != tc.differ {
+				t.Errorf("block reported as differing: %v, expected %v", differ, tc.differ)
+			}
+		})
+	}
+}
+
+func TestChainSyncSourceClose(t *testing.T) {
+	// More blocks past the requested one than the source buffers.
+	block := nodetest.ConwayBlock(t)
+	node := &nodetest.Node{}
+	for range 20 {
+		node.Chain = append(node.Chain, block)
+	}
+	socket := node.ListenUnix(t)
+
+	source, err := newNodeBlockSource(nodeConfig{SocketPath: socket, NetworkMagic: nodetest.NetworkMagic}, zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+	origin := common.NewPointOrigin()
+	if _, err := source.Block(&origin, common.NewPoint(block.SlotNumber(), block.Hash().Bytes())); err != nil {
+		t.Fatal(err)
+	}
+	s := source.(*chainSyncSource)
+	for len(s.blocks) < cap(s.blocks) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if err := source.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// A block still arriving does not wait on the full buffer.
+	stopped := make(chan error, 1)
+	go func() { stopped <- s.rollForward(chainsync.CallbackContext{}, 0, block, chainsync.Tip{}) }()
+	select {
+	case err := <-stopped:
+		if !errors.Is(err, chainsync.ErrStopSyncProcess) {
+			t.Errorf("roll forward after close: %v", err)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("roll forward blocked after close")
+	}
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+
+	"github.com/no-witness-labs/firehose-cardano/utxorpc"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/streamingfast/bstream"
+	"github.com/streamingfast/bstream/blockstream"
+	"github.com/streamingfast/bstream/hub"
+	"github.com/streamingfast/dstore"
+	firecore "github.com/streamingfast/firehose-core"
+	"github.com/streamingfast/firehose-core/launcher"
+	"github.com/streamingfast/shutter"
+	"github.com/utxorpc/go-codegen/utxorpc/v1alpha/sync/syncconnect"
+	"github.com/utxorpc/go-codegen/utxorpc/v1alpha/watch/watchconnect"
+	"go.uber.org/zap"
+)
+
+func registerUtxorpcApp() {
+	launcher.RegisterApp(zlog, &launcher.AppDef{
+		ID:          "utxorpc",
+		Title:       "UTxO RPC",
+		Description: "Serves the UTxO RPC sync and watch services from the merged blocks and the relayer",
+		RegisterFlags: func(cmd *cobra.Command) error {
+			cmd.Flags().String("utxorpc-grpc-listen-addr", ":10020", "Address to listen for incoming UTxO RPC requests, gRPC, gRPC-Web and Connect over HTTP/2 cleartext or HTTP/1.1")
+			return nil
+		},
+		FactoryFunc: func(runtime *launcher.Runtime) (launcher.App, error) {
+			liveBlocksAddr := viper.GetString("common-live-blocks-addr")
+			if liveBlocksAddr == "" {
+				return nil, errors.New("--common-live-blocks-addr is required")
+			}
+
+			mergedBlocksStore, err := dstore.NewDBinStore(firecore.MustReplaceDataDir(runtime.AbsDataDir, viper.GetString("common-merged-blocks-store-url")))
+			if err != nil {
+				return nil, fmt.Errorf("failed to open merged blocks store: %w", err)
+			}
+			oneBlocksStore, err := dstore.NewDBinStore(firecore.MustReplaceDataDir(runtime.AbsDataDir, viper.GetString("common-one-block-store-url")))
+			if err != nil {
+				return nil, fmt.Errorf("failed to open one block store: %w", err)
+			}
+			var forkedBlocksStore dstore.Store
+			if url := viper.GetString("common-forked-blocks-store-url"); url != "" {
+				forkedBlocksStore, err = dstore.NewDBinStore(firecore.MustReplaceDataDir(runtime.AbsDataDir, url))
+				if err != nil {
+					return nil, fmt.Errorf("failed to open forked blocks store: %w", err)
+				}
+			}
+
+			return &utxorpcApp{
+				Shutter:           shutter.New(),
+				listenAddr:        viper.GetString("utxorpc-grpc-listen-addr"),
+				liveBlocksAddr:    liveBlocksAddr,
+				mergedBlocksStore: mergedBlocksStore,
+				oneBlocksStore:    oneBlocksStore,
+				forkedBlocksStore: forkedBlocksStore,
+				firstBlock:        viper.GetUint64("common-first-streamable-block"),
+			}, nil
+		},
+	})
+}
+
+type utxorpcApp struct {
+	*shutter.Shutter
+	listenAddr        string
+	liveBlocksAddr    string
+	mergedBlocksStore dstore.Store
+	oneBlocksStore    dstore.Store
+	forkedBlocksStore dstore.Store
+	firstBlock        uint64
+}
+
+func (a *utxorpcApp) Run() error {
+	listener, err := net.Listen("tcp", a.listenAddr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", a.listenAddr, err)
+	}
+
+	liveSourceFactory := bstream.SourceFactory(func(h bstream.Handler) bstream.Source {
+		return blockstream.NewSource(context.Background(), a.liveBlocksAddr, 2, h, blockstream.WithRequester("utxorpc"))
+	})
+	forkableHub := hub.NewForkableHub(liveSourceFactory, 500, a.oneBlocksStore)
+	forkableHub.OnTerminated(a.Shutdown)
+	go forkableHub.Run()
+
+	source := utxorpc.NewSource(a.mergedBlocksStore, a.forkedBlocksStore, forkableHub, a.firstBlock, zlog)
+	mux := http.NewServeMux()
+	mux.Handle(syncconnect.NewSyncServiceHandler(utxorpc.NewSyncServer(source)))
+	mux.Handle(watchconnect.NewWatchServiceHandler(utxorpc.NewWatchServer(source)))
+
+	// gRPC clients speak HTTP/2 without TLS.
+	protocols := new(http.Protocols)
+	protocols.SetHTTP1(true)
+	protocols.SetUnencryptedHTTP2(true)
+	server := &http.Server{Handler: mux, Protocols: protocols}
+	a.OnTerminating(func(error) {
+		server.Close()
+	})
+
+	zlog.Info("serving utxorpc", zap.String("listen_addr", a.listenAddr))
+	go func() {
+		err := server.Serve(listener)
+		if errors.Is(err, http.ErrServerClosed) {
+			err = nil
+		}
+		a.Shutdown(err)
+	}()
+	return nil
+}
@@ -0,0 +1,73 @@
+package convert
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/blinklabs-io/gouroboros/cbor"
+	"github.com/no-witness-labs/firehose-cardano/metadata"
+	pbcardano "github.com/no-witness-labs/firehose-cardano/types/pb/sf/cardano/type/v1"
+)
+
+// WithDecodedMetadata decodes the metadata records of the CIPs the metadata
+// package knows (CIP-20 messages, CIP-25 NFT metadata and CIP-36 vote
+// registrations) into the decoded_metadata of each transaction.
+func WithDecodedMetadata() Option {
+	return func(o *options) {
+		o.decodeMetadata = true
+	}
+}
+
+// auxiliaryMetadataKey is the key of the metadata in the tagged auxiliary data
+// map of Alonzo onwards.
+const auxiliaryMetadataKey = 0
+
+// auxiliaryData decodes serialized auxiliary data: the metadata map of
+// Shelley, [metadata, native scripts] from Allegra and a tagged map from
+// Alonzo. It returns nil when the transaction has none.
+func auxiliaryData(auxCbor []byte) (*pbcardano.AuxData, error) {
+	if len(auxCbor) == 0 || bytes.Equal(auxCbor, cborNull) {
+		return nil, nil
+	}
+
+	var rawMetadata []byte
+	out := &pbcardano.AuxData{}
+	switch auxCbor[0] >> 5 {
+	case 5:
+		rawMetadata = auxCbor
+	case 4:
+		var parts []cbor.RawMessage
+		if _, err := cbor.Decode(auxCbor, &parts); err != nil {
+			return nil, fmt.Errorf("failed to decode auxiliary data: %w", err)
+		}
+		if len(parts) > 0 {
+			rawMetadata = parts[0]
+		}
+		if len(parts) > 1 {
+			var err error
+			if out.Scripts, err = scriptList(scriptTypeNative, parts[1]); err != nil {
+				return nil, err
+			}
+		}
+	case 6:
+		var fields map[uint]cbor.RawMessage
+		if _, err := cbor.Decode(auxCbor, &fields); err != nil {
+			return nil, fmt.Errorf("failed to decode auxiliary data: %w", err)
+		}
+		rawMetadata = fields[auxiliaryMetadataKey]
+		var err error
+		if out.Scripts, err = scriptFields(fields, auxiliaryScriptKeys); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unexpected auxiliary data major type %d", auxCbor[0]>>5)
+	}
+
+	if rawMetadata != nil {
+		var err error
+		if out.Metadata, err = metadata.Decode(rawMetadata); err != nil {
+			return nil, fmt.Errorf("failed to decode metadata: %w", err)
+		}
+	}
+	return out, nil
+}
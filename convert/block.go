@@ -0,0 +1,210 @@
+package convert
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/blinklabs-io/gouroboros/ledger"
+	"github.com/blinklabs-io/gouroboros/ledger/byron"
+	pbcardano "github.com/no-witness-labs/firehose-cardano/types/pb/sf/cardano/type/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// Option configures the conversion of a block.
+type Option func(*options)
+
+type options struct {
+	rawCBOR        bool
+	datumIndex     DatumIndex
+	utxoResolver   UTxOResolver
+	scriptRegistry ScriptRegistry
+	decodeMetadata bool
+	cip68          bool
+}
+
+// WithRawCBOR embeds the original CBOR of the block and of each transaction's
+// body, witness set and auxiliary data in the converted block.
+func WithRawCBOR() Option {
+	return func(o *options) {
+		o.rawCBOR = true
+	}
+}
+
+// Block converts a ledger block into its sf.cardano.type.v1.Block
+// representation.
+//
+// The conversion starts from the utxorpc representation produced by
+// gouroboros, which is wire compatible with our schema, then fills what it
+// leaves out from the ledger transactions.
+func Block(block ledger.Block, opts ...Option) (*pbcardano.Block, error) {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	utxoBlock, err := block.Utxorpc()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get UTXO RPC: %w", err)
+	}
+
+	out := &pbcardano.Block{}
+	if err := fromUtxorpc(utxoBlock, out); err != nil {
+		return nil, err
+	}
+	if o.rawCBOR {
+		out.OriginalCbor = bytes.Clone(block.Cbor())
+	}
+
+	datums, err := txDatums(block.Transactions(), o.datumIndex)
+	if err != nil {
+		return nil, err
+	}
+	state := newBlockState(datums, o)
+
+	// Byron blocks have no utxorpc representation, utxorpc transactions
+	// otherwise follow the ledger ones.
+	switch b := block.(type) {
+	case *byron.ByronMainBlock:
+		if err := byronMainBlock(b, out, o, state); err != nil {
+			return nil, err
+		}
+	case *byron.ByronEpochBoundaryBlock:
+		byronEpochBoundaryBlock(b, out)
+	default:
+		txs := block.Transactions()
+		for i, tx := range out.GetBody().GetTx() {
+			if i >= len(txs) {
+				break
+			}
+			if err := enrichTx(txs[i], i, tx, o, state); err != nil {
+				return nil, fmt.Errorf("failed to convert transaction %d: %w", i, err)
+			}
+		}
+	}
+
+	out.Stats = blockStats(out.GetBody().GetTx())
+
+	if o.scriptRegistry != nil && len(state.newScripts) > 0 {
+		if err := o.scriptRegistry.AddScripts(state.newScripts); err != nil {
+			return nil, fmt.Errorf("failed to register scripts: %w", err)
+		}
+	}
+
+	return out, nil
+}
+
+// Tx converts a transaction outside of any block, a pending one. Its datums
+// and scripts are looked up in the index and registry options but not added
+// to them, which only learn from blocks.
+func Tx(tx ledger.Transaction, opts ...Option) (*pbcardano.Tx, error) {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	utxoTx, err := tx.Utxorpc()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get UTXO RPC: %w", err)
+	}
+	out := &pbcardano.Tx{}
+	if err := fromUtxorpc(utxoTx, out); err != nil {
+		return nil, err
+	}
+
+	datums, err := txDatums([]ledger.Transaction{tx}, nil)
+	if err != nil {
+		return nil, err
+	}
+	datums.index = o.datumIndex
+	if err := enrichTx(tx, 0, out, o, newBlockState(datums, o)); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// txTypes are the transaction types a pending transaction can have, latest
+// era first: transactions valid in several eras decode as the latest one.
+var txTypes = []uint{
+	ledger.TxTypeConway,
+	ledger.TxTypeBabbage,
+	ledger.TxTypeAlonzo,
+	ledger.TxTypeMary,
+	ledger.TxTypeAllegra,
+	ledger.TxTypeShelley,
+}
+
+// DecodeTx decodes a transaction given without its era, as mempools and
+// submitters give them. The era of the decoded transaction is its Type().
+func DecodeTx(data []byte) (ledger.Transaction, error) {
+	for _, txType := range txTypes {
+		if tx, err := ledger.NewTransactionFromCbor(txType, data); err == nil {
+			return tx, nil
+		}
+	}
+	return nil, fmt.Errorf("failed to decode transaction %x", data)
+}
+
+// blockState is what the conversion of a transaction learns for the
+// following ones of its block.
+type blockState struct {
+	datums *datums
+
+	// Scripts seen in the block so far, by hash, and the ones the registry
+	// is to be fed.
+	scripts    map[string]*pbcardano.Script
+	newScripts []*pbcardano.Script
+	registry   ScriptRegistry
+
+	// Outputs produced in the block so far, by input key.
+	outputs  map[string]*pbcardano.TxOutput
+	resolver UTxOResolver
+}
+
+func newBlockState(datums *datums, o *options) *blockState {
+	return &blockState{
+		datums:   datums,
+		scripts:  map[string]*pbcardano.Script{},
+		registry: o.scriptRegistry,
+		outputs:  map[string]*pbcardano.TxOutput{},
+		resolver: o.utxoResolver,
+	}
+}
+
+func (s *blockState) addScripts(scripts ...*pbcardano.Script) {
+	for _, script := range scripts {
+		if script == nil {
+			continue
+		}
+		if _, ok := s.scripts[string(script.Hash)]; !ok {
+			s.scripts[string(script.Hash)] = script
+			s.newScripts = append(s.newScripts, script)
+		}
+	}
+}
+
+func (s *blockState) script(hash []byte) (*pbcardano.Script, error) {
+	if script, ok := s.scripts[string(hash)]; ok {
+		return script, nil
+	}
+	if s.registry == nil {
+		return nil, nil
+	}
+	script, err := s.registry.Script(hash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up script %x: %w", hash, err)
+	}
+	return script, nil
+}
+
+// fromUtxorpc copies a utxorpc message into its wire compatible
+// sf.cardano.type.v1 counterpart.
+func fromUtxorpc(in, out proto.Message) error {
+	data, err := proto.Marshal(in)
+	if err != nil {
+		return fmt.Errorf("failed to marshal UTXO RPC %s: %w", in.ProtoReflect().Descriptor().Name(), err)
+	}
+	if err := proto.Unmarshal(data, out); err != nil {
+		return fmt.Errorf("failed to unmarshal UTXO RPC %s: %w", in.ProtoReflect().Descriptor().Name(), err)
+	}
+	return nil
+}
@@ -0,0 +1,458 @@
+package convert
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/blinklabs-io/gouroboros/cbor"
+	"github.com/blinklabs-io/gouroboros/ledger/byron"
+	pbcardano "github.com/no-witness-labs/firehose-cardano/types/pb/sf/cardano/type/v1"
+	"golang.org/x/crypto/blake2b"
+)
+
+// Byron witness types; script witnesses were never used on chain.
+const (
+	byronWitnessPubKey = 0
+	byronWitnessRedeem = 2
+)
+
+// byronMainBlock fills a block from a Byron main block, which gouroboros does
+// not convert to utxorpc: its header, its transactions and its delegation
+// and update payloads.
+func byronMainBlock(block *byron.ByronMainBlock, out *pbcardano.Block, o *options, state *blockState) error {
+	header := block.BlockHeader
+	out.Header = &pbcardano.BlockHeader{
+		Slot:   block.SlotNumber(),
+		Hash:   block.Hash().Bytes(),
+		Height: block.BlockNumber(),
+	}
+	out.Byron = &pbcardano.ByronBlock{
+		ProtocolMagic: header.ProtocolMagic,
+		PrevHash:      header.PrevBlock.Bytes(),
+		Epoch:         header.ConsensusData.SlotId.Epoch,
+		SlotInEpoch:   uint32(header.ConsensusData.SlotId.Slot),
+		IssuerKey:     bytes.Clone(header.ConsensusData.PubKey),
+		BlockVersion: &pbcardano.ByronBlockVersion{
+			Major: uint32(header.ExtraData.BlockVersion.Major),
+			Minor: uint32(header.ExtraData.BlockVersion.Minor),
+			Alt:   uint32(header.ExtraData.BlockVersion.Unknown),
+		},
+		SoftwareVersion: &pbcardano.ByronSoftwareVersion{
+			ApplicationName: header.ExtraData.SoftwareVersion.Name,
+			Version:         header.ExtraData.SoftwareVersion.Version,
+		},
+	}
+
+	// The body is [tx payload, ssc payload, delegation payload, update
+	// payload], each transaction of the tx payload being [tx, witnesses].
+	var body struct {
+		cbor.StructAsArray
+		TxPayload  []cbor.RawMessage
+		SscPayload cbor.RawMessage
+		DlgPayload []cbor.RawMessage
+		UpdPayload struct {
+			cbor.StructAsArray
+			Proposals []cbor.RawMessage
+			Votes     []cbor.RawMessage
+		}
+	}
+	if _, err := cbor.Decode(block.Body.Cbor(), &body); err != nil {
+		return fmt.Errorf("failed to decode Byron block body: %w", err)
+	}
+
+	txs := block.Transactions()
+	if len(txs) != len(body.TxPayload) {
+		return fmt.Errorf("Byron block body has %d transactions, %d decoded", len(body.TxPayload), len(txs))
+	}
+	out.Body = &pbcardano.BlockBody{}
+	for i, raw := range body.TxPayload {
+		tx, err := byronTx(txs[i].(*byron.ByronTransaction), raw, i, o, state)
+		if err != nil {
+			return fmt.Errorf("failed to convert transaction %d: %w", i, err)
+		}
+		out.Body.Tx = append(out.Body.Tx, tx)
+	}
+
+	for i, raw := range body.DlgPayload {
+		var cert struct {
+			cbor.StructAsArray
+			Epoch       uint64
+			IssuerKey   []byte
+			DelegateKey []byte
+			Signature   []byte
+		}
+		if _, err := cbor.Decode(raw, &cert); err != nil {
+			return fmt.Errorf("failed to decode delegation certificate %d: %w", i, err)
+		}
+		out.Byron.DelegationCertificates = append(out.Byron.DelegationCertificates, &pbcardano.ByronDelegationCertificate{
+			Epoch:       cert.Epoch,
+			IssuerKey:   cert.IssuerKey,
+			DelegateKey: cert.DelegateKey,
+			Signature:   cert.Signature,
+		})
+	}
+
+	for i, raw := range body.UpdPayload.Proposals {
+		proposal, err := byronUpdateProposal(raw)
+		if err != nil {
+			return fmt.Errorf("failed to decode update proposal %d: %w", i, err)
+		}
+		out.Byron.UpdateProposals = append(out.Byron.UpdateProposals, proposal)
+	}
+	for i, raw := range body.UpdPayload.Votes {
+		var vote struct {
+			cbor.StructAsArray
+			VoterKey   []byte
+			ProposalID []byte
+			Approve    bool
+			Signature  []byte
+		}
+		if _, err := cbor.Decode(raw, &vote); err != nil {
+			return fmt.Errorf("failed to decode update vote %d: %w", i, err)
+		}
+		out.Byron.UpdateVotes = append(out.Byron.UpdateVotes, &pbcardano.ByronUpdateVote{
+			VoterKey:   vote.VoterKey,
+			ProposalId: vote.ProposalID,
+			Approve:    vote.Approve,
+			Signature:  vote.Signature,
+		})
+	}
+	return nil
+}
+
+// byronEpochBoundaryBlock fills a block from a Byron epoch boundary block,
+// whose body only lists the slot leaders of the epoch.
+func byronEpochBoundaryBlock(block *byron.ByronEpochBoundaryBlock, out *pbcardano.Block) {
+	header := block.BlockHeader
+	out.Header = &pbcardano.BlockHeader{
+		Slot:   block.SlotNumber(),
+		Hash:   block.Hash().Bytes(),
+		Height: block.BlockNumber(),
+	}
+	out.Byron = &pbcardano.ByronBlock{
+		EpochBoundary: true,
+		ProtocolMagic: header.ProtocolMagic,
+		PrevHash:      header.PrevBlock.Bytes(),
+		Epoch:         header.ConsensusData.Epoch,
+	}
+	for _, leader := range block.Body {
+		out.Byron.EpochLeaders = append(out.Byron.EpochLeaders, leader.Bytes())
+	}
+}
+
+// byronTx converts a Byron transaction given the [tx, witnesses] item of the
+// block body holding it. Byron fees are implicit: they are only known when all
+// inputs are resolved.
+func byronTx(tx *byron.ByronTransaction, raw []byte, index int, o *options, state *blockState) (*pbcardano.Tx, error) {
+	var parts []cbor.RawMessage
+	if _, err := cbor.Decode(raw, &parts); err != nil {
+		return nil, fmt.Errorf("failed to decode Byron transaction: %w", err)
+	}
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Byron transaction has %d parts", len(parts))
+	}
+	var witnesses []cbor.RawMessage
+	if _, err := cbor.Decode(parts[1], &witnesses); err != nil {
+		return nil, fmt.Errorf("failed to decode Byron witnesses: %w", err)
+	}
+
+	out := &pbcardano.Tx{
+		Hash:       tx.Hash().Bytes(),
+		Index:      uint32(index),
+		Size:       uint64(len(raw)),
+		Successful: true,
+	}
+	if o.rawCBOR {
+		out.OriginalBodyCbor = bytes.Clone(parts[0])
+		out.OriginalWitnessesCbor = bytes.Clone(parts[1])
+	}
+
+	for _, input := range tx.Inputs() {
+		out.Inputs = append(out.Inputs, &pbcardano.TxInput{
+			TxHash:      input.Id().Bytes(),
+			OutputIndex: input.Index(),
+		})
+	}
+	for _, output := range tx.Outputs() {
+		address, err := output.Address().Bytes()
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode output address: %w", err)
+		}
+		out.Outputs = append(out.Outputs, &pbcardano.TxOutput{
+			Address:      address,
+			Coin:         output.Amount(),
+			ByronAddress: byronAddress(address),
+		})
+	}
+
+	for i, rawWitness := range witnesses {
+		witness, err := byronWitness(rawWitness)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode witness %d: %w", i, err)
+		}
+		if witness == nil {
+			continue
+		}
+		if out.Witnesses == nil {
+			out.Witnesses = &pbcardano.WitnessSet{}
+		}
+		out.Witnesses.BootstrapWitnesses = append(out.Witnesses.BootstrapWitnesses, witness)
+	}
+
+	if err := state.resolveInputs(out); err != nil {
+		return nil, err
+	}
+	var in, produced uint64
+	resolved := true
+	for _, input := range out.Inputs {
+		if input.AsOutput == nil {
+			resolved = false
+			break
+		}
+		in += input.AsOutput.Coin
+	}
+	for _, output := range out.Outputs {
+		produced += output.Coin
+	}
+	if resolved && in >= produced {
+		out.Fee = in - produced
+	}
+
+	out.Effect = ledgerEffect(out)
+	if !resolved || in < produced {
+		out.Effect.Fee = nil
+	}
+	state.addOutputs(out)
+	return out, nil
+}
+
+// byronWitness decodes a Byron transaction witness,
+// [type, #6.24(bytes .cbor [key, signature])]. Script witnesses, which were
+// never used, are skipped.
+func byronWitness(raw []byte) (*pbcardano.BootstrapWitness, error) {
+	var witness struct {
+		cbor.StructAsArray
+		Type uint
+		Data []byte
+	}
+	if _, err := cbor.Decode(raw, &witness); err != nil {
+		return nil, err
+	}
+	if witness.Type != byronWitnessPubKey && witness.Type != byronWitnessRedeem {
+		return nil, nil
+	}
+
+	var data struct {
+		cbor.StructAsArray
+		Key       []byte
+		Signature []byte
+	}
+	if _, err := cbor.Decode(witness.Data, &data); err != nil {
+		return nil, err
+	}
+	out := &pbcardano.BootstrapWitness{Signature: data.Signature}
+	if witness.Type == byronWitnessRedeem {
+		out.Vkey = data.Key
+		out.Redeem = true
+		return out, nil
+	}
+	// Public key witnesses carry extended keys: key then chain code.
+	if len(data.Key) != 64 {
+		return nil, fmt.Errorf("extended public key is %d bytes", len(data.Key))
+	}
+	out.Vkey, out.ChainCode = data.Key[:32], data.Key[32:]
+	return out, nil
+}
+
+// bootstrapWitnesses returns the bootstrap witnesses of a serialized
+// Shelley-era witness set.
+func bootstrapWitnesses(witnessCbor []byte) ([]*pbcardano.BootstrapWitness, error) {
+	var fields map[uint]cbor.RawMessage
+	if _, err := cbor.Decode(witnessCbor, &fields); err != nil {
+		return nil, fmt.Errorf("failed to decode witness set: %w", err)
+	}
+	raw, ok := fields[witnessBootstrap]
+	if !ok {
+		return nil, nil
+	}
+
+	var witnesses []struct {
+		cbor.StructAsArray
+		Vkey       []byte
+		Signature  []byte
+		ChainCode  []byte
+		Attributes []byte
+	}
+	if _, err := cbor.Decode(raw, &witnesses); err != nil {
+		return nil, fmt.Errorf("failed to decode bootstrap witnesses: %w", err)
+	}
+	var out []*pbcardano.BootstrapWitness
+	for _, w := range witnesses {
+		out = append(out, &pbcardano.BootstrapWitness{
+			Vkey:       w.Vkey,
+			Signature:  w.Signature,
+			ChainCode:  w.ChainCode,
+			Attributes: w.Attributes,
+		})
+	}
+	return out, nil
+}
+
+// witnessBootstrap is the witness set key of bootstrap witnesses.
+const witnessBootstrap = 2
+
+// byronAddress decodes a Byron address, nil for other addresses.
+func byronAddress(raw []byte) *pbcardano.ByronAddress {
+	// Byron addresses are CBOR arrays, read as header type 8.
+	if len(raw) == 0 || raw[0]>>4 != 0x8 {
+		return nil
+	}
+	address, err := pbcardano.DecodeAddress(raw)
+	if err != nil || address.Type != pbcardano.AddressTypeByron {
+		return nil
+	}
+
+	out := &pbcardano.ByronAddress{
+		Root: address.ByronRoot,
+		Type: uint32(address.ByronType),
+	}
+	if attributes := address.ByronAttributes; attributes != nil {
+		out.DerivationPath = attributes.DerivationPath
+		out.NetworkMagic = attributes.NetworkMagic
+	}
+	return out
+}
+
+// byronUpdateProposal decodes a Byron update proposal, whose id is the hash of
+// its serialization.
+func byronUpdateProposal(raw []byte) (*pbcardano.ByronUpdateProposal, error) {
+	var proposal struct {
+		cbor.StructAsArray
+		BlockVersion struct {
+			cbor.StructAsArray
+			Major, Minor uint16
+			Alt          uint8
+		}
+		Modification    []cbor.RawMessage
+		SoftwareVersion struct {
+			cbor.StructAsArray
+			Name    string
+			Version uint32
+		}
+		Data map[string]struct {
+			cbor.StructAsArray
+			AppDiffHash  []byte
+			PkgHash      []byte
+			UpdaterHash  []byte
+			MetadataHash []byte
+		}
+		Attributes  cbor.RawMessage
+		ProposerKey []byte
+		Signature   []byte
+	}
+	if _, err := cbor.Decode(raw, &proposal); err != nil {
+		return nil, err
+	}
+
+	id := blake2b.Sum256(raw)
+	out := &pbcardano.ByronUpdateProposal{
+		Id: id[:],
+		BlockVersion: &pbcardano.ByronBlockVersion{
+			Major: uint32(proposal.BlockVersion.Major),
+			Minor: uint32(proposal.BlockVersion.Minor),
+			Alt:   uint32(proposal.BlockVersion.Alt),
+		},
+		SoftwareVersion: &pbcardano.ByronSoftwareVersion{
+			ApplicationName: proposal.SoftwareVersion.Name,
+			Version:         proposal.SoftwareVersion.Version,
+		},
+		ProposerKey: proposal.ProposerKey,
+		Signature:   proposal.Signature,
+	}
+
+	var err error
+	if out.BlockVersionModification, err = byronBlockVersionModification(proposal.Modification); err != nil {
+		return nil, err
+	}
+	for tag, data := range proposal.Data {
+		out.Data = append(out.Data, &pbcardano.ByronSystemUpdate{
+			SystemTag:    tag,
+			AppDiffHash:  data.AppDiffHash,
+			PkgHash:      data.PkgHash,
+			UpdaterHash:  data.UpdaterHash,
+			MetadataHash: data.MetadataHash,
+		})
+	}
+	slices.SortFunc(out.Data, func(a, b *pbcardano.ByronSystemUpdate) int {
+		return strings.Compare(a.SystemTag, b.SystemTag)
+	})
+	return out, nil
+}
+
+// byronBlockVersionModification decodes the parameters changed by an update
+// proposal, each field being an empty list when kept, a single item list
+// otherwise.
+func byronBlockVersionModification(fields []cbor.RawMessage) (*pbcardano.ByronBlockVersionModification, error) {
+	if len(fields) != 14 {
+		return nil, fmt.Errorf("block version modification has %d fields", len(fields))
+	}
+
+	out := &pbcardano.ByronBlockVersionModification{}
+	uints := []**uint64{
+		1: &out.SlotDuration, 2: &out.MaxBlockSize, 3: &out.MaxHeaderSize,
+		4: &out.MaxTxSize, 5: &out.MaxProposalSize, 6: &out.MpcThd,
+		7: &out.HeavyDelThd, 8: &out.UpdateVoteThd, 9: &out.UpdateProposalThd,
+		10: &out.UpdateImplicit, 13: &out.UnlockStakeEpoch,
+	}
+	for i, field := range fields {
+		var items []cbor.RawMessage
+		if _, err := cbor.Decode(field, &items); err != nil {
+			return nil, fmt.Errorf("failed to decode block version modification field %d: %w", i, err)
+		}
+		if len(items) == 0 {
+			continue
+		}
+
+		var err error
+		switch i {
+		case 0:
+			var version uint32
+			_, err = cbor.Decode(items[0], &version)
+			out.ScriptVersion = &version
+		case 11:
+			var rule struct {
+				cbor.StructAsArray
+				InitThd, MinThd, ThdDecrement uint64
+			}
+			_, err = cbor.Decode(items[0], &rule)
+			out.SoftforkRule = &pbcardano.ByronSoftforkRule{InitThd: rule.InitThd, MinThd: rule.MinThd, ThdDecrement: rule.ThdDecrement}
+		case 12:
+			// [0, #6.24(bytes .cbor [summand, multiplier])], 0 being the
+			// linear policy, the only one defined.
+			var policy struct {
+				cbor.StructAsArray
+				Type uint
+				Data []byte
+			}
+			if _, err = cbor.Decode(items[0], &policy); err != nil {
+				break
+			}
+			var coefficients struct {
+				cbor.StructAsArray
+				Summand, Multiplier uint64
+			}
+			_, err = cbor.Decode(policy.Data, &coefficients)
+			out.TxFeePolicy = &pbcardano.ByronTxFeePolicy{Summand: coefficients.Summand, Multiplier: coefficients.Multiplier}
+		default:
+			var value uint64
+			_, err = cbor.Decode(items[0], &value)
+			*uints[i] = &value
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode block version modification field %d: %w", i, err)
+		}
+	}
+	return out, nil
+}
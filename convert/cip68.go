@@ -0,0 +1,133 @@
+package convert
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/no-witness-labs/firehose-cardano/metadata"
+	pbcardano "github.com/no-witness-labs/firehose-cardano/types/pb/sf/cardano/type/v1"
+)
+
+// WithCIP68 reports the CIP-68 tokens each transaction mints, burns or
+// outputs, and decodes the reference datum of the reference NFTs it moves to
+// outputs with a new datum.
+//
+// Telling a move that keeps the datum apart takes the spent input holding the
+// reference NFT; without WithUTxOResolver, every move is reported.
+func WithCIP68() Option {
+	return func(o *options) {
+		o.cip68 = true
+	}
+}
+
+// fillCIP68 sets the CIP-68 tokens and metadata updates of a transaction,
+// following its ledger effect: transactions whose scripts failed mint nothing
+// and only create their collateral return.
+func fillCIP68(out *pbcardano.Tx) {
+	tokens := map[string]*pbcardano.Cip68Token{}
+	token := func(policyID, name []byte) *pbcardano.Cip68Token {
+		label, ok := metadata.AssetLabel(name)
+		if !ok {
+			return nil
+		}
+		key := string(policyID) + string(name)
+		if t, ok := tokens[key]; ok {
+			return t
+		}
+		t := &pbcardano.Cip68Token{
+			PolicyId:           policyID,
+			AssetName:          name,
+			Label:              label,
+			ReferenceAssetName: metadata.LabelAssetName(name, metadata.LabelCIP68Reference),
+		}
+		tokens[key] = t
+		out.Cip68Tokens = append(out.Cip68Tokens, t)
+		return t
+	}
+
+	if out.BodyApplied() {
+		for _, policy := range out.Mint {
+			for _, asset := range policy.Assets {
+				if t := token(policy.PolicyId, asset.Name); t != nil {
+					t.MintCoin += asset.MintCoin
+				}
+			}
+		}
+	}
+	var references []referenceNFT
+	for _, created := range out.GetEffect().GetCreated() {
+		output := out.CreatedOutput(created.Index)
+		for _, policy := range output.GetAssets() {
+			for _, asset := range policy.Assets {
+				t := token(policy.PolicyId, asset.Name)
+				if t == nil {
+					continue
+				}
+				t.OutputIndexes = append(t.OutputIndexes, created.Index)
+				if t.Label == metadata.LabelCIP68Reference {
+					references = append(references, referenceNFT{t, created.Index, output})
+				}
+			}
+		}
+	}
+
+	for _, ref := range references {
+		if !ref.datumChanged(out.SpentInputs()) {
+			continue
+		}
+		update := &pbcardano.Cip68MetadataUpdate{
+			PolicyId:    ref.token.PolicyId,
+			AssetName:   ref.token.AssetName,
+			OutputIndex: ref.outputIndex,
+			TokenLabel:  userTokenLabel(out.Cip68Tokens, ref.token),
+			Minted:      ref.token.MintCoin > 0,
+			DatumHash:   ref.output.GetDatum().GetHash(),
+		}
+		var err error
+		update.Metadata, err = metadata.DecodeCIP68(ref.output.GetDatum().GetPayload(), update.TokenLabel)
+		var errs metadata.ValidationErrors
+		if errors.As(err, &errs) {
+			for _, e := range errs {
+				update.ValidationErrors = append(update.ValidationErrors, e.Error())
+			}
+		}
+		out.Cip68Updates = append(out.Cip68Updates, update)
+	}
+}
+
+// referenceNFT is a reference NFT held by an output.
+type referenceNFT struct {
+	token       *pbcardano.Cip68Token
+	outputIndex uint32
+	output      *pbcardano.TxOutput
+}
+
+// datumChanged tells whether the output holding a reference NFT has another
+// datum than the spent input it comes from. Minted NFTs and NFTs from
+// unresolved inputs count as changed.
+func (r referenceNFT) datumChanged(inputs []*pbcardano.TxInput) bool {
+	for _, input := range inputs {
+		for _, policy := range input.GetAsOutput().GetAssets() {
+			if !bytes.Equal(policy.PolicyId, r.token.PolicyId) {
+				continue
+			}
+			for _, asset := range policy.Assets {
+				if bytes.Equal(asset.Name, r.token.AssetName) {
+					return !bytes.Equal(input.AsOutput.GetDatum().GetHash(), r.output.GetDatum().GetHash())
+				}
+			}
+		}
+	}
+	return true
+}
+
+// userTokenLabel returns the label of the user token of a reference NFT among
+// the tokens of its transaction, 0 when there is none.
+func userTokenLabel(tokens []*pbcardano.Cip68Token, reference *pbcardano.Cip68Token) uint32 {
+	for _, t := range tokens {
+		if t.Label != metadata.LabelCIP68Reference && bytes.Equal(t.PolicyId, reference.PolicyId) && bytes.Equal(t.ReferenceAssetName, reference.AssetName) {
+			return t.Label
+		}
+	}
+	return 0
+}
@@ -0,0 +1,164 @@
+package convert
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/blinklabs-io/gouroboros/ledger"
+	"github.com/blinklabs-io/gouroboros/ledger/common"
+	"github.com/no-witness-labs/firehose-cardano/plutusdata"
+	pbcardano "github.com/no-witness-labs/firehose-cardano/types/pb/sf/cardano/type/v1"
+)
+
+// DatumIndex maps datum hashes to datums. Outputs locked by a datum hash
+// usually reveal their datum in a later transaction, or in an unrelated one,
+// the index keeps the datums seen so far so that conversion can fill them in.
+type DatumIndex interface {
+	// Datum returns the CBOR of the datum with the given hash, nil when it is
+	// unknown.
+	Datum(hash []byte) ([]byte, error)
+	// AddDatums records CBOR-encoded datums.
+	AddDatums(datums [][]byte) error
+}
+
+// WithDatumIndex fills output datums from index, and feeds it the datums of
+// each converted block.
+func WithDatumIndex(index DatumIndex) Option {
+	return func(o *options) {
+		o.datumIndex = index
+	}
+}
+
+// datums resolves datum hashes, first against the datums of the block being
+// converted then against the index.
+type datums struct {
+	block map[string][]byte
+	index DatumIndex
+}
+
+// txDatums collects the datums of the transactions of a block: the ones of
+// witness sets and the inline datums of outputs, collateral returns included.
+// They are added to index when given.
+func txDatums(txs []ledger.Transaction, index DatumIndex) (*datums, error) {
+	d := &datums{block: map[string][]byte{}, index: index}
+	var all [][]byte
+	add := func(data []byte) {
+		key := string(plutusdata.Hash(data))
+		if _, ok := d.block[key]; !ok {
+			d.block[key] = data
+			all = append(all, data)
+		}
+	}
+
+	for _, tx := range txs {
+		if witnesses := tx.Witnesses(); witnesses != nil {
+			for _, datum := range witnesses.PlutusData() {
+				add(datum.Cbor())
+			}
+		}
+		outputs := tx.Outputs()
+		if ret := tx.CollateralReturn(); ret != nil {
+			outputs = append(outputs[:len(outputs):len(outputs)], ret)
+		}
+		for _, output := range outputs {
+			if datum := output.Datum(); datum != nil {
+				add(datum.Cbor())
+			}
+		}
+	}
+
+	if index != nil && len(all) > 0 {
+		if err := index.AddDatums(all); err != nil {
+			return nil, fmt.Errorf("failed to index datums: %w", err)
+		}
+	}
+	return d, nil
+}
+
+func (d *datums) lookup(hash []byte) ([]byte, error) {
+	if data, ok := d.block[string(hash)]; ok {
+		return data, nil
+	}
+	if d.index == nil {
+		return nil, nil
+	}
+	return d.index.Datum(hash)
+}
+
+// outputDatum builds the datum of an output. Inline datums are hashed, datum
+// hashes are resolved when the datum is known.
+func outputDatum(output common.TransactionOutput, d *datums) (*pbcardano.Datum, error) {
+	if datum := output.Datum(); datum != nil {
+		return newDatum(nil, datum.Cbor())
+	}
+
+	hash := output.DatumHash()
+	// gouroboros reports Babbage outputs without datum with a zero hash.
+	if hash == nil || *hash == (common.Blake2b256{}) {
+		return nil, nil
+	}
+	data, err := d.lookup(hash.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up datum %x: %w", hash.Bytes(), err)
+	}
+	return newDatum(hash.Bytes(), data)
+}
+
+// newDatum returns the datum of the given CBOR, hashing it when hash is nil.
+// data may be nil for a datum known by its hash only.
+func newDatum(hash, data []byte) (*pbcardano.Datum, error) {
+	if hash == nil {
+		hash = plutusdata.Hash(data)
+	}
+	out := &pbcardano.Datum{Hash: bytes.Clone(hash)}
+	if data == nil {
+		return out, nil
+	}
+
+	payload, err := plutusdata.Decode(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode datum %x: %w", hash, err)
+	}
+	out.Payload = payload
+	out.OriginalCbor = bytes.Clone(data)
+	return out, nil
+}
+
+// fillDatums sets the datums of the outputs, collateral return and witness
+// set of a transaction, which the utxorpc conversion leaves out.
+func fillDatums(tx common.Transaction, out *pbcardano.Tx, d *datums) error {
+	for i, output := range tx.Outputs() {
+		if i >= len(out.Outputs) {
+			break
+		}
+		datum, err := outputDatum(output, d)
+		if err != nil {
+			return fmt.Errorf("output %d: %w", i, err)
+		}
+		out.Outputs[i].Datum = datum
+	}
+
+	if ret := tx.CollateralReturn(); ret != nil && out.GetCollateral().GetCollateralReturn() != nil {
+		datum, err := outputDatum(ret, d)
+		if err != nil {
+			return fmt.Errorf("collateral return: %w", err)
+		}
+		out.Collateral.CollateralReturn.Datum = datum
+	}
+
+	witnesses := tx.Witnesses()
+	if witnesses == nil {
+		return nil
+	}
+	for i, datum := range witnesses.PlutusData() {
+		payload, err := plutusdata.Decode(datum.Cbor())
+		if err != nil {
+			return fmt.Errorf("failed to decode witness datum %d: %w", i, err)
+		}
+		if out.Witnesses == nil {
+			out.Witnesses = &pbcardano.WitnessSet{}
+		}
+		out.Witnesses.PlutusDatums = append(out.Witnesses.PlutusDatums, payload)
+	}
+	return nil
+}
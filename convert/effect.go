@@ -0,0 +1,57 @@
+package convert
+
+import (
+	pbcardano "github.com/no-witness-labs/firehose-cardano/types/pb/sf/cardano/type/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// ledgerEffect describes what the ledger applies of a transaction, which
+// takes its collateral inputs resolved to tell the collateral it forfeits
+// when its scripts failed.
+func ledgerEffect(out *pbcardano.Tx) *pbcardano.LedgerEffect {
+	effect := &pbcardano.LedgerEffect{}
+	if out.Successful {
+		for i := range out.Inputs {
+			effect.Spent = append(effect.Spent, &pbcardano.TxInputRef{Index: uint32(i)})
+		}
+		for i := range out.Outputs {
+			effect.Created = append(effect.Created, &pbcardano.TxOutputRef{Index: uint32(i)})
+		}
+		effect.Fee = proto.Uint64(out.Fee)
+		effect.BodyApplied = true
+		return effect
+	}
+
+	for i := range out.GetCollateral().GetCollateral() {
+		effect.Spent = append(effect.Spent, &pbcardano.TxInputRef{Collateral: true, Index: uint32(i)})
+	}
+	// The collateral return takes the index following the outputs.
+	if out.GetCollateral().GetCollateralReturn() != nil {
+		effect.Created = append(effect.Created, &pbcardano.TxOutputRef{CollateralReturn: true, Index: uint32(len(out.Outputs))})
+	}
+	effect.Fee = collateralCollected(out)
+	return effect
+}
+
+// collateralCollected returns the lovelace a transaction whose scripts failed
+// forfeits: its total collateral when declared, its collateral inputs minus
+// its collateral return otherwise. Without a declared total, it is nil unless
+// every collateral input is resolved.
+func collateralCollected(tx *pbcardano.Tx) *uint64 {
+	collateral := tx.GetCollateral()
+	if collateral != nil && collateral.DeclaredTotalCollateral != nil {
+		return proto.Uint64(collateral.GetDeclaredTotalCollateral())
+	}
+
+	var total uint64
+	for _, input := range collateral.GetCollateral() {
+		if input.AsOutput == nil {
+			return nil
+		}
+		total += input.AsOutput.Coin
+	}
+	if ret := collateral.GetCollateralReturn().GetCoin(); ret <= total {
+		return proto.Uint64(total - ret)
+	}
+	return proto.Uint64(0)
+}
@@ -0,0 +1,57 @@
+package convert
+
+import (
+	"testing"
+
+	pbcardano "github.com/no-witness-labs/firehose-cardano/types/pb/sf/cardano/type/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestInvalidTxFee(t *testing.T) {
+	resolved := &pbcardano.TxInput{AsOutput: &pbcardano.TxOutput{Coin: 5_000_000}}
+	for _, tc := range []struct {
+		name       string
+		collateral *pbcardano.Collateral
+		fee        *uint64
+	}{
+		{
+			name:       "declared total",
+			collateral: &pbcardano.Collateral{Collateral: []*pbcardano.TxInput{{}}, DeclaredTotalCollateral: proto.Uint64(2_000_000)},
+			fee:        proto.Uint64(2_000_000),
+		},
+		{
+			name: "resolved inputs",
+			collateral: &pbcardano.Collateral{
+				Collateral:       []*pbcardano.TxInput{resolved},
+				CollateralReturn: &pbcardano.TxOutput{Coin: 3_000_000},
+			},
+			fee: proto.Uint64(2_000_000),
+		},
+		{
+			name:       "unresolved input",
+			collateral: &pbcardano.Collateral{Collateral: []*pbcardano.TxInput{resolved, {}}},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			tx := &pbcardano.Tx{Fee: 200_000, Collateral: tc.collateral}
+			tx.Effect = ledgerEffect(tx)
+			if got := tx.Effect.Fee; (got == nil) != (tc.fee == nil) || (got != nil && *got != *tc.fee) {
+				t.Errorf("fee %v, expected %v", got, tc.fee)
+			}
+
+			valid := &pbcardano.Tx{Fee: 200_000, Successful: true}
+			valid.Effect = ledgerEffect(valid)
+			stats := blockStats([]*pbcardano.Tx{valid, tx})
+			expected := uint64(200_000)
+			var unknown uint64
+			if tc.fee != nil {
+				expected += *tc.fee
+			} else {
+				unknown = 1
+			}
+			if stats.Fees != expected || stats.UnknownFeeCount != unknown {
+				t.Errorf("stats fees %d, unknown %d, expected %d, %d", stats.Fees, stats.UnknownFeeCount, expected, unknown)
+			}
+		})
+	}
+}
@@ -0,0 +1,237 @@
+package convert
+
+import (
+	"bytes"
+	"cmp"
+	"fmt"
+	"slices"
+
+	"github.com/blinklabs-io/gouroboros/cbor"
+	"github.com/blinklabs-io/gouroboros/ledger/common"
+	pbcardano "github.com/no-witness-labs/firehose-cardano/types/pb/sf/cardano/type/v1"
+)
+
+var voterTypes = map[uint8]pbcardano.VoterType{
+	common.VoterTypeConstitutionalCommitteeHotKeyHash:    pbcardano.VoterType_VOTER_TYPE_CONSTITUTIONAL_COMMITTEE_HOT_KEY_HASH,
+	common.VoterTypeConstitutionalCommitteeHotScriptHash: pbcardano.VoterType_VOTER_TYPE_CONSTITUTIONAL_COMMITTEE_HOT_SCRIPT_HASH,
+	common.VoterTypeDRepKeyHash:                          pbcardano.VoterType_VOTER_TYPE_DREP_KEY_HASH,
+	common.VoterTypeDRepScriptHash:                       pbcardano.VoterType_VOTER_TYPE_DREP_SCRIPT_HASH,
+	common.VoterTypeStakingPoolKeyHash:                   pbcardano.VoterType_VOTER_TYPE_STAKE_POOL_KEY_HASH,
+}
+
+// voterRank orders voters the way the ledger does: committee, DRep then pool
+// voters, script credentials before key credentials.
+var voterRank = map[pbcardano.VoterType]int{
+	pbcardano.VoterType_VOTER_TYPE_CONSTITUTIONAL_COMMITTEE_HOT_SCRIPT_HASH: 0,
+	pbcardano.VoterType_VOTER_TYPE_CONSTITUTIONAL_COMMITTEE_HOT_KEY_HASH:    1,
+	pbcardano.VoterType_VOTER_TYPE_DREP_SCRIPT_HASH:                         2,
+	pbcardano.VoterType_VOTER_TYPE_DREP_KEY_HASH:                            3,
+	pbcardano.VoterType_VOTER_TYPE_STAKE_POOL_KEY_HASH:                      4,
+}
+
+var votes = map[uint8]pbcardano.Vote{
+	common.GovVoteNo:      pbcardano.Vote_VOTE_NO,
+	common.GovVoteYes:     pbcardano.Vote_VOTE_YES,
+	common.GovVoteAbstain: pbcardano.Vote_VOTE_ABSTAIN,
+}
+
+// votingProcedures flattens the voter → action → vote map of a transaction.
+// gouroboros decodes it into a Go map, the votes are therefore sorted in the
+// ledger order of voters and governance action ids to stay deterministic.
+func votingProcedures(procedures common.VotingProcedures) []*pbcardano.VotingProcedure {
+	if len(procedures) == 0 {
+		return nil
+	}
+
+	var out []*pbcardano.VotingProcedure
+	for voter, actions := range procedures {
+		for actionID, procedure := range actions {
+			vp := &pbcardano.VotingProcedure{
+				Voter: &pbcardano.Voter{
+					Type: voterTypes[voter.Type],
+					Hash: bytes.Clone(voter.Hash[:]),
+				},
+				GovActionId: &pbcardano.GovernanceActionId{
+					TransactionId:         bytes.Clone(actionID.TransactionId[:]),
+					GovernanceActionIndex: actionID.GovActionIdx,
+				},
+				Vote: votes[procedure.Vote],
+			}
+			if procedure.Anchor != nil {
+				vp.Anchor = &pbcardano.Anchor{
+					Url:         procedure.Anchor.Url,
+					ContentHash: bytes.Clone(procedure.Anchor.DataHash[:]),
+				}
+			}
+			out = append(out, vp)
+		}
+	}
+
+	slices.SortFunc(out, compareVotingProcedures)
+	return out
+}
+
+func compareVotingProcedures(a, b *pbcardano.VotingProcedure) int {
+	return cmp.Or(
+		cmp.Compare(voterRank[a.Voter.Type], voterRank[b.Voter.Type]),
+		bytes.Compare(a.Voter.Hash, b.Voter.Hash),
+		bytes.Compare(a.GovActionId.TransactionId, b.GovActionId.TransactionId),
+		cmp.Compare(a.GovActionId.GovernanceActionIndex, b.GovActionId.GovernanceActionIndex),
+	)
+}
+
+// proposals converts the governance actions proposed by a transaction. The
+// Go maps gouroboros decodes withdrawals and new committee members into are
+// sorted in ledger order to stay deterministic.
+func proposals(procedures []common.ProposalProcedure) ([]*pbcardano.GovernanceActionProposal, error) {
+	var out []*pbcardano.GovernanceActionProposal
+	for i, procedure := range procedures {
+		account, err := procedure.RewardAccount.Bytes()
+		if err != nil {
+			return nil, fmt.Errorf("proposal %d: failed to encode reward account: %w", i, err)
+		}
+		action, err := govAction(procedure.GovAction.Action)
+		if err != nil {
+			return nil, fmt.Errorf("proposal %d: %w", i, err)
+		}
+		out = append(out, &pbcardano.GovernanceActionProposal{
+			Deposit:       procedure.Deposit,
+			RewardAccount: account,
+			GovAction:     action,
+			Anchor: &pbcardano.Anchor{
+				Url:         procedure.Anchor.Url,
+				ContentHash: bytes.Clone(procedure.Anchor.DataHash[:]),
+			},
+		})
+	}
+	return out, nil
+}
+
+func govAction(action common.GovAction) (*pbcardano.GovernanceAction, error) {
+	switch a := action.(type) {
+	case *common.ParameterChangeGovAction:
+		var params paramUpdate
+		if _, err := cbor.Decode(a.ParamUpdate, &params); err != nil {
+			return nil, fmt.Errorf("failed to decode parameter update: %w", err)
+		}
+		update, err := params.convert(false)
+		if err != nil {
+			return nil, err
+		}
+		// protocol_param_update leaves the parameters that do not change at
+		// zero.
+		pparams := &pbcardano.PParams{}
+		update.Apply(pparams)
+		return &pbcardano.GovernanceAction{GovernanceAction: &pbcardano.GovernanceAction_ParameterChangeAction{
+			ParameterChangeAction: &pbcardano.ParameterChangeAction{
+				GovActionId:         govActionID(a.ActionId),
+				ProtocolParamUpdate: pparams,
+				PolicyHash:          a.PolicyHash,
+				Update:              update,
+			},
+		}}, nil
+
+	case *common.HardForkInitiationGovAction:
+		return &pbcardano.GovernanceAction{GovernanceAction: &pbcardano.GovernanceAction_HardForkInitiationAction{
+			HardForkInitiationAction: &pbcardano.HardForkInitiationAction{
+				GovActionId: govActionID(a.ActionId),
+				ProtocolVersion: &pbcardano.ProtocolVersion{
+					Major: uint32(a.ProtocolVersion.Major),
+					Minor: uint32(a.ProtocolVersion.Minor),
+				},
+			},
+		}}, nil
+
+	case *common.TreasuryWithdrawalGovAction:
+		withdrawals, err := withdrawals(a.Withdrawals)
+		if err != nil {
+			return nil, err
+		}
+		out := &pbcardano.TreasuryWithdrawalsAction{PolicyHash: a.PolicyHash}
+		for _, w := range withdrawals {
+			out.Withdrawals = append(out.Withdrawals, &pbcardano.WithdrawalAmount{RewardAccount: w.RewardAccount, Coin: w.Coin})
+		}
+		return &pbcardano.GovernanceAction{GovernanceAction: &pbcardano.GovernanceAction_TreasuryWithdrawalsAction{
+			TreasuryWithdrawalsAction: out,
+		}}, nil
+
+	case *common.NoConfidenceGovAction:
+		return &pbcardano.GovernanceAction{GovernanceAction: &pbcardano.GovernanceAction_NoConfidenceAction{
+			NoConfidenceAction: &pbcardano.NoConfidenceAction{GovActionId: govActionID(a.ActionId)},
+		}}, nil
+
+	case *common.UpdateCommitteeGovAction:
+		threshold, err := rationalNumber(&a.Quorum)
+		if err != nil {
+			return nil, fmt.Errorf("invalid committee threshold: %w", err)
+		}
+		out := &pbcardano.UpdateCommitteeAction{
+			GovActionId:           govActionID(a.ActionId),
+			NewCommitteeThreshold: threshold,
+		}
+		for _, credential := range a.Credentials {
+			out.RemoveCommitteeCredentials = append(out.RemoveCommitteeCredentials, stakeCredential(credential))
+		}
+		for credential, epoch := range a.CredEpochs {
+			out.NewCommitteeCredentials = append(out.NewCommitteeCredentials, &pbcardano.NewCommitteeCredentials{
+				CommitteeColdCredential: stakeCredential(*credential),
+				ExpiresEpoch:            uint32(epoch),
+			})
+		}
+		slices.SortFunc(out.NewCommitteeCredentials, func(a, b *pbcardano.NewCommitteeCredentials) int {
+			return compareStakeCredentials(a.CommitteeColdCredential, b.CommitteeColdCredential)
+		})
+		return &pbcardano.GovernanceAction{GovernanceAction: &pbcardano.GovernanceAction_UpdateCommitteeAction{
+			UpdateCommitteeAction: out,
+		}}, nil
+
+	case *common.NewConstitutionGovAction:
+		return &pbcardano.GovernanceAction{GovernanceAction: &pbcardano.GovernanceAction_NewConstitutionAction{
+			NewConstitutionAction: &pbcardano.NewConstitutionAction{
+				GovActionId: govActionID(a.ActionId),
+				Constitution: &pbcardano.Constitution{
+					Anchor: &pbcardano.Anchor{
+						Url:         a.Constitution.Anchor.Url,
+						ContentHash: bytes.Clone(a.Constitution.Anchor.DataHash[:]),
+					},
+					Hash: a.Constitution.ScriptHash,
+				},
+			},
+		}}, nil
+
+	case *common.InfoGovAction:
+		return &pbcardano.GovernanceAction{GovernanceAction: &pbcardano.GovernanceAction_InfoAction{
+			InfoAction: common.GovActionTypeInfo,
+		}}, nil
+	}
+	return nil, fmt.Errorf("unsupported governance action %T", action)
+}
+
+// govActionID converts the id of the previous action of the same purpose, nil
+// when there is none.
+func govActionID(id *common.GovActionId) *pbcardano.GovernanceActionId {
+	if id == nil {
+		return nil
+	}
+	return &pbcardano.GovernanceActionId{
+		TransactionId:         bytes.Clone(id.TransactionId[:]),
+		GovernanceActionIndex: id.GovActionIdx,
+	}
+}
+
+func stakeCredential(c common.Credential) *pbcardano.StakeCredential {
+	if c.CredType == common.CredentialTypeScriptHash {
+		return &pbcardano.StakeCredential{StakeCredential: &pbcardano.StakeCredential_ScriptHash{ScriptHash: bytes.Clone(c.Credential[:])}}
+	}
+	return &pbcardano.StakeCredential{StakeCredential: &pbcardano.StakeCredential_AddrKeyHash{AddrKeyHash: bytes.Clone(c.Credential[:])}}
+}
+
+// compareStakeCredentials orders script credentials before key credentials,
+// then by hash.
+func compareStakeCredentials(a, b *pbcardano.StakeCredential) int {
+	return cmp.Or(
+		-cmp.Compare(len(a.GetScriptHash()), len(b.GetScriptHash())),
+		bytes.Compare(a.GetScriptHash(), b.GetScriptHash()),
+		bytes.Compare(a.GetAddrKeyHash(), b.GetAddrKeyHash()),
+	)
+}
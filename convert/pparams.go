@@ -0,0 +1,229 @@
+package convert
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+
+	"github.com/blinklabs-io/gouroboros/cbor"
+	"github.com/blinklabs-io/gouroboros/ledger/common"
+	pbcardano "github.com/no-witness-labs/firehose-cardano/types/pb/sf/cardano/type/v1"
+)
+
+// txBodyUpdate is the key of the protocol parameter update of a transaction
+// body, used from Shelley to Babbage.
+const txBodyUpdate = 6
+
+// paramUpdate is a protocol parameter update of any era from Shelley to
+// Conway: the eras only add and remove keys, key 17 excepted which holds the
+// coins per UTxO word in Alonzo and per byte afterwards.
+type paramUpdate struct {
+	MinFeeA              *uint64                                   `cbor:"0,keyasint"`
+	MinFeeB              *uint64                                   `cbor:"1,keyasint"`
+	MaxBlockBodySize     *uint64                                   `cbor:"2,keyasint"`
+	MaxTxSize            *uint64                                   `cbor:"3,keyasint"`
+	MaxBlockHeaderSize   *uint64                                   `cbor:"4,keyasint"`
+	KeyDeposit           *uint64                                   `cbor:"5,keyasint"`
+	PoolDeposit          *uint64                                   `cbor:"6,keyasint"`
+	MaxEpoch             *uint64                                   `cbor:"7,keyasint"`
+	NOpt                 *uint64                                   `cbor:"8,keyasint"`
+	A0                   *cbor.Rat                                 `cbor:"9,keyasint"`
+	Rho                  *cbor.Rat                                 `cbor:"10,keyasint"`
+	Tau                  *cbor.Rat                                 `cbor:"11,keyasint"`
+	Decentralization     *cbor.Rat                                 `cbor:"12,keyasint"`
+	ExtraEntropy         *common.Nonce                             `cbor:"13,keyasint"`
+	ProtocolVersion      *common.ProtocolParametersProtocolVersion `cbor:"14,keyasint"`
+	MinUtxoValue         *uint64                                   `cbor:"15,keyasint"`
+	MinPoolCost          *uint64                                   `cbor:"16,keyasint"`
+	CoinsPerUtxo         *uint64                                   `cbor:"17,keyasint"`
+	CostModels           map[uint][]int64                          `cbor:"18,keyasint"`
+	ExecutionCosts       *common.ExUnitPrice                       `cbor:"19,keyasint"`
+	MaxTxExUnits         *common.ExUnits                           `cbor:"20,keyasint"`
+	MaxBlockExUnits      *common.ExUnits                           `cbor:"21,keyasint"`
+	MaxValueSize         *uint64                                   `cbor:"22,keyasint"`
+	CollateralPercentage *uint64                                   `cbor:"23,keyasint"`
+	MaxCollateralInputs  *uint64                                   `cbor:"24,keyasint"`
+	PoolVotingThresholds []cbor.Rat                                `cbor:"25,keyasint"`
+	DRepVotingThresholds []cbor.Rat                                `cbor:"26,keyasint"`
+	MinCommitteeSize     *uint32                                   `cbor:"27,keyasint"`
+	CommitteeTermLimit   *uint64                                   `cbor:"28,keyasint"`
+	GovActionValidity    *uint64                                   `cbor:"29,keyasint"`
+	GovActionDeposit     *uint64                                   `cbor:"30,keyasint"`
+	DRepDeposit          *uint64                                   `cbor:"31,keyasint"`
+	DRepInactivity       *uint64                                   `cbor:"32,keyasint"`
+	MinFeeRefScriptCost  *cbor.Rat                                 `cbor:"33,keyasint"`
+}
+
+// protocolParamUpdate converts the protocol parameter update of a
+// transaction body, nil when it has none. The proposals are decoded into a
+// Go map, they are sorted by genesis key hash to stay deterministic.
+func protocolParamUpdate(body map[uint]cbor.RawMessage, alonzoEra bool) (*pbcardano.ProtocolParamUpdate, error) {
+	raw, ok := body[txBodyUpdate]
+	if !ok {
+		return nil, nil
+	}
+	var update struct {
+		cbor.StructAsArray
+		Proposals map[common.Blake2b224]paramUpdate
+		Epoch     uint64
+	}
+	if _, err := cbor.Decode(raw, &update); err != nil {
+		return nil, fmt.Errorf("failed to decode protocol parameter update: %w", err)
+	}
+
+	out := &pbcardano.ProtocolParamUpdate{Epoch: update.Epoch}
+	for genesisHash, params := range update.Proposals {
+		pparams, err := params.convert(alonzoEra)
+		if err != nil {
+			return nil, fmt.Errorf("genesis key %x: %w", genesisHash.Bytes(), err)
+		}
+		out.Proposals = append(out.Proposals, &pbcardano.GenesisParamUpdate{
+			GenesisHash: bytes.Clone(genesisHash.Bytes()),
+			Params:      pparams,
+		})
+	}
+
+	slices.SortFunc(out.Proposals, func(a, b *pbcardano.GenesisParamUpdate) int {
+		return bytes.Compare(a.GenesisHash, b.GenesisHash)
+	})
+	return out, nil
+}
+
+func (u *paramUpdate) convert(alonzoEra bool) (*pbcardano.PParamsUpdate, error) {
+	out := &pbcardano.PParamsUpdate{
+		MinFeeCoefficient:        u.MinFeeA,
+		MinFeeConstant:           u.MinFeeB,
+		MaxBlockBodySize:         u.MaxBlockBodySize,
+		MaxTxSize:                u.MaxTxSize,
+		MaxBlockHeaderSize:       u.MaxBlockHeaderSize,
+		StakeKeyDeposit:          u.KeyDeposit,
+		PoolDeposit:              u.PoolDeposit,
+		PoolRetirementEpochBound: u.MaxEpoch,
+		DesiredNumberOfPools:     u.NOpt,
+		MinUtxoValue:             u.MinUtxoValue,
+		MinPoolCost:              u.MinPoolCost,
+		MaxValueSize:             u.MaxValueSize,
+		CollateralPercentage:     u.CollateralPercentage,
+		MaxCollateralInputs:      u.MaxCollateralInputs,
+
+		MinCommitteeSize:               u.MinCommitteeSize,
+		CommitteeTermLimit:             u.CommitteeTermLimit,
+		GovernanceActionValidityPeriod: u.GovActionValidity,
+		GovernanceActionDeposit:        u.GovActionDeposit,
+		DrepDeposit:                    u.DRepDeposit,
+		DrepInactivityPeriod:           u.DRepInactivity,
+	}
+	if alonzoEra {
+		out.CoinsPerUtxoWord = u.CoinsPerUtxo
+	} else {
+		out.CoinsPerUtxoByte = u.CoinsPerUtxo
+	}
+
+	var err error
+	rationals := []struct {
+		name string
+		in   *cbor.Rat
+		out  **pbcardano.RationalNumber
+	}{
+		{"pool influence", u.A0, &out.PoolInfluence},
+		{"monetary expansion", u.Rho, &out.MonetaryExpansion},
+		{"treasury expansion", u.Tau, &out.TreasuryExpansion},
+		{"decentralization", u.Decentralization, &out.Decentralization},
+		{"reference script cost", u.MinFeeRefScriptCost, &out.MinFeeScriptRefCostPerByte},
+	}
+	for _, r := range rationals {
+		if *r.out, err = rationalNumber(r.in); err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", r.name, err)
+		}
+	}
+
+	if u.ExtraEntropy != nil {
+		out.ExtraEntropy = []byte{}
+		if u.ExtraEntropy.Type != common.NonceTypeNeutral {
+			out.ExtraEntropy = bytes.Clone(u.ExtraEntropy.Value[:])
+		}
+	}
+	if u.ProtocolVersion != nil {
+		out.ProtocolVersion = &pbcardano.ProtocolVersion{
+			Major: uint32(u.ProtocolVersion.Major),
+			Minor: uint32(u.ProtocolVersion.Minor),
+		}
+	}
+	if u.CostModels != nil {
+		out.CostModels = costModels(u.CostModels)
+	}
+	if u.ExecutionCosts != nil {
+		out.Prices = &pbcardano.ExPrices{}
+		if out.Prices.Steps, err = rationalNumber(u.ExecutionCosts.StepPrice); err != nil {
+			return nil, fmt.Errorf("invalid step price: %w", err)
+		}
+		if out.Prices.Memory, err = rationalNumber(u.ExecutionCosts.MemPrice); err != nil {
+			return nil, fmt.Errorf("invalid memory price: %w", err)
+		}
+	}
+	if out.PoolVotingThresholds, err = votingThresholds(u.PoolVotingThresholds); err != nil {
+		return nil, fmt.Errorf("invalid pool voting thresholds: %w", err)
+	}
+	if out.DrepVotingThresholds, err = votingThresholds(u.DRepVotingThresholds); err != nil {
+		return nil, fmt.Errorf("invalid DRep voting thresholds: %w", err)
+	}
+	out.MaxExecutionUnitsPerTransaction = exUnits(u.MaxTxExUnits)
+	out.MaxExecutionUnitsPerBlock = exUnits(u.MaxBlockExUnits)
+	return out, nil
+}
+
+// costModels converts cost models keyed by Plutus language (0 for V1).
+func costModels(models map[uint][]int64) *pbcardano.CostModels {
+	out := &pbcardano.CostModels{}
+	for language, values := range models {
+		model := &pbcardano.CostModel{Values: slices.Clone(values)}
+		switch language {
+		case 0:
+			out.PlutusV1 = model
+		case 1:
+			out.PlutusV2 = model
+		case 2:
+			out.PlutusV3 = model
+		}
+	}
+	return out
+}
+
+func votingThresholds(thresholds []cbor.Rat) (*pbcardano.VotingThresholds, error) {
+	if thresholds == nil {
+		return nil, nil
+	}
+	out := &pbcardano.VotingThresholds{}
+	for _, threshold := range thresholds {
+		r, err := rationalNumber(&threshold)
+		if err != nil {
+			return nil, err
+		}
+		out.Thresholds = append(out.Thresholds, r)
+	}
+	return out, nil
+}
+
+func exUnits(units *common.ExUnits) *pbcardano.ExUnits {
+	if units == nil {
+		return nil
+	}
+	return &pbcardano.ExUnits{Steps: units.Steps, Memory: units.Memory}
+}
+
+// rationalNumber converts a rational, nil when it is absent. The numerator
+// and denominator must fit the 32 bits of RationalNumber.
+func rationalNumber(r *cbor.Rat) (*pbcardano.RationalNumber, error) {
+	if r == nil || r.Rat == nil {
+		return nil, nil
+	}
+	num, denom := r.Num(), r.Denom()
+	if !num.IsInt64() || num.Int64() < -1<<31 || num.Int64() >= 1<<31 ||
+		!denom.IsUint64() || denom.Uint64() >= 1<<32 {
+		return nil, fmt.Errorf("%s does not fit a 32-bit rational", r.String())
+	}
+	return &pbcardano.RationalNumber{
+		Numerator:   int32(num.Int64()),
+		Denominator: uint32(denom.Uint64()),
+	}, nil
+}
@@ -0,0 +1,333 @@
+package convert
+
+import (
+	"bytes"
+	"cmp"
+	"fmt"
+	"slices"
+
+	"github.com/blinklabs-io/gouroboros/ledger/common"
+	"github.com/no-witness-labs/firehose-cardano/plutusdata"
+	pbcardano "github.com/no-witness-labs/firehose-cardano/types/pb/sf/cardano/type/v1"
+)
+
+// scriptPurposes holds, for each redeemer purpose, the script hash of each
+// item a redeemer index can point at, nil for items not locked by a script.
+// Items follow the ledger order: sorted inputs, sorted policies,
+// certificates, sorted reward accounts, sorted voters and proposals.
+type scriptPurposes map[pbcardano.RedeemerPurpose][][]byte
+
+func txScriptPurposes(tx common.Transaction, out *pbcardano.Tx) scriptPurposes {
+	purposes := scriptPurposes{}
+
+	inputs := slices.Clone(out.Inputs)
+	slices.SortFunc(inputs, compareInputs)
+	for _, input := range inputs {
+		purposes.add(pbcardano.RedeemerPurpose_REDEEMER_PURPOSE_SPEND, paymentScriptHash(input.GetAsOutput().GetAddress()))
+	}
+
+	for _, ma := range out.Mint {
+		purposes.add(pbcardano.RedeemerPurpose_REDEEMER_PURPOSE_MINT, ma.PolicyId)
+	}
+
+	for _, cert := range out.Certificates {
+		purposes.add(pbcardano.RedeemerPurpose_REDEEMER_PURPOSE_CERT, certScriptHash(cert))
+	}
+
+	for _, withdrawal := range out.Withdrawals {
+		purposes.add(pbcardano.RedeemerPurpose_REDEEMER_PURPOSE_REWARD, rewardScriptHash(withdrawal.RewardAccount))
+	}
+
+	var previous *pbcardano.Voter
+	for _, procedure := range out.VotingProcedures {
+		voter := procedure.Voter
+		if previous != nil && previous.Type == voter.Type && bytes.Equal(previous.Hash, voter.Hash) {
+			continue
+		}
+		previous = voter
+
+		var hash []byte
+		switch voter.Type {
+		case pbcardano.VoterType_VOTER_TYPE_CONSTITUTIONAL_COMMITTEE_HOT_SCRIPT_HASH, pbcardano.VoterType_VOTER_TYPE_DREP_SCRIPT_HASH:
+			hash = voter.Hash
+		}
+		purposes.add(pbcardano.RedeemerPurpose_REDEEMER_PURPOSE_VOTE, hash)
+	}
+
+	for _, proposal := range tx.ProposalProcedures() {
+		var hash []byte
+		switch action := proposal.GovAction.Action.(type) {
+		case *common.ParameterChangeGovAction:
+			hash = action.PolicyHash
+		case *common.TreasuryWithdrawalGovAction:
+			hash = action.PolicyHash
+		}
+		purposes.add(pbcardano.RedeemerPurpose_REDEEMER_PURPOSE_PROPOSE, hash)
+	}
+
+	return purposes
+}
+
+func (p scriptPurposes) add(purpose pbcardano.RedeemerPurpose, hash []byte) {
+	if len(hash) == 0 {
+		hash = nil
+	}
+	p[purpose] = append(p[purpose], hash)
+}
+
+func (p scriptPurposes) scriptHash(purpose pbcardano.RedeemerPurpose, index uint32) []byte {
+	if items := p[purpose]; int(index) < len(items) {
+		return items[index]
+	}
+	return nil
+}
+
+// scriptHashes returns the distinct script hashes the transaction has to
+// satisfy, in purpose order.
+func (p scriptPurposes) scriptHashes() [][]byte {
+	var out [][]byte
+	seen := map[string]bool{}
+	for purpose := pbcardano.RedeemerPurpose_REDEEMER_PURPOSE_SPEND; purpose <= pbcardano.RedeemerPurpose_REDEEMER_PURPOSE_PROPOSE; purpose++ {
+		for _, hash := range p[purpose] {
+			if hash != nil && !seen[string(hash)] {
+				seen[string(hash)] = true
+				out = append(out, hash)
+			}
+		}
+	}
+	return out
+}
+
+func compareInputs(a, b *pbcardano.TxInput) int {
+	return cmp.Or(
+		bytes.Compare(a.TxHash, b.TxHash),
+		cmp.Compare(a.OutputIndex, b.OutputIndex),
+	)
+}
+
+// paymentScriptHash returns the script hash of the payment part of a Shelley
+// address, nil for key, Byron or unknown addresses.
+func paymentScriptHash(address []byte) []byte {
+	if len(address) < 29 {
+		return nil
+	}
+	switch address[0] >> 4 {
+	case common.AddressTypeScriptKey, common.AddressTypeScriptScript, common.AddressTypeScriptPointer, common.AddressTypeScriptNone:
+		return address[1:29]
+	}
+	return nil
+}
+
+func rewardScriptHash(account []byte) []byte {
+	if len(account) < 29 || account[0]>>4 != common.AddressTypeNoneScript {
+		return nil
+	}
+	return account[1:29]
+}
+
+// certScriptHash returns the script hash of the credential witnessing a
+// certificate. Stake registrations without deposit, pool and genesis
+// certificates are not witnessed by scripts.
+func certScriptHash(cert *pbcardano.Certificate) []byte {
+	var credential *pbcardano.StakeCredential
+	switch c := cert.Certificate.(type) {
+	case *pbcardano.Certificate_StakeDeregistration:
+		credential = c.StakeDeregistration
+	case *pbcardano.Certificate_StakeDelegation:
+		credential = c.StakeDelegation.StakeCredential
+	case *pbcardano.Certificate_RegCert:
+		credential = c.RegCert.StakeCredential
+	case *pbcardano.Certificate_UnregCert:
+		credential = c.UnregCert.StakeCredential
+	case *pbcardano.Certificate_VoteDelegCert:
+		credential = c.VoteDelegCert.StakeCredential
+	case *pbcardano.Certificate_StakeVoteDelegCert:
+		credential = c.StakeVoteDelegCert.StakeCredential
+	case *pbcardano.Certificate_StakeRegDelegCert:
+		credential = c.StakeRegDelegCert.StakeCredential
+	case *pbcardano.Certificate_VoteRegDelegCert:
+		credential = c.VoteRegDelegCert.StakeCredential
+	case *pbcardano.Certificate_StakeVoteRegDelegCert:
+		credential = c.StakeVoteRegDelegCert.StakeCredential
+	case *pbcardano.Certificate_AuthCommitteeHotCert:
+		credential = c.AuthCommitteeHotCert.CommitteeColdCredential
+	case *pbcardano.Certificate_ResignCommitteeColdCert:
+		credential = c.ResignCommitteeColdCert.CommitteeColdCredential
+	case *pbcardano.Certificate_RegDrepCert:
+		credential = c.RegDrepCert.DrepCredential
+	case *pbcardano.Certificate_UnregDrepCert:
+		credential = c.UnregDrepCert.DrepCredential
+	case *pbcardano.Certificate_UpdateDrepCert:
+		credential = c.UpdateDrepCert.DrepCredential
+	}
+	return credential.GetScriptHash()
+}
+
+// fillScripts sets the reference scripts of the outputs, the witness scripts
+// and the redeemers of a transaction, and reports the scripts it executes
+// along with where they were found. Scripts of the auxiliary data are only
+// recorded in the registry.
+func fillScripts(tx common.Transaction, witnessCbor []byte, out *pbcardano.Tx, state *blockState) error {
+	for i, output := range tx.Outputs() {
+		if i >= len(out.Outputs) {
+			break
+		}
+		script, err := outputScript(output)
+		if err != nil {
+			return fmt.Errorf("output %d: %w", i, err)
+		}
+		out.Outputs[i].Script = script
+	}
+	if ret := tx.CollateralReturn(); ret != nil && out.GetCollateral().GetCollateralReturn() != nil {
+		script, err := outputScript(ret)
+		if err != nil {
+			return fmt.Errorf("collateral return: %w", err)
+		}
+		out.Collateral.CollateralReturn.Script = script
+	}
+
+	var provided []*pbcardano.Script
+	if witnessCbor != nil {
+		var err error
+		if provided, err = witnessScripts(witnessCbor); err != nil {
+			return err
+		}
+	}
+	if len(provided) > 0 {
+		if out.Witnesses == nil {
+			out.Witnesses = &pbcardano.WitnessSet{}
+		}
+		out.Witnesses.Script = provided
+	}
+
+	purposes := txScriptPurposes(tx, out)
+	executed := map[string]*pbcardano.ExecutedScript{}
+	for _, hash := range purposes.scriptHashes() {
+		script, err := executedScript(hash, provided, out, state)
+		if err != nil {
+			return err
+		}
+		executed[string(hash)] = script
+		out.ExecutedScripts = append(out.ExecutedScripts, script)
+	}
+
+	if err := fillRedeemers(tx, out, purposes, executed); err != nil {
+		return err
+	}
+
+	state.addScripts(out.GetAuxiliary().GetScripts()...)
+	state.addScripts(provided...)
+	for _, output := range out.Outputs {
+		state.addScripts(output.Script)
+	}
+	if ret := out.GetCollateral().GetCollateralReturn(); ret != nil {
+		state.addScripts(ret.Script)
+	}
+	return nil
+}
+
+// executedScript finds a script run by a transaction: in its witness set,
+// then among the reference scripts of its reference and spent inputs, then
+// among the ones seen earlier.
+func executedScript(hash []byte, provided []*pbcardano.Script, out *pbcardano.Tx, state *blockState) (*pbcardano.ExecutedScript, error) {
+	executed := &pbcardano.ExecutedScript{Hash: bytes.Clone(hash)}
+	found := func(script *pbcardano.Script, origin pbcardano.ScriptOrigin) *pbcardano.ExecutedScript {
+		executed.Script = script
+		executed.Language = scriptLanguage(script)
+		executed.Origin = origin
+		return executed
+	}
+
+	for _, script := range provided {
+		if bytes.Equal(script.Hash, hash) {
+			return found(script, pbcardano.ScriptOrigin_SCRIPT_ORIGIN_WITNESS_SET), nil
+		}
+	}
+
+	inputOrigins := []struct {
+		inputs []*pbcardano.TxInput
+		origin pbcardano.ScriptOrigin
+	}{
+		{out.ReferenceInputs, pbcardano.ScriptOrigin_SCRIPT_ORIGIN_REFERENCE_INPUT},
+		{out.Inputs, pbcardano.ScriptOrigin_SCRIPT_ORIGIN_INPUT},
+	}
+	for _, inputs := range inputOrigins {
+		for _, input := range inputs.inputs {
+			if script := input.GetAsOutput().GetScript(); script != nil && bytes.Equal(script.Hash, hash) {
+				executed = found(script, inputs.origin)
+				executed.TxHash = input.TxHash
+				executed.OutputIndex = input.OutputIndex
+				return executed, nil
+			}
+		}
+	}
+
+	script, err := state.script(hash)
+	if err != nil {
+		return nil, err
+	}
+	if script != nil {
+		return found(script, pbcardano.ScriptOrigin_SCRIPT_ORIGIN_REGISTRY), nil
+	}
+	return executed, nil
+}
+
+// fillRedeemers converts the redeemers of a transaction into its witness set
+// and attaches each to the input, policy, certificate or withdrawal it is
+// for.
+func fillRedeemers(tx common.Transaction, out *pbcardano.Tx, purposes scriptPurposes, executed map[string]*pbcardano.ExecutedScript) error {
+	witnesses := tx.Witnesses()
+	if witnesses == nil || witnesses.Redeemers() == nil {
+		return nil
+	}
+
+	inputs := slices.Clone(out.Inputs)
+	slices.SortFunc(inputs, compareInputs)
+
+	for key, value := range witnesses.Redeemers().Iter() {
+		data := value.Data.Cbor()
+		payload, err := plutusdata.Decode(data)
+		if err != nil {
+			return fmt.Errorf("failed to decode redeemer %d/%d: %w", key.Tag, key.Index, err)
+		}
+
+		purpose := pbcardano.RedeemerPurpose(key.Tag + 1)
+		redeemer := &pbcardano.Redeemer{
+			Purpose:      purpose,
+			Payload:      payload,
+			Index:        key.Index,
+			ExUnits:      &pbcardano.ExUnits{Steps: value.ExUnits.Steps, Memory: value.ExUnits.Memory},
+			OriginalCbor: bytes.Clone(data),
+		}
+		if hash := purposes.scriptHash(purpose, key.Index); hash != nil {
+			redeemer.ScriptHash = bytes.Clone(hash)
+			redeemer.ScriptLanguage = executed[string(hash)].GetLanguage()
+		}
+
+		if out.Witnesses == nil {
+			out.Witnesses = &pbcardano.WitnessSet{}
+		}
+		out.Witnesses.Redeemers = append(out.Witnesses.Redeemers, redeemer)
+
+		index := int(key.Index)
+		switch purpose {
+		case pbcardano.RedeemerPurpose_REDEEMER_PURPOSE_SPEND:
+			if index < len(inputs) {
+				inputs[index].Redeemer = redeemer
+			}
+		case pbcardano.RedeemerPurpose_REDEEMER_PURPOSE_MINT:
+			if index < len(out.Mint) {
+				out.Mint[index].Redeemer = redeemer
+			}
+		case pbcardano.RedeemerPurpose_REDEEMER_PURPOSE_CERT:
+			if index < len(out.Certificates) {
+				out.Certificates[index].Redeemer = redeemer
+			}
+		case pbcardano.RedeemerPurpose_REDEEMER_PURPOSE_REWARD:
+			if index < len(out.Withdrawals) {
+				out.Withdrawals[index].Redeemer = redeemer
+			}
+		}
+	}
+	return nil
+}
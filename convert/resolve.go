@@ -0,0 +1,67 @@
+package convert
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	pbcardano "github.com/no-witness-labs/firehose-cardano/types/pb/sf/cardano/type/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// UTxOResolver returns the outputs transaction inputs point at.
+type UTxOResolver interface {
+	// Output returns output index of transaction txHash, nil when it is
+	// unknown.
+	Output(txHash []byte, index uint32) (*pbcardano.TxOutput, error)
+}
+
+// WithUTxOResolver fills the as_output of inputs, reference inputs and
+// collateral inputs. Outputs produced earlier in the block are resolved
+// without the resolver, which is only asked for older ones.
+func WithUTxOResolver(resolver UTxOResolver) Option {
+	return func(o *options) {
+		o.utxoResolver = resolver
+	}
+}
+
+// resolveInputs fills the as_output of the inputs of a transaction. Inputs
+// whose output is unknown are left unresolved.
+func (s *blockState) resolveInputs(out *pbcardano.Tx) error {
+	if s.resolver == nil {
+		return nil
+	}
+
+	inputs := append(append([]*pbcardano.TxInput{}, out.Inputs...), out.ReferenceInputs...)
+	inputs = append(inputs, out.GetCollateral().GetCollateral()...)
+	for _, input := range inputs {
+		if output, ok := s.outputs[inputKey(input.TxHash, input.OutputIndex)]; ok {
+			input.AsOutput = proto.Clone(output).(*pbcardano.TxOutput)
+			continue
+		}
+
+		output, err := s.resolver.Output(input.TxHash, input.OutputIndex)
+		if err != nil {
+			return fmt.Errorf("failed to resolve input %x#%d: %w", input.TxHash, input.OutputIndex, err)
+		}
+		input.AsOutput = output
+	}
+	return nil
+}
+
+// addOutputs records the outputs a transaction creates: its outputs, or its
+// collateral return when its scripts failed.
+func (s *blockState) addOutputs(out *pbcardano.Tx) {
+	if s.resolver == nil {
+		return
+	}
+
+	for _, ref := range out.GetEffect().GetCreated() {
+		if output := out.CreatedOutput(ref.Index); output != nil {
+			s.outputs[inputKey(out.Hash, ref.Index)] = output
+		}
+	}
+}
+
+func inputKey(txHash []byte, index uint32) string {
+	return string(binary.BigEndian.AppendUint32(txHash[:len(txHash):len(txHash)], index))
+}
@@ -0,0 +1,255 @@
+package convert
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/blinklabs-io/gouroboros/cbor"
+	"github.com/blinklabs-io/gouroboros/ledger/common"
+	pbcardano "github.com/no-witness-labs/firehose-cardano/types/pb/sf/cardano/type/v1"
+	"golang.org/x/crypto/blake2b"
+)
+
+// ScriptRegistry maps script hashes to scripts. Transactions using reference
+// scripts only carry them implicitly, through the outputs they reference; the
+// registry keeps the scripts seen so far so that they can still be reported
+// when those outputs are not resolved.
+type ScriptRegistry interface {
+	// Script returns the script with the given hash, nil when it is unknown.
+	Script(hash []byte) (*pbcardano.Script, error)
+	// AddScripts records scripts, keyed by their hash field.
+	AddScripts(scripts []*pbcardano.Script) error
+}
+
+// WithScriptRegistry looks up the scripts transactions execute in registry
+// when they cannot be found otherwise, and feeds it the scripts of each
+// converted block.
+func WithScriptRegistry(registry ScriptRegistry) Option {
+	return func(o *options) {
+		o.scriptRegistry = registry
+	}
+}
+
+// Script types, as used in script references and prepended to scripts to
+// compute their hash.
+const (
+	scriptTypeNative   = 0
+	scriptTypePlutusV1 = 1
+	scriptTypePlutusV2 = 2
+	scriptTypePlutusV3 = 3
+)
+
+// newScript builds a script from its type and raw bytes: the CBOR of a native
+// script, the serialized program of a Plutus one.
+func newScript(scriptType uint, raw []byte) (*pbcardano.Script, error) {
+	out := &pbcardano.Script{}
+	switch scriptType {
+	case scriptTypeNative:
+		native, err := nativeScript(raw, 0)
+		if err != nil {
+			return nil, err
+		}
+		out.Script = &pbcardano.Script_Native{Native: native}
+	case scriptTypePlutusV1:
+		out.Script = &pbcardano.Script_PlutusV1{PlutusV1: bytes.Clone(raw)}
+	case scriptTypePlutusV2:
+		out.Script = &pbcardano.Script_PlutusV2{PlutusV2: bytes.Clone(raw)}
+	case scriptTypePlutusV3:
+		out.Script = &pbcardano.Script_PlutusV3{PlutusV3: bytes.Clone(raw)}
+	default:
+		return nil, fmt.Errorf("unknown script type %d", scriptType)
+	}
+
+	hash, err := blake2b.New(28, nil)
+	if err != nil {
+		return nil, err
+	}
+	hash.Write([]byte{byte(scriptType)})
+	hash.Write(raw)
+	out.Hash = hash.Sum(nil)
+	return out, nil
+}
+
+// nativeScript decodes the CBOR of a native script.
+func nativeScript(raw []byte, depth int) (*pbcardano.NativeScript, error) {
+	if depth > 64 {
+		return nil, fmt.Errorf("native script nested too deeply")
+	}
+
+	var items []cbor.RawMessage
+	if _, err := cbor.Decode(raw, &items); err != nil {
+		return nil, fmt.Errorf("failed to decode native script: %w", err)
+	}
+	if len(items) < 2 {
+		return nil, fmt.Errorf("native script has %d items", len(items))
+	}
+	var scriptType uint
+	if _, err := cbor.Decode(items[0], &scriptType); err != nil {
+		return nil, fmt.Errorf("failed to decode native script type: %w", err)
+	}
+
+	list := func(raw []byte) ([]*pbcardano.NativeScript, error) {
+		var rawScripts []cbor.RawMessage
+		if _, err := cbor.Decode(raw, &rawScripts); err != nil {
+			return nil, fmt.Errorf("failed to decode native scripts: %w", err)
+		}
+		var scripts []*pbcardano.NativeScript
+		for _, rawScript := range rawScripts {
+			script, err := nativeScript(rawScript, depth+1)
+			if err != nil {
+				return nil, err
+			}
+			scripts = append(scripts, script)
+		}
+		return scripts, nil
+	}
+
+	out := &pbcardano.NativeScript{}
+	switch scriptType {
+	case 0:
+		var hash []byte
+		if _, err := cbor.Decode(items[1], &hash); err != nil {
+			return nil, fmt.Errorf("failed to decode native script key hash: %w", err)
+		}
+		out.NativeScript = &pbcardano.NativeScript_ScriptPubkey{ScriptPubkey: hash}
+	case 1, 2:
+		scripts, err := list(items[1])
+		if err != nil {
+			return nil, err
+		}
+		if scriptType == 1 {
+			out.NativeScript = &pbcardano.NativeScript_ScriptAll{ScriptAll: &pbcardano.NativeScriptList{Items: scripts}}
+		} else {
+			out.NativeScript = &pbcardano.NativeScript_ScriptAny{ScriptAny: &pbcardano.NativeScriptList{Items: scripts}}
+		}
+	case 3:
+		if len(items) != 3 {
+			return nil, fmt.Errorf("n of k native script has %d items", len(items))
+		}
+		var k uint32
+		if _, err := cbor.Decode(items[1], &k); err != nil {
+			return nil, fmt.Errorf("failed to decode native script count: %w", err)
+		}
+		scripts, err := list(items[2])
+		if err != nil {
+			return nil, err
+		}
+		out.NativeScript = &pbcardano.NativeScript_ScriptNOfK{ScriptNOfK: &pbcardano.ScriptNOfK{K: k, Scripts: scripts}}
+	case 4, 5:
+		var slot uint64
+		if _, err := cbor.Decode(items[1], &slot); err != nil {
+			return nil, fmt.Errorf("failed to decode native script slot: %w", err)
+		}
+		if scriptType == 4 {
+			out.NativeScript = &pbcardano.NativeScript_InvalidBefore{InvalidBefore: slot}
+		} else {
+			out.NativeScript = &pbcardano.NativeScript_InvalidHereafter{InvalidHereafter: slot}
+		}
+	default:
+		return nil, fmt.Errorf("unknown native script type %d", scriptType)
+	}
+	return out, nil
+}
+
+// scriptList decodes a list, or set, of scripts of the given type.
+func scriptList(scriptType uint, raw []byte) ([]*pbcardano.Script, error) {
+	var items []cbor.RawMessage
+	if _, err := cbor.Decode(raw, &items); err != nil {
+		return nil, fmt.Errorf("failed to decode scripts: %w", err)
+	}
+
+	var out []*pbcardano.Script
+	for _, item := range items {
+		raw := []byte(item)
+		if scriptType != scriptTypeNative {
+			if _, err := cbor.Decode(item, &raw); err != nil {
+				return nil, fmt.Errorf("failed to decode Plutus script: %w", err)
+			}
+		}
+		script, err := newScript(scriptType, raw)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, script)
+	}
+	return out, nil
+}
+
+// scriptFields decodes the scripts of a witness set or auxiliary data map,
+// given the map key of each script type.
+func scriptFields(fields map[uint]cbor.RawMessage, keys map[uint]uint) ([]*pbcardano.Script, error) {
+	var out []*pbcardano.Script
+	for scriptType := uint(scriptTypeNative); scriptType <= scriptTypePlutusV3; scriptType++ {
+		raw, ok := fields[keys[scriptType]]
+		if !ok {
+			continue
+		}
+		scripts, err := scriptList(scriptType, raw)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, scripts...)
+	}
+	return out, nil
+}
+
+var (
+	witnessScriptKeys   = map[uint]uint{scriptTypeNative: 1, scriptTypePlutusV1: 3, scriptTypePlutusV2: 6, scriptTypePlutusV3: 7}
+	auxiliaryScriptKeys = map[uint]uint{scriptTypeNative: 1, scriptTypePlutusV1: 2, scriptTypePlutusV2: 3, scriptTypePlutusV3: 4}
+)
+
+// witnessScripts returns the scripts of a serialized witness set.
+func witnessScripts(witnessCbor []byte) ([]*pbcardano.Script, error) {
+	var fields map[uint]cbor.RawMessage
+	if _, err := cbor.Decode(witnessCbor, &fields); err != nil {
+		return nil, fmt.Errorf("failed to decode witness set: %w", err)
+	}
+	return scriptFields(fields, witnessScriptKeys)
+}
+
+// outputScript returns the reference script of an output.
+func outputScript(output common.TransactionOutput) (*pbcardano.Script, error) {
+	if output.ScriptRef() == nil {
+		return nil, nil
+	}
+
+	var fields map[uint]cbor.RawMessage
+	if _, err := cbor.Decode(output.Cbor(), &fields); err != nil {
+		return nil, fmt.Errorf("failed to decode output: %w", err)
+	}
+	// The script reference is #6.24(bytes .cbor [type, script]).
+	var scriptCbor []byte
+	if _, err := cbor.Decode(fields[3], &scriptCbor); err != nil {
+		return nil, fmt.Errorf("failed to decode script reference: %w", err)
+	}
+	var ref struct {
+		cbor.StructAsArray
+		Type uint
+		Raw  cbor.RawMessage
+	}
+	if _, err := cbor.Decode(scriptCbor, &ref); err != nil {
+		return nil, fmt.Errorf("failed to decode script reference: %w", err)
+	}
+
+	raw := []byte(ref.Raw)
+	if ref.Type != scriptTypeNative {
+		if _, err := cbor.Decode(ref.Raw, &raw); err != nil {
+			return nil, fmt.Errorf("failed to decode Plutus script: %w", err)
+		}
+	}
+	return newScript(ref.Type, raw)
+}
+
+func scriptLanguage(script *pbcardano.Script) pbcardano.ScriptLanguage {
+	switch script.GetScript().(type) {
+	case *pbcardano.Script_Native:
+		return pbcardano.ScriptLanguage_SCRIPT_LANGUAGE_NATIVE
+	case *pbcardano.Script_PlutusV1:
+		return pbcardano.ScriptLanguage_SCRIPT_LANGUAGE_PLUTUS_V1
+	case *pbcardano.Script_PlutusV2:
+		return pbcardano.ScriptLanguage_SCRIPT_LANGUAGE_PLUTUS_V2
+	case *pbcardano.Script_PlutusV3:
+		return pbcardano.ScriptLanguage_SCRIPT_LANGUAGE_PLUTUS_V3
+	}
+	return pbcardano.ScriptLanguage_SCRIPT_LANGUAGE_UNSPECIFIED
+}
@@ -0,0 +1,55 @@
+package convert
+
+import (
+	pbcardano "github.com/no-witness-labs/firehose-cardano/types/pb/sf/cardano/type/v1"
+)
+
+var certificateOneof = (&pbcardano.Certificate{}).ProtoReflect().Descriptor().Oneofs().ByName("certificate")
+
+// blockStats computes the aggregates of the transactions of a block.
+func blockStats(txs []*pbcardano.Tx) *pbcardano.BlockStats {
+	out := &pbcardano.BlockStats{
+		TxCount:      uint64(len(txs)),
+		ExUnits:      &pbcardano.ExUnits{},
+		Certificates: map[string]uint64{},
+	}
+	for _, tx := range txs {
+		if !tx.Successful {
+			out.InvalidTxCount++
+		}
+		out.ScriptCount += uint64(len(tx.ExecutedScripts))
+		out.RedeemerCount += uint64(len(tx.GetWitnesses().GetRedeemers()))
+		for _, redeemer := range tx.GetWitnesses().GetRedeemers() {
+			out.ExUnits.Steps += redeemer.GetExUnits().GetSteps()
+			out.ExUnits.Memory += redeemer.GetExUnits().GetMemory()
+		}
+
+		if fee := tx.GetEffect().Fee; fee != nil {
+			out.Fees += *fee
+		} else {
+			out.UnknownFeeCount++
+		}
+		for _, output := range tx.CreatedOutputs() {
+			out.OutputLovelace += output.Coin
+		}
+		if !tx.BodyApplied() {
+			continue
+		}
+		for _, cert := range tx.Certificates {
+			if fd := cert.ProtoReflect().WhichOneof(certificateOneof); fd != nil {
+				out.Certificates[string(fd.Name())]++
+			}
+		}
+		for _, policy := range tx.Mint {
+			for _, asset := range policy.Assets {
+				switch {
+				case asset.MintCoin > 0:
+					out.MintCount++
+				case asset.MintCoin < 0:
+					out.BurnCount++
+				}
+			}
+		}
+	}
+	return out
+}
@@ -0,0 +1,282 @@
+package convert
+
+import (
+	"bytes"
+	"cmp"
+	"fmt"
+	"slices"
+
+	"github.com/blinklabs-io/gouroboros/cbor"
+	"github.com/blinklabs-io/gouroboros/ledger/alonzo"
+	"github.com/blinklabs-io/gouroboros/ledger/common"
+	"github.com/no-witness-labs/firehose-cardano/metadata"
+	pbcardano "github.com/no-witness-labs/firehose-cardano/types/pb/sf/cardano/type/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// Keys of the transaction body map whose presence cannot be told from the
+// zero values gouroboros decodes them into.
+const (
+	txBodyTTL                  = 3
+	txBodyValidityStart        = 8
+	txBodyTotalCollateral      = 17
+	txBodyNetworkID            = 15
+	txBodyCurrentTreasuryValue = 21
+	txBodyTreasuryDonation     = 22
+)
+
+// enrichTx fills the parts of a transaction the utxorpc conversion of
+// gouroboros does not carry.
+func enrichTx(tx common.Transaction, index int, out *pbcardano.Tx, o *options, state *blockState) error {
+	txCbor := tx.Cbor()
+	parts, err := txParts(txCbor)
+	if err != nil {
+		return err
+	}
+	body, err := txBodyFields(parts[0])
+	if err != nil {
+		return err
+	}
+
+	if o.rawCBOR {
+		out.OriginalBodyCbor = bytes.Clone(parts[0])
+		if len(parts) > 1 {
+			out.OriginalWitnessesCbor = bytes.Clone(parts[1])
+		}
+		// Auxiliary data is the last element, null when absent.
+		if aux := parts[len(parts)-1]; len(parts) > 2 && !bytes.Equal(aux, cborNull) {
+			out.OriginalAuxiliaryCbor = bytes.Clone(aux)
+		}
+	}
+
+	out.Index = uint32(index)
+	out.Successful = tx.IsValid()
+	out.Size = uint64(len(txCbor))
+
+	for _, output := range out.Outputs {
+		sortMultiassets(output.Assets)
+		output.ByronAddress = byronAddress(output.Address)
+	}
+
+	out.Withdrawals, err = withdrawals(tx.Withdrawals())
+	if err != nil {
+		return err
+	}
+	out.Mint = mint(tx.AssetMint())
+
+	// The deprecated start and ttl are kept filled for existing consumers.
+	if _, ok := body[txBodyTTL]; ok {
+		out.Validity = &pbcardano.TxValidity{Ttl: tx.TTL(), InvalidHereafter: proto.Uint64(tx.TTL())}
+	}
+	if _, ok := body[txBodyValidityStart]; ok {
+		if out.Validity == nil {
+			out.Validity = &pbcardano.TxValidity{}
+		}
+		out.Validity.Start = tx.ValidityIntervalStart()
+		out.Validity.InvalidBefore = proto.Uint64(tx.ValidityIntervalStart())
+	}
+
+	if out.Collateral, err = collateral(tx, body); err != nil {
+		return err
+	}
+
+	for _, signer := range tx.RequiredSigners() {
+		out.RequiredSigners = append(out.RequiredSigners, bytes.Clone(signer.Bytes()))
+	}
+	if hash := tx.ScriptDataHash(); hash != nil {
+		out.ScriptDataHash = bytes.Clone(hash.Bytes())
+	}
+	if hash := tx.AuxDataHash(); hash != nil {
+		out.AuxiliaryDataHash = bytes.Clone(hash.Bytes())
+	}
+	if raw, ok := body[txBodyNetworkID]; ok {
+		var networkID uint32
+		if _, err := cbor.Decode(raw, &networkID); err != nil {
+			return fmt.Errorf("failed to decode network id: %w", err)
+		}
+		out.NetworkId = proto.Uint32(networkID)
+	}
+	if _, ok := body[txBodyCurrentTreasuryValue]; ok {
+		out.CurrentTreasuryValue = proto.Uint64(uint64(tx.CurrentTreasuryValue()))
+	}
+	if _, ok := body[txBodyTreasuryDonation]; ok {
+		out.TreasuryDonation = proto.Uint64(tx.Donation())
+	}
+	if out.Update, err = protocolParamUpdate(body, tx.Type() == alonzo.TxTypeAlonzo); err != nil {
+		return err
+	}
+
+	out.VotingProcedures = votingProcedures(tx.VotingProcedures())
+	if out.Proposals, err = proposals(tx.ProposalProcedures()); err != nil {
+		return err
+	}
+	if err := fillDatums(tx, out, state.datums); err != nil {
+		return err
+	}
+
+	if err := state.resolveInputs(out); err != nil {
+		return err
+	}
+	out.Effect = ledgerEffect(out)
+	if len(parts) > 2 {
+		if out.Auxiliary, err = auxiliaryData(parts[len(parts)-1]); err != nil {
+			return err
+		}
+		if o.decodeMetadata {
+			out.DecodedMetadata = metadata.DecodeRecords(out.GetAuxiliary().GetMetadata())
+		}
+	}
+	var witnessCbor []byte
+	if len(parts) > 1 {
+		witnessCbor = parts[1]
+	}
+	if err := fillScripts(tx, witnessCbor, out, state); err != nil {
+		return err
+	}
+	if witnessCbor != nil {
+		bootstrap, err := bootstrapWitnesses(witnessCbor)
+		if err != nil {
+			return err
+		}
+		if len(bootstrap) > 0 {
+			if out.Witnesses == nil {
+				out.Witnesses = &pbcardano.WitnessSet{}
+			}
+			out.Witnesses.BootstrapWitnesses = bootstrap
+		}
+	}
+	if o.cip68 {
+		fillCIP68(out)
+	}
+	state.addOutputs(out)
+	return nil
+}
+
+var cborNull = []byte{0xf6}
+
+// txParts splits a serialized transaction into its body, witness set,
+// validity flag (Alonzo onwards) and auxiliary data.
+func txParts(txCbor []byte) ([]cbor.RawMessage, error) {
+	var parts []cbor.RawMessage
+	if _, err := cbor.Decode(txCbor, &parts); err != nil {
+		return nil, fmt.Errorf("failed to decode transaction: %w", err)
+	}
+	if len(parts) == 0 {
+		return nil, fmt.Errorf("transaction has no body")
+	}
+	return parts, nil
+}
+
+// txBodyFields returns the raw fields of a transaction body, keyed by their
+// body map key.
+func txBodyFields(bodyCbor []byte) (map[uint]cbor.RawMessage, error) {
+	var fields map[uint]cbor.RawMessage
+	if _, err := cbor.Decode(bodyCbor, &fields); err != nil {
+		return nil, fmt.Errorf("failed to decode transaction body: %w", err)
+	}
+	return fields, nil
+}
+
+func collateral(tx common.Transaction, body map[uint]cbor.RawMessage) (*pbcardano.Collateral, error) {
+	out := &pbcardano.Collateral{}
+	for _, input := range tx.Collateral() {
+		in, err := input.Utxorpc()
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert collateral input: %w", err)
+		}
+		pbInput := &pbcardano.TxInput{}
+		if err := fromUtxorpc(in, pbInput); err != nil {
+			return nil, err
+		}
+		out.Collateral = append(out.Collateral, pbInput)
+	}
+
+	if output := tx.CollateralReturn(); output != nil {
+		ret, err := output.Utxorpc()
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert collateral return: %w", err)
+		}
+		out.CollateralReturn = &pbcardano.TxOutput{}
+		if err := fromUtxorpc(ret, out.CollateralReturn); err != nil {
+			return nil, err
+		}
+		sortMultiassets(out.CollateralReturn.Assets)
+		out.CollateralReturn.ByronAddress = byronAddress(out.CollateralReturn.Address)
+	}
+
+	if _, ok := body[txBodyTotalCollateral]; ok {
+		out.TotalCollateral = tx.TotalCollateral()
+		out.DeclaredTotalCollateral = proto.Uint64(tx.TotalCollateral())
+	}
+
+	if len(out.Collateral) == 0 && out.CollateralReturn == nil && out.DeclaredTotalCollateral == nil {
+		return nil, nil
+	}
+	return out, nil
+}
+
+// withdrawals converts the withdrawals of a transaction. gouroboros decodes
+// them into a Go map, they are sorted in the ledger order of reward accounts
+// (network, script before key credentials, hash) to stay deterministic.
+func withdrawals(withdrawals map[*common.Address]uint64) ([]*pbcardano.Withdrawal, error) {
+	var out []*pbcardano.Withdrawal
+	for addr, coin := range withdrawals {
+		account, err := addr.Bytes()
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode reward account: %w", err)
+		}
+		out = append(out, &pbcardano.Withdrawal{RewardAccount: account, Coin: coin})
+	}
+
+	slices.SortFunc(out, func(a, b *pbcardano.Withdrawal) int {
+		return compareRewardAccounts(a.RewardAccount, b.RewardAccount)
+	})
+	return out, nil
+}
+
+func compareRewardAccounts(a, b []byte) int {
+	if len(a) == 0 || len(b) == 0 {
+		return cmp.Compare(len(a), len(b))
+	}
+	// Header is 0xe0 (key) or 0xf0 (script) | network id.
+	return cmp.Or(
+		cmp.Compare(a[0]&0x0f, b[0]&0x0f),
+		-cmp.Compare(a[0]&0xf0, b[0]&0xf0),
+		bytes.Compare(a[1:], b[1:]),
+	)
+}
+
+func mint(assets *common.MultiAsset[common.MultiAssetTypeMint]) []*pbcardano.Multiasset {
+	if assets == nil {
+		return nil
+	}
+
+	var out []*pbcardano.Multiasset
+	for _, policyID := range assets.Policies() {
+		ma := &pbcardano.Multiasset{PolicyId: bytes.Clone(policyID.Bytes())}
+		for _, name := range assets.Assets(policyID) {
+			ma.Assets = append(ma.Assets, &pbcardano.Asset{
+				Name:     bytes.Clone(name),
+				MintCoin: assets.Asset(policyID, name),
+			})
+		}
+		out = append(out, ma)
+	}
+
+	sortMultiassets(out)
+	return out
+}
+
+// sortMultiassets orders policies and asset names bytewise. gouroboros keeps
+// multi-assets in Go maps, which would otherwise make their order change
+// between conversions of the same block.
+func sortMultiassets(assets []*pbcardano.Multiasset) {
+	slices.SortFunc(assets, func(a, b *pbcardano.Multiasset) int {
+		return bytes.Compare(a.PolicyId, b.PolicyId)
+	})
+	for _, ma := range assets {
+		slices.SortFunc(ma.Assets, func(a, b *pbcardano.Asset) int {
+			return bytes.Compare(a.Name, b.Name)
+		})
+	}
+}
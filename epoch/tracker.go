@@ -0,0 +1,151 @@
+// Package epoch marks the epoch transitions of the chain the fetcher
+// follows.
+//
+// The tracker counts the blocks and transactions of the current epoch. The
+// first block of a new epoch gets an EpochTransition closing the previous
+// one, so that consumers triggering on epoch boundaries, reward or snapshot
+// pipelines, do not have to derive them from slot arithmetic.
+//
+// Like the UTxO store, the tracker keeps the undo records of its last
+// security parameter blocks so that chain-sync rollbacks can be reverted.
+package epoch
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/blinklabs-io/gouroboros/protocol/common"
+	"github.com/fxamacker/cbor/v2"
+	"github.com/no-witness-labs/firehose-cardano/era"
+	"github.com/no-witness-labs/firehose-cardano/internal/undolog"
+	pbcardano "github.com/no-witness-labs/firehose-cardano/types/pb/sf/cardano/type/v1"
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	metaBucket = []byte("meta")
+
+	stateKey = []byte("state")
+)
+
+// ErrRollbackTooDeep is returned when a rollback goes past the retained undo
+// records. The tracker is then unusable and has to be rebuilt.
+var ErrRollbackTooDeep = undolog.ErrRollbackTooDeep
+
+// Tracker is a persistent epoch tracker.
+type Tracker struct {
+	db      *bolt.DB
+	history *era.History
+	log     *undolog.Log
+}
+
+// state is the epoch of the tip and what was seen of it.
+type state struct {
+	Epoch   uint64 `cbor:"0,keyasint"`
+	Blocks  uint64 `cbor:"1,keyasint"`
+	Txs     uint64 `cbor:"2,keyasint"`
+	Partial bool   `cbor:"3,keyasint"` // The tracker started in the middle of the epoch
+}
+
+// Open opens, or creates, the tracker database at path.
+func Open(path string, history *era.History) (*Tracker, error) {
+	db, err := bolt.Open(path, 0600, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open epoch tracker %s: %w", path, err)
+	}
+
+	log := undolog.New("epoch tracker", func(slot uint64) uint64 { return history.EraAt(slot).SecurityParam })
+	if err := db.Update(log.Init); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to initialize epoch tracker %s: %w", path, err)
+	}
+	return &Tracker{db: db, history: history, log: log}, nil
+}
+
+func (t *Tracker) Close() error {
+	return t.db.Close()
+}
+
+// Tip returns the point of the last applied block, nil for an empty tracker.
+func (t *Tracker) Tip() (*common.Point, error) {
+	var point *common.Point
+	err := t.db.View(func(tx *bolt.Tx) error {
+		var err error
+		point, err = t.log.Tip(tx)
+		return err
+	})
+	return point, err
+}
+
+// ApplyBlock counts a converted block in its epoch. When the block is the
+// first of an epoch, the epoch transition is attached to it.
+func (t *Tracker) ApplyBlock(block *pbcardano.Block) error {
+	slot, hash := block.GetHeader().GetSlot(), block.GetHeader().GetHash()
+	return t.db.Update(func(tx *bolt.Tx) error {
+		tip, err := t.log.Tip(tx)
+		if err != nil {
+			return err
+		}
+		if tip != nil && slot < tip.Slot {
+			return fmt.Errorf("block at slot %d does not extend epoch tracker tip at slot %d", slot, tip.Slot)
+		}
+		// The undo data is the state before the block, nil for the first
+		// block.
+		var undo []byte
+
+		meta := tx.Bucket(metaBucket)
+		epoch := t.history.SlotToEpoch(slot)
+		txs := uint64(len(block.GetBody().GetTx()))
+		st := &state{Epoch: epoch, Blocks: 1, Txs: txs}
+		if tip == nil {
+			// The counts of the first epoch followed are complete only when
+			// the chain is followed from its first block.
+			st.Partial = block.GetHeader().GetHeight() != 0
+		} else {
+			undo = bytes.Clone(meta.Get(stateKey))
+			prev := &state{}
+			if err := cbor.Unmarshal(undo, prev); err != nil {
+				return fmt.Errorf("failed to decode epoch state: %w", err)
+			}
+
+			switch {
+			case epoch == prev.Epoch:
+				st.Blocks, st.Txs, st.Partial = prev.Blocks+1, prev.Txs+txs, prev.Partial
+			case epoch > prev.Epoch:
+				block.EpochTransition = &pbcardano.EpochTransition{
+					PreviousEpoch:           prev.Epoch,
+					Epoch:                   epoch,
+					FirstSlot:               t.history.EpochFirstSlot(epoch),
+					PreviousEpochBlockCount: prev.Blocks,
+					PreviousEpochTxCount:    prev.Txs,
+					Partial:                 prev.Partial,
+				}
+			default:
+				return fmt.Errorf("block at slot %d is in epoch %d, before epoch %d of the tracker tip", slot, epoch, prev.Epoch)
+			}
+		}
+
+		data, err := cbor.Marshal(st)
+		if err != nil {
+			return fmt.Errorf("failed to encode epoch state: %w", err)
+		}
+		if err := meta.Put(stateKey, data); err != nil {
+			return err
+		}
+		return t.log.Push(tx, common.NewPoint(slot, hash), undo)
+	})
+}
+
+// Rollback reverts the blocks applied after point.
+func (t *Tracker) Rollback(point common.Point) error {
+	return t.db.Update(func(tx *bolt.Tx) error {
+		meta := tx.Bucket(metaBucket)
+		return t.log.Rollback(tx, point, func(prev []byte) error {
+			// Rolling back the first block followed empties the tracker.
+			if prev == nil {
+				return meta.Delete(stateKey)
+			}
+			return meta.Put(stateKey, prev)
+		})
+	})
+}
@@ -0,0 +1,150 @@
+package epoch
+
+import (
+	"errors"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/blinklabs-io/gouroboros/protocol/common"
+	"github.com/no-witness-labs/firehose-cardano/era"
+	pbcardano "github.com/no-witness-labs/firehose-cardano/types/pb/sf/cardano/type/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// testHistory is a chain of 10-slot epochs rolled back at most 3 blocks.
+var testHistory = &era.History{
+	Network: "test",
+	Eras:    []era.Era{{Name: "conway", SlotLength: 1000, EpochLength: 10, SecurityParam: 3}},
+}
+
+// testBlock returns a block at slot, of the given height and number of
+// transactions, its hash being the slot.
+func testBlock(slot, height uint64, txs int) *pbcardano.Block {
+	block := &pbcardano.Block{Header: &pbcardano.BlockHeader{Slot: slot, Hash: []byte{byte(slot)}, Height: height}}
+	if txs > 0 {
+		block.Body = &pbcardano.BlockBody{Tx: make([]*pbcardano.Tx, txs)}
+		for i := range block.Body.Tx {
+			block.Body.Tx[i] = &pbcardano.Tx{}
+		}
+	}
+	return block
+}
+
+func point(slot uint64) *common.Point {
+	p := common.NewPoint(slot, []byte{byte(slot)})
+	return &p
+}
+
+func TestTracker(t *testing.T) {
+	// The first epoch, followed from the first block of the chain.
+	genesis := []*pbcardano.Block{testBlock(0, 0, 1), testBlock(5, 1, 2)}
+
+	for _, tc := range []struct {
+		name       string
+		blocks     []*pbcardano.Block
+		rollback   *common.Point
+		err        error
+		next       *pbcardano.Block
+		transition *pbcardano.EpochTransition
+	}{
+		{
+			name:       "boundary",
+			blocks:     genesis,
+			next:       testBlock(10, 2, 0),
+			transition: &pbcardano.EpochTransition{PreviousEpoch: 0, Epoch: 1, FirstSlot: 10, PreviousEpochBlockCount: 2, PreviousEpochTxCount: 3},
+		},
+		{
+			name:       "skipped epoch",
+			blocks:     genesis,
+			next:       testBlock(25, 2, 0),
+			transition: &pbcardano.EpochTransition{PreviousEpoch: 0, Epoch: 2, FirstSlot: 20, PreviousEpochBlockCount: 2, PreviousEpochTxCount: 3},
+		},
+		{
+			name:   "same epoch",
+			blocks: genesis,
+			next:   testBlock(9, 2, 0),
+		},
+		{
+			name:       "started mid-epoch",
+			blocks:     []*pbcardano.Block{testBlock(3, 100, 1), testBlock(5, 101, 1)},
+			next:       testBlock(10, 102, 0),
+			transition: &pbcardano.EpochTransition{PreviousEpoch: 0, Epoch: 1, FirstSlot: 10, PreviousEpochBlockCount: 2, PreviousEpochTxCount: 2, Partial: true},
+		},
+		{
+			name:       "rollback across the boundary",
+			blocks:     slices.Concat(genesis, []*pbcardano.Block{testBlock(10, 2, 4), testBlock(12, 3, 1)}),
+			rollback:   point(5),
+			next:       testBlock(11, 2, 0),
+			transition: &pbcardano.EpochTransition{PreviousEpoch: 0, Epoch: 1, FirstSlot: 10, PreviousEpochBlockCount: 2, PreviousEpochTxCount: 3},
+		},
+		{
+			name:     "rollback within the epoch",
+			blocks:   slices.Concat(genesis, []*pbcardano.Block{testBlock(10, 2, 4), testBlock(12, 3, 1)}),
+			rollback: point(10),
+			next:     testBlock(20, 3, 0),
+			transition: &pbcardano.EpochTransition{
+				PreviousEpoch: 1, Epoch: 2, FirstSlot: 20, PreviousEpochBlockCount: 1, PreviousEpochTxCount: 4,
+			},
+		},
+		{
+			// Back to an empty tracker, the next block is again the first
+			// one followed.
+			name:     "rollback of the first block",
+			blocks:   genesis[:1],
+			rollback: &common.Point{},
+			next:     testBlock(3, 1, 0),
+		},
+		{
+			name:     "rollback beyond the undo depth",
+			blocks:   slices.Concat(genesis, []*pbcardano.Block{testBlock(10, 2, 0), testBlock(12, 3, 0), testBlock(15, 4, 0)}),
+			rollback: point(0),
+			err:      ErrRollbackTooDeep,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			tracker, err := Open(filepath.Join(t.TempDir(), "epoch.db"), testHistory)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer tracker.Close()
+
+			for _, block := range tc.blocks {
+				if err := tracker.ApplyBlock(proto.Clone(block).(*pbcardano.Block)); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if tc.rollback != nil {
+				err := tracker.Rollback(*tc.rollback)
+				if tc.err != nil {
+					if !errors.Is(err, tc.err) {
+						t.Errorf("rollback: %v, expected %v", err, tc.err)
+					}
+					return
+				}
+				if err != nil {
+					t.Fatal(err)
+				}
+				tip, err := tracker.Tip()
+				if err != nil {
+					t.Fatal(err)
+				}
+				if tc.rollback.Slot == 0 && len(tc.rollback.Hash) == 0 {
+					if tip != nil {
+						t.Errorf("tip %v after rolling back every block", tip)
+					}
+				} else if tip == nil || tip.Slot != tc.rollback.Slot {
+					t.Errorf("tip %v after rollback, expected slot %d", tip, tc.rollback.Slot)
+				}
+			}
+
+			next := proto.Clone(tc.next).(*pbcardano.Block)
+			if err := tracker.ApplyBlock(next); err != nil {
+				t.Fatal(err)
+			}
+			if !proto.Equal(next.EpochTransition, tc.transition) {
+				t.Errorf("transition %v, expected %v", next.EpochTransition, tc.transition)
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package era
+
+import (
+	"fmt"
+	"time"
+)
+
+// Era describes the slot and epoch layout of one ledger era of a network.
+type Era struct {
+	Name          string
+	StartSlot     uint64 // Absolute slot of the first slot of the era
+	StartEpoch    uint64 // First epoch of the era
+	StartTime     int64  // Unix ms timestamp of the era's first slot
+	SlotLength    int64  // Slot duration in ms
+	EpochLength   uint64 // Number of slots per epoch
+	SecurityParam uint64
+}
+
+// History is the ordered list of eras of a network. The last era is
+// open-ended.
+type History struct {
+	Network string
+	Eras    []Era
+}
+
+func shelleyBased(name string, prev Era, epoch uint64) Era {
+	// Every era after Byron shares the Shelley slot and epoch layout, so the
+	// start of an era can be derived from its first epoch.
+	start := prev.StartSlot + (epoch-prev.StartEpoch)*prev.EpochLength
+	return Era{
+		Name:          name,
+		StartSlot:     start,
+		StartEpoch:    epoch,
+		StartTime:     prev.StartTime + int64(start-prev.StartSlot)*prev.SlotLength,
+		SlotLength:    1000,
+		EpochLength:   prev.EpochLength,
+		SecurityParam: prev.SecurityParam,
+	}
+}
+
+func newHistory(network string, eras ...Era) *History {
+	return &History{Network: network, Eras: eras}
+}
+
+func (h *History) followedBy(names []string, epochs []uint64) *History {
+	for i, name := range names {
+		h.Eras = append(h.Eras, shelleyBased(name, h.Eras[len(h.Eras)-1], epochs[i]))
+	}
+	return h
+}
+
+var shelleyEras = []string{"allegra", "mary", "alonzo", "babbage", "conway"}
+
+var histories = map[string]*History{
+	"mainnet": newHistory("mainnet",
+		Era{Name: "byron", StartTime: 1506203091000, SlotLength: 20000, EpochLength: 21600, SecurityParam: 2160},
+		Era{Name: "shelley", StartSlot: 4492800, StartEpoch: 208, StartTime: 1596059091000, SlotLength: 1000, EpochLength: 432000, SecurityParam: 2160},
+	).followedBy(shelleyEras, []uint64{236, 251, 290, 365, 507}),
+	"preprod": newHistory("preprod",
+		Era{Name: "byron", StartTime: 1654041600000, SlotLength: 20000, EpochLength: 21600, SecurityParam: 2160},
+		Era{Name: "shelley", StartSlot: 86400, StartEpoch: 4, StartTime: 1655769600000, SlotLength: 1000, EpochLength: 432000, SecurityParam: 2160},
+	).followedBy(shelleyEras, []uint64{5, 6, 7, 12, 163}),
+	"preview": newHistory("preview",
+		Era{Name: "alonzo", StartTime: 1666656000000, SlotLength: 1000, EpochLength: 86400, SecurityParam: 432},
+	).followedBy([]string{"babbage", "conway"}, []uint64{3, 646}),
+}
+
+// ForNetwork returns the era history of a known network.
+func ForNetwork(network string) (*History, error) {
+	h, ok := histories[network]
+	if !ok {
+		return nil, fmt.Errorf("unknown network %q", network)
+	}
+	return h, nil
+}
+
+// EraAt returns the era the slot belongs to.
+func (h *History) EraAt(slot uint64) *Era {
+	for i := len(h.Eras) - 1; i > 0; i-- {
+		if slot >= h.Eras[i].StartSlot {
+			return &h.Eras[i]
+		}
+	}
+	return &h.Eras[0]
+}
+
+// EraOfEpoch returns the era the epoch belongs to.
+func (h *History) EraOfEpoch(epoch uint64) *Era {
+	for i := len(h.Eras) - 1; i > 0; i-- {
+		if epoch >= h.Eras[i].StartEpoch {
+			return &h.Eras[i]
+		}
+	}
+	return &h.Eras[0]
+}
+
+// SlotToUnixMilli returns the ms timestamp at which the slot begins.
+func (h *History) SlotToUnixMilli(slot uint64) int64 {
+	e := h.EraAt(slot)
+	return e.StartTime + int64(slot-e.StartSlot)*e.SlotLength
+}
+
+// SlotToTime returns the time at which the slot begins.
+func (h *History) SlotToTime(slot uint64) time.Time {
+	return time.UnixMilli(h.SlotToUnixMilli(slot)).UTC()
+}
+
+// SlotToEpoch returns the epoch the slot belongs to.
+func (h *History) SlotToEpoch(slot uint64) uint64 {
+	e := h.EraAt(slot)
+	return e.StartEpoch + (slot-e.StartSlot)/e.EpochLength
+}
+
+// EpochFirstSlot returns the first slot of the epoch.
+func (h *History) EpochFirstSlot(epoch uint64) uint64 {
+	e := h.EraOfEpoch(epoch)
+	return e.StartSlot + (epoch-e.StartEpoch)*e.EpochLength
+}
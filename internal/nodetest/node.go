@@ -0,0 +1,250 @@
+// Package nodetest runs an in-process Cardano node for tests. The node is
+// made of the server side of the gouroboros mini-protocols: it serves a fixed
+// chain over block-fetch and chain-sync, and answers LocalStateQuery queries
+// with a callback.
+package nodetest
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/hex"
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	ouroboros "github.com/blinklabs-io/gouroboros"
+	"github.com/blinklabs-io/gouroboros/ledger"
+	"github.com/blinklabs-io/gouroboros/protocol/blockfetch"
+	"github.com/blinklabs-io/gouroboros/protocol/chainsync"
+	"github.com/blinklabs-io/gouroboros/protocol/common"
+	"github.com/blinklabs-io/gouroboros/protocol/localstatequery"
+)
+
+// NetworkMagic is the network magic of the node, the mainnet one.
+const NetworkMagic = 764824073
+
+//go:embed testdata/conway_block.hex
+var conwayBlockHex string
+
+// ConwayBlock returns mainnet block
+// 27807a70215e3e018eec9be8c619c692e06a78ebcb63daf90d7abe823f3bbf47, at slot
+// 159835207.
+func ConwayBlock(t testing.TB) ledger.Block {
+	t.Helper()
+	data, err := hex.DecodeString(strings.TrimSpace(conwayBlockHex))
+	if err != nil {
+		t.Fatal(err)
+	}
+	block, err := ledger.NewBlockFromCbor(ledger.BlockTypeConway, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return block
+}
+
+// Node serves Chain, oldest block first, to the connections it accepts.
+type Node struct {
+	Chain []ledger.Block
+
+	// Query answers the LocalStateQuery queries of node-to-client
+	// connections, the query being one of the types of the localstatequery
+	// package. The result is CBOR encoded as is. Nil fails every query.
+	Query func(query any) (any, error)
+
+	mu     sync.Mutex
+	cursor map[string]*cursor // Chain-sync position, by connection
+}
+
+type cursor struct {
+	intersect *common.Point // Point to roll back to first, as nodes do after an intersection
+	next      int           // Index of the next block
+}
+
+// ListenTCP accepts node-to-node connections on a local TCP port until the
+// end of the test, and returns the address to dial.
+func (n *Node) ListenTCP(t testing.TB) string {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	n.serve(t, listener, true)
+	return listener.Addr().String()
+}
+
+// ListenUnix accepts node-to-client connections on a unix socket until the
+// end of the test, and returns the path of the socket.
+func (n *Node) ListenUnix(t testing.TB) string {
+	t.Helper()
+	// t.TempDir paths can exceed the length limit of socket paths.
+	dir, err := os.MkdirTemp("", "nodetest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	path := filepath.Join(dir, "node.socket")
+	listener, err := net.Listen("unix", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	n.serve(t, listener, false)
+	return path
+}
+
+func (n *Node) serve(t testing.TB, listener net.Listener, nodeToNode bool) {
+	var (
+		mu    sync.Mutex
+		conns []*ouroboros.Connection
+	)
+	t.Cleanup(func() {
+		listener.Close()
+		mu.Lock()
+		defer mu.Unlock()
+		for _, conn := range conns {
+			conn.Close()
+		}
+	})
+
+	go func() {
+		for {
+			netConn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			errorChan := make(chan error, 10)
+			go func() {
+				for range errorChan {
+				}
+			}()
+			conn, err := ouroboros.NewConnection(
+				ouroboros.WithConnection(netConn),
+				ouroboros.WithServer(true),
+				ouroboros.WithNodeToNode(nodeToNode),
+				ouroboros.WithNetworkMagic(NetworkMagic),
+				ouroboros.WithErrorChan(errorChan),
+				ouroboros.WithBlockFetchConfig(blockfetch.NewConfig(
+					blockfetch.WithRequestRangeFunc(n.requestRange),
+				)),
+				ouroboros.WithChainSyncConfig(chainsync.NewConfig(
+					chainsync.WithFindIntersectFunc(n.findIntersect),
+					chainsync.WithRequestNextFunc(n.requestNext),
+				)),
+				ouroboros.WithLocalStateQueryConfig(localstatequery.NewConfig(
+					localstatequery.WithAcquireFunc(n.acquire),
+					localstatequery.WithQueryFunc(n.query),
+					localstatequery.WithReleaseFunc(func(localstatequery.CallbackContext) error { return nil }),
+				)),
+			)
+			if err != nil {
+				netConn.Close()
+				continue
+			}
+			mu.Lock()
+			conns = append(conns, conn)
+			mu.Unlock()
+		}
+	}()
+}
+
+// index returns the index of the block at point, -1 when it is not in the
+// chain.
+func (n *Node) index(point common.Point) int {
+	for i, block := range n.Chain {
+		if block.SlotNumber() == point.Slot && bytes.Equal(block.Hash().Bytes(), point.Hash) {
+			return i
+		}
+	}
+	return -1
+}
+
+func (n *Node) tip() chainsync.Tip {
+	if len(n.Chain) == 0 {
+		return chainsync.Tip{Point: common.NewPointOrigin()}
+	}
+	last := n.Chain[len(n.Chain)-1]
+	return chainsync.Tip{
+		Point:       common.NewPoint(last.SlotNumber(), last.Hash().Bytes()),
+		BlockNumber: last.BlockNumber(),
+	}
+}
+
+func (n *Node) requestRange(ctx blockfetch.CallbackContext, start, end common.Point) error {
+	from, to := n.index(start), n.index(end)
+	if from < 0 || to < from {
+		return ctx.Server.NoBlocks()
+	}
+	if err := ctx.Server.StartBatch(); err != nil {
+		return err
+	}
+	for _, block := range n.Chain[from : to+1] {
+		if err := ctx.Server.Block(uint(block.Type()), block.Cbor()); err != nil {
+			return err
+		}
+	}
+	return ctx.Server.BatchDone()
+}
+
+func (n *Node) findIntersect(ctx chainsync.CallbackContext, points []common.Point) (common.Point, chainsync.Tip, error) {
+	for _, point := range points {
+		i := -1
+		if point.Slot != 0 || len(point.Hash) != 0 {
+			if i = n.index(point); i < 0 {
+				continue
+			}
+		}
+		n.mu.Lock()
+		if n.cursor == nil {
+			n.cursor = map[string]*cursor{}
+		}
+		n.cursor[ctx.ConnectionId.String()] = &cursor{intersect: &point, next: i + 1}
+		n.mu.Unlock()
+		return point, n.tip(), nil
+	}
+	return common.Point{}, n.tip(), chainsync.ErrIntersectNotFound
+}
+
+// requestNext rolls back to the intersection, then forward block by block,
+// the tip of the chain being followed by an endless wait.
+func (n *Node) requestNext(ctx chainsync.CallbackContext) error {
+	n.mu.Lock()
+	c := n.cursor[ctx.ConnectionId.String()]
+	var intersect *common.Point
+	next := len(n.Chain)
+	if c != nil {
+		intersect, c.intersect = c.intersect, nil
+		if intersect == nil && c.next < len(n.Chain) {
+			next = c.next
+			c.next++
+		}
+	}
+	n.mu.Unlock()
+
+	if intersect != nil {
+		return ctx.Server.RollBackward(*intersect, n.tip())
+	}
+	if next >= len(n.Chain) {
+		return ctx.Server.AwaitReply()
+	}
+	// The server sends the header of the block itself to node-to-node
+	// clients.
+	block := n.Chain[next]
+	return ctx.Server.RollForward(uint(block.Type()), block.Cbor(), n.tip())
+}
+
+// acquire accepts any point. Refusing it is not an option: the server of
+// gouroboros follows an acquire failure with an acquired message.
+func (n *Node) acquire(_ localstatequery.CallbackContext, _ localstatequery.AcquireTarget, _ bool) error {
+	return nil
+}
+
+func (n *Node) query(_ localstatequery.CallbackContext, query localstatequery.QueryWrapper) (any, error) {
+	if n.Query == nil {
+		return nil, errors.New("no ledger state")
+	}
+	return n.Query(query.Query)
+}
@@ -0,0 +1,202 @@
+// Package undolog keeps the chain position of a store following the chain
+// block by block, and the undo records that let it revert chain-sync
+// rollbacks.
+//
+// The log lives in the meta and undo buckets of the store database, the meta
+// bucket being shared with the store. Each applied block pushes a record
+// holding the data the store needs to revert it; records beyond the undo
+// depth are pruned, oldest first.
+package undolog
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/blinklabs-io/gouroboros/protocol/common"
+	"github.com/fxamacker/cbor/v2"
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	metaBucket = []byte("meta")
+	undoBucket = []byte("undo")
+
+	tipKey       = []byte("tip")
+	undoCountKey = []byte("undo_count")
+)
+
+// ErrRollbackTooDeep is returned when a rollback goes past the retained undo
+// records.
+var ErrRollbackTooDeep = errors.New("rollback beyond the undo depth")
+
+// Log is the undo log of a store.
+type Log struct {
+	name  string
+	depth func(slot uint64) uint64
+}
+
+type record struct {
+	Slot     uint64 `cbor:"0,keyasint"`
+	Hash     []byte `cbor:"1,keyasint"`
+	PrevSlot uint64 `cbor:"2,keyasint"`
+	PrevHash []byte `cbor:"3,keyasint"`
+	First    bool   `cbor:"4,keyasint"` // The block is the first one followed
+	Data     []byte `cbor:"5,keyasint"` // Undo data of the store
+}
+
+type tipRecord struct {
+	Slot uint64 `cbor:"0,keyasint"`
+	Hash []byte `cbor:"1,keyasint"`
+}
+
+// New returns the log of the store called name in error messages, keeping
+// the undo records of the last depth(slot) blocks, slot being the one of the
+// last block applied.
+func New(name string, depth func(slot uint64) uint64) *Log {
+	return &Log{name: name, depth: depth}
+}
+
+// Init creates the buckets of the log.
+func (l *Log) Init(tx *bolt.Tx) error {
+	for _, name := range [][]byte{metaBucket, undoBucket} {
+		if _, err := tx.CreateBucketIfNotExists(name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Tip returns the point of the last applied block, nil when there is none.
+func (l *Log) Tip(tx *bolt.Tx) (*common.Point, error) {
+	data := tx.Bucket(metaBucket).Get(tipKey)
+	if data == nil {
+		return nil, nil
+	}
+	tip := &tipRecord{}
+	if err := cbor.Unmarshal(data, tip); err != nil {
+		return nil, fmt.Errorf("failed to decode %s tip: %w", l.name, err)
+	}
+	point := common.NewPoint(tip.Slot, tip.Hash)
+	return &point, nil
+}
+
+// Len returns the number of retained undo records.
+func (l *Log) Len(tx *bolt.Tx) uint64 {
+	return readUint64(tx.Bucket(metaBucket).Get(undoCountKey))
+}
+
+// Push records the block at point as the new tip, data being what the store
+// needs to revert it.
+func (l *Log) Push(tx *bolt.Tx, point common.Point, data []byte) error {
+	tip, err := l.Tip(tx)
+	if err != nil {
+		return err
+	}
+	rec := &record{Slot: point.Slot, Hash: point.Hash, First: tip == nil, Data: data}
+	if tip != nil {
+		rec.PrevSlot, rec.PrevHash = tip.Slot, tip.Hash
+	}
+
+	encoded, err := cbor.Marshal(rec)
+	if err != nil {
+		return fmt.Errorf("failed to encode undo record: %w", err)
+	}
+	undos := tx.Bucket(undoBucket)
+	seq, err := undos.NextSequence()
+	if err != nil {
+		return err
+	}
+	if err := undos.Put(uint64Bytes(seq), encoded); err != nil {
+		return err
+	}
+
+	depth := l.depth(point.Slot)
+	count := l.Len(tx) + 1
+	c := undos.Cursor()
+	for k, _ := c.First(); k != nil && count > depth; k, _ = c.First() {
+		if err := undos.Delete(k); err != nil {
+			return err
+		}
+		count--
+	}
+	if err := tx.Bucket(metaBucket).Put(undoCountKey, uint64Bytes(count)); err != nil {
+		return err
+	}
+	return writeTip(tx, &tipRecord{Slot: point.Slot, Hash: point.Hash})
+}
+
+// Rollback reverts the blocks applied after point, last first, handing the
+// data of their records to undo. Rolling back the first block followed
+// empties the log, whatever the point.
+func (l *Log) Rollback(tx *bolt.Tx, point common.Point, undo func(data []byte) error) error {
+	tip, err := l.Tip(tx)
+	if err != nil {
+		return err
+	}
+	// Nothing was applied after the point.
+	if tip == nil || tip.Slot < point.Slot {
+		return nil
+	}
+
+	meta := tx.Bucket(metaBucket)
+	undos := tx.Bucket(undoBucket)
+	count := l.Len(tx)
+
+	c := undos.Cursor()
+	for tip.Slot != point.Slot || !bytes.Equal(tip.Hash, point.Hash) {
+		if tip.Slot < point.Slot {
+			return fmt.Errorf("rollback point at slot %d is not on the %s chain", point.Slot, l.name)
+		}
+
+		k, v := c.Last()
+		if k == nil {
+			return fmt.Errorf("%w: slot %d", ErrRollbackTooDeep, point.Slot)
+		}
+		rec := &record{}
+		if err := cbor.Unmarshal(v, rec); err != nil {
+			return fmt.Errorf("failed to decode undo record: %w", err)
+		}
+		if err := undo(rec.Data); err != nil {
+			return err
+		}
+		if err := undos.Delete(k); err != nil {
+			return err
+		}
+		count--
+
+		if rec.First {
+			if err := meta.Put(undoCountKey, uint64Bytes(count)); err != nil {
+				return err
+			}
+			return meta.Delete(tipKey)
+		}
+		p := common.NewPoint(rec.PrevSlot, rec.PrevHash)
+		tip = &p
+	}
+
+	if err := meta.Put(undoCountKey, uint64Bytes(count)); err != nil {
+		return err
+	}
+	return writeTip(tx, &tipRecord{Slot: tip.Slot, Hash: tip.Hash})
+}
+
+func writeTip(tx *bolt.Tx, tip *tipRecord) error {
+	data, err := cbor.Marshal(tip)
+	if err != nil {
+		return err
+	}
+	return tx.Bucket(metaBucket).Put(tipKey, data)
+}
+
+func uint64Bytes(v uint64) []byte {
+	return binary.BigEndian.AppendUint64(nil, v)
+}
+
+func readUint64(data []byte) uint64 {
+	if len(data) != 8 {
+		return 0
+	}
+	return binary.BigEndian.Uint64(data)
+}
@@ -0,0 +1,447 @@
+// Package ledgerstate queries the ledger state of a local node through the
+// LocalStateQuery mini-protocol, which only node-to-client connections
+// speak.
+//
+// A snapshot is taken at the first block of an epoch: the era history, the
+// protocol parameters in force, the stake distribution and the parameters of
+// the registered pools. The node only keeps the ledger states of its last
+// security parameter blocks, boundaries further behind its tip cannot be
+// queried.
+//
+// The treasury, the reserves and the Conway governance state come from the
+// debug epoch state query. When the epoch state cannot be decoded, the
+// snapshot is returned without them along with ErrEpochState.
+package ledgerstate
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"time"
+
+	"github.com/blinklabs-io/gouroboros/cbor"
+	"github.com/blinklabs-io/gouroboros/ledger"
+	"github.com/blinklabs-io/gouroboros/protocol/common"
+	"github.com/blinklabs-io/gouroboros/protocol/localstatequery"
+	pbcardano "github.com/no-witness-labs/firehose-cardano/types/pb/sf/cardano/type/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// eraNames are the names of the eras of the hard fork combinator, by era
+// index.
+var eraNames = []string{"byron", "shelley", "allegra", "mary", "alonzo", "babbage", "conway"}
+
+// ErrEpochState is returned, along with the rest of the snapshot, when the
+// epoch state of the node cannot be queried or decoded.
+var ErrEpochState = errors.New("epoch state unavailable")
+
+// Snapshot acquires the ledger state of the node at point, the first block
+// of epoch, and queries it. The state is released before returning.
+func Snapshot(client *localstatequery.Client, point common.Point, epoch uint64) (*pbcardano.LedgerSnapshot, error) {
+	if err := client.Acquire(&point); err != nil {
+		return nil, fmt.Errorf("failed to acquire ledger state at slot %d: %w", point.Slot, err)
+	}
+	out, err := query(client, epoch)
+	var stateErr error
+	if err == nil {
+		stateErr = epochState(client, out)
+	}
+	if releaseErr := client.Release(); releaseErr != nil && err == nil {
+		err = fmt.Errorf("failed to release ledger state: %w", releaseErr)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if stateErr != nil {
+		return out, fmt.Errorf("%w: %w", ErrEpochState, stateErr)
+	}
+	return out, nil
+}
+
+// Params acquires the ledger state of the node at point and queries the
+// protocol parameters in force. The state is released before returning.
+func Params(client *localstatequery.Client, point common.Point) (*pbcardano.PParams, error) {
+	if err := client.Acquire(&point); err != nil {
+		return nil, fmt.Errorf("failed to acquire ledger state at slot %d: %w", point.Slot, err)
+	}
+	out, err := protocolParams(client)
+	if releaseErr := client.Release(); releaseErr != nil && err == nil {
+		err = fmt.Errorf("failed to release ledger state: %w", releaseErr)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func query(client *localstatequery.Client, epoch uint64) (*pbcardano.LedgerSnapshot, error) {
+	out := &pbcardano.LedgerSnapshot{Epoch: epoch}
+
+	systemStart, err := client.GetSystemStart()
+	if err != nil {
+		return nil, fmt.Errorf("failed to query system start: %w", err)
+	}
+	eras, err := client.GetEraHistory()
+	if err != nil {
+		return nil, fmt.Errorf("failed to query era history: %w", err)
+	}
+	if out.EraHistory, err = eraSummaries(systemStart, eras); err != nil {
+		return nil, err
+	}
+
+	if out.ProtocolParams, err = protocolParams(client); err != nil {
+		return nil, err
+	}
+
+	distribution, err := client.GetStakeDistribution()
+	if err != nil {
+		return nil, fmt.Errorf("failed to query stake distribution: %w", err)
+	}
+	if out.StakeDistribution, err = stakeDistribution(distribution); err != nil {
+		return nil, err
+	}
+
+	pools, err := client.GetStakePools()
+	if err != nil {
+		return nil, fmt.Errorf("failed to query stake pools: %w", err)
+	}
+	if len(pools.Results) > 0 {
+		poolParams, err := client.GetStakePoolParams(pools.Results)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query stake pool parameters: %w", err)
+		}
+		if out.Pools, err = stakePoolParams(poolParams); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+func protocolParams(client *localstatequery.Client) (*pbcardano.PParams, error) {
+	params, err := client.GetCurrentProtocolParams()
+	if err != nil {
+		return nil, fmt.Errorf("failed to query protocol parameters: %w", err)
+	}
+	utxorpcParams, err := params.Utxorpc()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert protocol parameters: %w", err)
+	}
+	out := &pbcardano.PParams{}
+	if err := fromUtxorpc(utxorpcParams, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// epochState sets the treasury, reserves and governance state of out from
+// the epoch state of the node, walking its generic decoding:
+//
+//	[[treasury, reserves], [certState, [utxo, deposited, fees, govState, ...]], ...]
+func epochState(client *localstatequery.Client, out *pbcardano.LedgerSnapshot) error {
+	result, err := client.DebugEpochState()
+	if err != nil {
+		return fmt.Errorf("failed to query epoch state: %w", err)
+	}
+	// Shelley query results are wrapped in an array.
+	state, err := at(*result, 0)
+	if err != nil {
+		return err
+	}
+
+	var treasury, reserves uint64
+	if treasury, err = uint64At(state, 0, 0); err != nil {
+		return fmt.Errorf("invalid treasury: %w", err)
+	}
+	if reserves, err = uint64At(state, 0, 1); err != nil {
+		return fmt.Errorf("invalid reserves: %w", err)
+	}
+
+	era, err := client.GetCurrentEra()
+	if err != nil {
+		return fmt.Errorf("failed to query current era: %w", err)
+	}
+	if era >= ledger.EraIdConway {
+		govState, err := at(state, 1, 1, 3)
+		if err != nil {
+			return fmt.Errorf("invalid governance state: %w", err)
+		}
+		if out.Governance, err = governance(govState); err != nil {
+			return fmt.Errorf("invalid governance state: %w", err)
+		}
+	}
+	out.Treasury, out.Reserves = &treasury, &reserves
+	return nil
+}
+
+// governance converts a Conway governance state:
+//
+//	[[roots, [govActionState]], committee, constitution, curPParams, ...]
+func governance(govState any) (*pbcardano.GovernanceState, error) {
+	out := &pbcardano.GovernanceState{}
+
+	proposals, err := at(govState, 0, 1)
+	if err != nil {
+		return nil, err
+	}
+	actions, _ := proposals.([]any)
+	for i := range actions {
+		// The state of a proposal starts with its id, [transaction id, index].
+		txID, err := at(actions[i], 0, 0)
+		if err != nil {
+			return nil, err
+		}
+		hash, ok := txID.([]byte)
+		if !ok {
+			return nil, fmt.Errorf("unexpected proposal transaction id %T", txID)
+		}
+		index, err := uint64At(actions[i], 0, 1)
+		if err != nil {
+			return nil, err
+		}
+		out.Proposals = append(out.Proposals, &pbcardano.GovernanceActionId{
+			TransactionId:         hash,
+			GovernanceActionIndex: uint32(index),
+		})
+	}
+
+	// The committee is a strict maybe, an empty array when there is none.
+	committee, err := at(govState, 1)
+	if err != nil {
+		return nil, err
+	}
+	if items, _ := committee.([]any); len(items) > 0 {
+		threshold, err := at(items[0], 1)
+		if err != nil {
+			return nil, err
+		}
+		rat, ok := threshold.(cbor.Rat)
+		if !ok {
+			return nil, fmt.Errorf("unexpected committee threshold %T", threshold)
+		}
+		if out.CommitteeThreshold, err = margin(&rat); err != nil {
+			return nil, fmt.Errorf("invalid committee threshold: %w", err)
+		}
+	}
+
+	// [[url, content hash], script hash or null]
+	url, err := at(govState, 2, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	contentHash, err := at(govState, 2, 0, 1)
+	if err != nil {
+		return nil, err
+	}
+	script, err := at(govState, 2, 1)
+	if err != nil {
+		return nil, err
+	}
+	constitution := &pbcardano.Constitution{Anchor: &pbcardano.Anchor{}}
+	var ok bool
+	if constitution.Anchor.Url, ok = url.(string); !ok {
+		return nil, fmt.Errorf("unexpected constitution url %T", url)
+	}
+	if constitution.Anchor.ContentHash, ok = contentHash.([]byte); !ok {
+		return nil, fmt.Errorf("unexpected constitution hash %T", contentHash)
+	}
+	if script != nil {
+		if constitution.Hash, ok = script.([]byte); !ok {
+			return nil, fmt.Errorf("unexpected constitution script hash %T", script)
+		}
+	}
+	out.Constitution = constitution
+	return out, nil
+}
+
+// at walks the generic decoding of a CBOR value down the array indexes of
+// path.
+func at(v any, path ...int) (any, error) {
+	for _, i := range path {
+		items, ok := v.([]any)
+		if !ok {
+			return nil, fmt.Errorf("unexpected %T, expected an array", v)
+		}
+		if i >= len(items) {
+			return nil, fmt.Errorf("array of %d elements, expected at least %d", len(items), i+1)
+		}
+		v = items[i]
+	}
+	return v, nil
+}
+
+func uint64At(v any, path ...int) (uint64, error) {
+	v, err := at(v, path...)
+	if err != nil {
+		return 0, err
+	}
+	n, ok := v.(uint64)
+	if !ok {
+		return 0, fmt.Errorf("unexpected %T, expected an unsigned integer", v)
+	}
+	return n, nil
+}
+
+// eraSummaries converts the era history of the node. Era boundaries are
+// given relative to the system start, they are turned into Unix ms
+// timestamps.
+func eraSummaries(systemStart *localstatequery.SystemStartResult, eras []localstatequery.EraHistoryResult) (*pbcardano.EraSummaries, error) {
+	if !systemStart.Year.IsInt64() || !systemStart.Picoseconds.IsInt64() {
+		return nil, fmt.Errorf("invalid system start %s", systemStart)
+	}
+	start := time.Date(int(systemStart.Year.Int64()), time.January, systemStart.Day, 0, 0, 0, 0, time.UTC).
+		Add(time.Duration(systemStart.Picoseconds.Int64() / 1000))
+
+	boundary := func(slot, epoch int, timespan any) (*pbcardano.EraBoundary, error) {
+		picoseconds, err := toBigInt(timespan)
+		if err != nil {
+			return nil, err
+		}
+		ms := new(big.Int).Quo(picoseconds, big.NewInt(1_000_000_000))
+		return &pbcardano.EraBoundary{
+			Time:  uint64(start.UnixMilli() + ms.Int64()),
+			Slot:  uint64(slot),
+			Epoch: uint64(epoch),
+		}, nil
+	}
+
+	out := &pbcardano.EraSummaries{}
+	for i, e := range eras {
+		summary := &pbcardano.EraSummary{Name: fmt.Sprintf("era%d", i)}
+		if i < len(eraNames) {
+			summary.Name = eraNames[i]
+		}
+		var err error
+		if summary.Start, err = boundary(e.Begin.SlotNo, e.Begin.EpochNo, e.Begin.Timespan); err != nil {
+			return nil, fmt.Errorf("invalid start of era %s: %w", summary.Name, err)
+		}
+		if summary.End, err = boundary(e.End.SlotNo, e.End.EpochNo, e.End.Timespan); err != nil {
+			return nil, fmt.Errorf("invalid end of era %s: %w", summary.Name, err)
+		}
+		out.Summaries = append(out.Summaries, summary)
+	}
+	return out, nil
+}
+
+func toBigInt(v any) (*big.Int, error) {
+	switch n := v.(type) {
+	case uint64:
+		return new(big.Int).SetUint64(n), nil
+	case int64:
+		return big.NewInt(n), nil
+	case big.Int:
+		return &n, nil
+	case *big.Int:
+		return n, nil
+	default:
+		return nil, fmt.Errorf("unexpected relative time %v", v)
+	}
+}
+
+func stakeDistribution(distribution *localstatequery.StakeDistributionResult) ([]*pbcardano.PoolStake, error) {
+	out := make([]*pbcardano.PoolStake, 0, len(distribution.Results))
+	for poolID, stake := range distribution.Results {
+		if stake.StakeFraction == nil || stake.StakeFraction.Rat == nil {
+			return nil, fmt.Errorf("pool %x has no stake fraction", poolID[:])
+		}
+		num, denom := stake.StakeFraction.Num(), stake.StakeFraction.Denom()
+		if !num.IsUint64() || !denom.IsUint64() {
+			return nil, fmt.Errorf("stake fraction %s of pool %x does not fit 64 bits", stake.StakeFraction.String(), poolID[:])
+		}
+		out = append(out, &pbcardano.PoolStake{
+			PoolId:           bytes.Clone(poolID[:]),
+			StakeNumerator:   num.Uint64(),
+			StakeDenominator: denom.Uint64(),
+			VrfKeyhash:       bytes.Clone(stake.VrfHash.Bytes()),
+		})
+	}
+	slices.SortFunc(out, func(a, b *pbcardano.PoolStake) int {
+		return bytes.Compare(a.PoolId, b.PoolId)
+	})
+	return out, nil
+}
+
+func stakePoolParams(params *localstatequery.StakePoolParamsResult) ([]*pbcardano.PoolParams, error) {
+	out := make([]*pbcardano.PoolParams, 0, len(params.Results))
+	for poolID, p := range params.Results {
+		account, err := p.RewardAccount.Bytes()
+		if err != nil {
+			return nil, fmt.Errorf("invalid reward account of pool %x: %w", poolID[:], err)
+		}
+		cert := &pbcardano.PoolRegistrationCert{
+			Operator:      bytes.Clone(p.Operator.Bytes()),
+			VrfKeyhash:    bytes.Clone(p.VrfKeyHash.Bytes()),
+			Pledge:        uint64(p.Pledge),
+			Cost:          uint64(p.FixedCost),
+			RewardAccount: account,
+		}
+		if cert.Margin, err = margin(p.Margin); err != nil {
+			return nil, fmt.Errorf("invalid margin of pool %x: %w", poolID[:], err)
+		}
+		for _, owner := range p.PoolOwners {
+			cert.PoolOwners = append(cert.PoolOwners, bytes.Clone(owner.Bytes()))
+		}
+		for _, r := range p.Relays {
+			cert.Relays = append(cert.Relays, relay(r))
+		}
+		if p.PoolMetadata != nil {
+			cert.PoolMetadata = &pbcardano.PoolMetadata{
+				Url:  p.PoolMetadata.Url,
+				Hash: bytes.Clone(p.PoolMetadata.MetadataHash.Bytes()),
+			}
+		}
+		out = append(out, &pbcardano.PoolParams{PoolId: bytes.Clone(poolID[:]), Params: cert})
+	}
+	slices.SortFunc(out, func(a, b *pbcardano.PoolParams) int {
+		return bytes.Compare(a.PoolId, b.PoolId)
+	})
+	return out, nil
+}
+
+func margin(r *cbor.Rat) (*pbcardano.RationalNumber, error) {
+	if r == nil || r.Rat == nil {
+		return nil, nil
+	}
+	num, denom := r.Num(), r.Denom()
+	if !num.IsInt64() || num.Int64() < -1<<31 || num.Int64() >= 1<<31 ||
+		!denom.IsUint64() || denom.Uint64() >= 1<<32 {
+		return nil, fmt.Errorf("%s does not fit a 32-bit rational", r.String())
+	}
+	return &pbcardano.RationalNumber{
+		Numerator:   int32(num.Int64()),
+		Denominator: uint32(denom.Uint64()),
+	}, nil
+}
+
+// relay converts a pool relay, keeping the host name the utxorpc conversion
+// of gouroboros drops.
+func relay(r ledger.PoolRelay) *pbcardano.Relay {
+	out := &pbcardano.Relay{}
+	if r.Port != nil {
+		out.Port = *r.Port
+	}
+	if r.Ipv4 != nil {
+		out.IpV4 = bytes.Clone(r.Ipv4.To4())
+	}
+	if r.Ipv6 != nil {
+		out.IpV6 = bytes.Clone(*r.Ipv6)
+	}
+	if r.Hostname != nil {
+		out.DnsName = *r.Hostname
+	}
+	return out
+}
+
+// fromUtxorpc copies a utxorpc message into its wire compatible
+// sf.cardano.type.v1 counterpart.
+func fromUtxorpc(in, out proto.Message) error {
+	data, err := proto.Marshal(in)
+	if err != nil {
+		return fmt.Errorf("failed to marshal UTXO RPC %s: %w", in.ProtoReflect().Descriptor().Name(), err)
+	}
+	if err := proto.Unmarshal(data, out); err != nil {
+		return fmt.Errorf("failed to unmarshal UTXO RPC %s: %w", in.ProtoReflect().Descriptor().Name(), err)
+	}
+	return nil
+}
@@ -0,0 +1,227 @@
+package ledgerstate
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	ouroboros "github.com/blinklabs-io/gouroboros"
+	"github.com/blinklabs-io/gouroboros/cbor"
+	"github.com/blinklabs-io/gouroboros/ledger"
+	lcommon "github.com/blinklabs-io/gouroboros/ledger/common"
+	"github.com/blinklabs-io/gouroboros/ledger/conway"
+	"github.com/blinklabs-io/gouroboros/protocol/common"
+	"github.com/blinklabs-io/gouroboros/protocol/localstatequery"
+	"github.com/no-witness-labs/firehose-cardano/internal/nodetest"
+)
+
+func rat(num, denom int64) *cbor.Rat {
+	return &cbor.Rat{Rat: big.NewRat(num, denom)}
+}
+
+// mainnetStart is the system start of mainnet, 2017-09-23T21:44:51Z.
+func mainnetStart() localstatequery.SystemStartResult {
+	start := localstatequery.SystemStartResult{Day: 266}
+	start.Year.SetInt64(2017)
+	start.Picoseconds.SetInt64(78291 * 1_000_000_000_000)
+	return start
+}
+
+// mainnetEras are the Byron and Shelley eras of mainnet, era boundaries
+// being picoseconds since the system start.
+func mainnetEras() []localstatequery.EraHistoryResult {
+	shelleyStart, _ := new(big.Int).SetString("89856000000000000000", 10)
+	eras := make([]localstatequery.EraHistoryResult, 2)
+	eras[0].Begin.Timespan = uint64(0)
+	eras[0].End.Timespan, eras[0].End.SlotNo, eras[0].End.EpochNo = shelleyStart, 4492800, 208
+	eras[1].Begin = eras[0].End
+	eras[1].End.Timespan, eras[1].End.SlotNo, eras[1].End.EpochNo = shelleyStart, 4492800, 208
+	return eras
+}
+
+// epochStateResult is a Conway epoch state holding a committee and a
+// proposal, wrapped as a Shelley query result.
+func epochStateResult() []any {
+	proposal := []any{
+		[]any{bytes.Repeat([]byte{0x0b}, 32), uint64(1)}, // Id
+		map[any]any{}, map[any]any{}, map[any]any{}, // Votes
+		[]any{}, uint64(500), uint64(506),
+	}
+	govState := []any{
+		[]any{[]any{}, []any{proposal}},
+		[]any{[]any{map[any]any{}, rat(2, 3)}},
+		[]any{[]any{"https://example.com/constitution.txt", bytes.Repeat([]byte{0x0c}, 32)}, bytes.Repeat([]byte{0x0d}, 28)},
+		[]any{}, []any{}, []any{}, []any{},
+	}
+	utxoState := []any{map[any]any{}, uint64(0), uint64(0), govState, []any{}, uint64(0)}
+	state := []any{
+		[]any{uint64(1_500_000_000), uint64(7_000_000_000)},
+		[]any{[]any{}, utxoState},
+		[]any{}, []any{},
+	}
+	return []any{state}
+}
+
+type poolStake = struct {
+	cbor.StructAsArray
+	StakeFraction *cbor.Rat
+	VrfHash       ledger.Blake2b256
+}
+
+func TestSnapshot(t *testing.T) {
+	params := conway.ConwayProtocolParameters{
+		MaxTxSize:                  16384,
+		A0:                         rat(3, 10),
+		Rho:                        rat(3, 1000),
+		Tau:                        rat(1, 5),
+		ProtocolVersion:            lcommon.ProtocolParametersProtocolVersion{Major: 10},
+		MinFeeRefScriptCostPerByte: rat(15, 1),
+	}
+	params.ExecutionCosts.MemPrice = rat(577, 10000)
+	params.ExecutionCosts.StepPrice = rat(721, 10000000)
+	half := *rat(1, 2)
+	params.PoolVotingThresholds = conway.PoolVotingThresholds{
+		MotionNoConfidence: half, CommitteeNormal: half, CommitteeNoConfidence: half,
+		HardForkInitiation: half, PpSecurityGroup: half,
+	}
+	params.DRepVotingThresholds = conway.DRepVotingThresholds{
+		MotionNoConfidence: half, CommitteeNormal: half, CommitteeNoConfidence: half,
+		UpdateToConstitution: half, HardForkInitiation: half, PpNetworkGroup: half,
+		PpEconomicGroup: half, PpTechnicalGroup: half, PpGovGroup: half, TreasuryWithdrawal: half,
+	}
+
+	var epochState any = epochStateResult()
+	node := &nodetest.Node{Query: func(query any) (any, error) {
+		switch q := query.(type) {
+		case *localstatequery.SystemStartQuery:
+			return mainnetStart(), nil
+		case *localstatequery.BlockQuery:
+			switch q := q.Query.(type) {
+			case *localstatequery.HardForkQuery:
+				switch q.Query.(type) {
+				case *localstatequery.HardForkCurrentEraQuery:
+					return ledger.EraIdConway, nil
+				case *localstatequery.HardForkEraHistoryQuery:
+					return mainnetEras(), nil
+				}
+			case *localstatequery.ShelleyQuery:
+				switch q.Query.(type) {
+				case *localstatequery.ShelleyCurrentProtocolParamsQuery:
+					return []conway.ConwayProtocolParameters{params}, nil
+				case *localstatequery.ShelleyStakeDistributionQuery:
+					return localstatequery.StakeDistributionResult{Results: map[ledger.PoolId]poolStake{
+						{0x02}: {StakeFraction: rat(1, 4)},
+						{0x01}: {StakeFraction: rat(3, 4)},
+					}}, nil
+				case *localstatequery.ShelleyStakePoolsQuery:
+					return localstatequery.StakePoolsResult{}, nil
+				case *localstatequery.ShelleyDebugEpochStateQuery:
+					return epochState, nil
+				}
+			}
+		}
+		return nil, fmt.Errorf("unexpected query %T", query)
+	}}
+	socket := node.ListenUnix(t)
+
+	conn, err := ouroboros.NewConnection(
+		ouroboros.WithNetworkMagic(nodetest.NetworkMagic),
+		ouroboros.WithNodeToNode(false),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := conn.Dial("unix", socket); err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	client := conn.LocalStateQuery().Client
+	point := common.NewPoint(4492800, make([]byte, 32))
+	snapshot, err := Snapshot(client, point, 208)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if snapshot.Epoch != 208 {
+		t.Errorf("epoch %d, expected 208", snapshot.Epoch)
+	}
+	summaries := snapshot.GetEraHistory().GetSummaries()
+	if len(summaries) != 2 || summaries[0].Name != "byron" || summaries[1].Name != "shelley" {
+		t.Fatalf("era summaries %v", summaries)
+	}
+	if start := summaries[0].Start.Time; start != 1506203091000 {
+		t.Errorf("Byron start %d, expected 1506203091000", start)
+	}
+	if start := summaries[1].Start; start.Time != 1596059091000 || start.Slot != 4492800 || start.Epoch != 208 {
+		t.Errorf("Shelley start %v, expected 1596059091000 at slot 4492800, epoch 208", start)
+	}
+
+	if got := snapshot.GetProtocolParams(); got.GetMaxTxSize() != 16384 || got.GetProtocolVersion().GetMajor() != 10 {
+		t.Errorf("protocol parameters %v", got)
+	}
+
+	stakes := snapshot.GetStakeDistribution()
+	if len(stakes) != 2 || stakes[0].PoolId[0] != 0x01 || stakes[1].PoolId[0] != 0x02 {
+		t.Fatalf("stake distribution %v, expected pools 01 and 02 in order", stakes)
+	}
+	if stakes[0].StakeNumerator != 3 || stakes[0].StakeDenominator != 4 {
+		t.Errorf("stake of pool 01 %d/%d, expected 3/4", stakes[0].StakeNumerator, stakes[0].StakeDenominator)
+	}
+	if len(snapshot.GetPools()) != 0 {
+		t.Errorf("pools %v, expected none", snapshot.GetPools())
+	}
+
+	if snapshot.GetTreasury() != 1_500_000_000 || snapshot.GetReserves() != 7_000_000_000 {
+		t.Errorf("treasury %d and reserves %d, expected 1500000000 and 7000000000", snapshot.GetTreasury(), snapshot.GetReserves())
+	}
+	governance := snapshot.GetGovernance()
+	if proposals := governance.GetProposals(); len(proposals) != 1 ||
+		proposals[0].TransactionId[0] != 0x0b || proposals[0].GovernanceActionIndex != 1 {
+		t.Errorf("proposals %v, expected 0b..0b#1", proposals)
+	}
+	if threshold := governance.GetCommitteeThreshold(); threshold.GetNumerator() != 2 || threshold.GetDenominator() != 3 {
+		t.Errorf("committee threshold %v, expected 2/3", threshold)
+	}
+	if constitution := governance.GetConstitution(); constitution.GetAnchor().GetUrl() != "https://example.com/constitution.txt" ||
+		len(constitution.GetAnchor().GetContentHash()) != 32 || len(constitution.GetHash()) != 28 {
+		t.Errorf("constitution %v", constitution)
+	}
+
+	// An epoch state that cannot be decoded leaves the rest of the snapshot.
+	epochState = []any{[]any{"unexpected"}}
+	snapshot, err = Snapshot(client, point, 208)
+	if !errors.Is(err, ErrEpochState) {
+		t.Fatalf("snapshot error %v, expected %v", err, ErrEpochState)
+	}
+	if snapshot.GetProtocolParams() == nil || snapshot.Treasury != nil || snapshot.Governance != nil {
+		t.Errorf("snapshot %v, expected the protocol parameters only", snapshot)
+	}
+}
+
+func TestMargin(t *testing.T) {
+	for _, tc := range []struct {
+		rat   *cbor.Rat
+		valid bool
+	}{
+		{rat: rat(1, 100), valid: true},
+		{rat: rat(1<<31-1, 1<<32-1), valid: true},
+		{rat: rat(1<<31, 1<<32-1)},
+		{rat: rat(1, 1<<32)},
+		{rat: rat(-1<<31-1, 1)},
+	} {
+		out, err := margin(tc.rat)
+		if (err == nil) != tc.valid {
+			t.Errorf("margin(%s) = %v, %v", tc.rat.String(), out, err)
+			continue
+		}
+		if tc.valid && (int64(out.Numerator) != tc.rat.Num().Int64() || int64(out.Denominator) != tc.rat.Denom().Int64()) {
+			t.Errorf("margin(%s) = %d/%d", tc.rat.String(), out.Numerator, out.Denominator)
+		}
+	}
+	if out, err := margin(nil); out != nil || err != nil {
+		t.Errorf("margin(nil) = %v, %v", out, err)
+	}
+}
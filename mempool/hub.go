@@ -0,0 +1,137 @@
+package mempool
+
+import (
+	"sync"
+
+	pbmempool "github.com/no-witness-labs/firehose-cardano/types/pb/sf/cardano/mempool/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// subscriberBuffer is the number of events a subscriber can lag behind
+// before it is disconnected.
+const subscriberBuffer = 4096
+
+// Hub keeps the pending transactions the fetcher reports and fans its events
+// out to subscribers.
+type Hub struct {
+	mu          sync.Mutex
+	pending     map[string]*pbmempool.Event
+	order       []string // Hashes of pending transactions, in the order they were seen
+	subscribers map[chan *pbmempool.Event]struct{}
+}
+
+func NewHub() *Hub {
+	return &Hub{
+		pending:     map[string]*pbmempool.Event{},
+		subscribers: map[chan *pbmempool.Event]struct{}{},
+	}
+}
+
+// Publish applies an event of the fetcher. A RESET drops every pending
+// transaction: the fetcher reports again the ones still pending.
+func (h *Hub) Publish(event *pbmempool.Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	switch event.Type {
+	case pbmempool.Event_TYPE_SEEN:
+		if _, ok := h.pending[string(event.TxHash)]; ok {
+			return
+		}
+		h.pending[string(event.TxHash)] = event
+		h.order = append(h.order, string(event.TxHash))
+		h.broadcast(event)
+	case pbmempool.Event_TYPE_DROPPED:
+		h.drop(event)
+	case pbmempool.Event_TYPE_RESET:
+		for _, hash := range h.order {
+			delete(h.pending, hash)
+			h.broadcast(&pbmempool.Event{
+				Type:      pbmempool.Event_TYPE_DROPPED,
+				TxHash:    []byte(hash),
+				Timestamp: event.Timestamp,
+			})
+		}
+		h.order = nil
+	}
+}
+
+func (h *Hub) drop(event *pbmempool.Event) {
+	if _, ok := h.pending[string(event.TxHash)]; !ok {
+		return
+	}
+	delete(h.pending, string(event.TxHash))
+	for i, hash := range h.order {
+		if hash == string(event.TxHash) {
+			h.order = append(h.order[:i:i], h.order[i+1:]...)
+			break
+		}
+	}
+	h.broadcast(event)
+}
+
+// broadcast sends an event to every subscriber, disconnecting the ones too
+// far behind.
+func (h *Hub) broadcast(event *pbmempool.Event) {
+	for ch := range h.subscribers {
+		select {
+		case ch <- event:
+		default:
+			delete(h.subscribers, ch)
+			close(ch)
+		}
+	}
+}
+
+// Subscribe returns a channel getting a SEEN event for each pending
+// transaction, then the events published. The channel is closed when the
+// subscriber falls behind or cancel is called.
+func (h *Hub) Subscribe() (events <-chan *pbmempool.Event, cancel func()) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	ch := make(chan *pbmempool.Event, max(subscriberBuffer, 2*len(h.order)))
+	for _, hash := range h.order {
+		ch <- h.pending[hash]
+	}
+	h.subscribers[ch] = struct{}{}
+
+	return ch, func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		if _, ok := h.subscribers[ch]; ok {
+			delete(h.subscribers, ch)
+			close(ch)
+		}
+	}
+}
+
+// Server implements the sf.cardano.mempool.v1.Mempool service over a Hub.
+type Server struct {
+	pbmempool.UnimplementedMempoolServer
+	hub *Hub
+}
+
+func NewServer(hub *Hub) *Server {
+	return &Server{hub: hub}
+}
+
+func (s *Server) Stream(_ *pbmempool.StreamRequest, stream pbmempool.Mempool_StreamServer) error {
+	events, cancel := s.hub.Subscribe()
+	defer cancel()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case event, ok := <-events:
+			if !ok {
+				return status.Error(codes.ResourceExhausted, "mempool stream fell behind")
+			}
+			if err := stream.Send(event); err != nil {
+				return err
+			}
+		}
+	}
+}
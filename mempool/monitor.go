@@ -0,0 +1,182 @@
+// Package mempool streams the pending transactions of a node.
+//
+// The fetcher polls the mempool of the node it is connected to over a
+// node-to-client socket through LocalTxMonitor. Each poll is compared to the
+// previous one: new transactions are reported as seen, the ones gone as
+// dropped, whether a block included them or the node evicted them. Events
+// are written next to the blocks, on FIRE MEMPOOL lines.
+//
+// firecardano reads those lines along with the blocks and keeps the pending
+// transactions in a Hub, from which the Mempool gRPC service streams them.
+package mempool
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/blinklabs-io/gouroboros/protocol/localtxmonitor"
+	"github.com/no-witness-labs/firehose-cardano/convert"
+	pbmempool "github.com/no-witness-labs/firehose-cardano/types/pb/sf/cardano/mempool/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// LinePrefix starts the lines carrying mempool events, followed by the
+// base64 encoded sf.cardano.mempool.v1.Event.
+const LinePrefix = "FIRE MEMPOOL "
+
+// FormatLine encodes an event into a FIRE MEMPOOL line.
+func FormatLine(event *pbmempool.Event) (string, error) {
+	data, err := proto.Marshal(event)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal mempool event: %w", err)
+	}
+	return LinePrefix + base64.StdEncoding.EncodeToString(data), nil
+}
+
+// ParseLine decodes the event of a FIRE MEMPOOL line, given without its
+// prefix.
+func ParseLine(payload string) (*pbmempool.Event, error) {
+	data, err := base64.StdEncoding.DecodeString(payload)
+	if err != nil {
+		return nil, fmt.Errorf("invalid mempool line: %w", err)
+	}
+	event := &pbmempool.Event{}
+	if err := proto.Unmarshal(data, event); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal mempool event: %w", err)
+	}
+	return event, nil
+}
+
+// Monitor polls the mempool of a node.
+type Monitor struct {
+	client         *localtxmonitor.Client
+	interval       time.Duration
+	convertOptions []convert.Option
+	logger         *log.Logger
+
+	pending map[string]struct{}
+	skipped map[string]struct{} // Pending transactions not reported, failing to decode or convert
+}
+
+// NewMonitor returns a monitor polling client every interval. Pending
+// transactions are converted with convertOptions; the ones failing to decode
+// or convert are logged to logger and skipped.
+func NewMonitor(client *localtxmonitor.Client, interval time.Duration, logger *log.Logger, convertOptions ...convert.Option) *Monitor {
+	return &Monitor{
+		client:         client,
+		interval:       interval,
+		convertOptions: convertOptions,
+		logger:         logger,
+	}
+}
+
+// Run polls the mempool until ctx is done, passing the events of each poll
+// to emit. The first poll is preceded by a RESET event.
+func (m *Monitor) Run(ctx context.Context, emit func(*pbmempool.Event) error) error {
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	for {
+		events, err := m.poll()
+		if err != nil {
+			return err
+		}
+		for _, event := range events {
+			if err := emit(event); err != nil {
+				return err
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (m *Monitor) poll() ([]*pbmempool.Event, error) {
+	timestamp := uint64(time.Now().UnixMilli())
+	var events []*pbmempool.Event
+	if m.pending == nil {
+		m.pending, m.skipped = map[string]struct{}{}, map[string]struct{}{}
+		events = append(events, &pbmempool.Event{Type: pbmempool.Event_TYPE_RESET, Timestamp: timestamp})
+	}
+
+	if err := m.client.Acquire(); err != nil {
+		return nil, fmt.Errorf("failed to acquire mempool snapshot: %w", err)
+	}
+	seen := map[string]struct{}{}
+	for {
+		data, err := m.client.NextTx()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get mempool transaction: %w", err)
+		}
+		if len(data) == 0 {
+			break
+		}
+
+		// Transactions are told apart by their hash, or their bytes when
+		// they cannot be decoded.
+		key := string(data)
+		tx, err := convert.DecodeTx(data)
+		if err == nil {
+			key = string(tx.Hash().Bytes())
+		}
+		seen[key] = struct{}{}
+		if _, ok := m.pending[key]; ok {
+			continue
+		}
+		if err != nil {
+			m.logger.Printf("Warning: skipping invalid mempool transaction of %d bytes: %v", len(data), err)
+			m.skipped[key] = struct{}{}
+			continue
+		}
+
+		hash := tx.Hash().Bytes()
+		converted, err := convert.Tx(tx, m.convertOptions...)
+		if err != nil {
+			m.logger.Printf("Warning: skipping mempool transaction %x: failed to convert: %v", hash, err)
+			m.skipped[key] = struct{}{}
+			continue
+		}
+		events = append(events, &pbmempool.Event{
+			Type:      pbmempool.Event_TYPE_SEEN,
+			TxHash:    bytes.Clone(hash),
+			Tx:        converted,
+			Timestamp: timestamp,
+		})
+	}
+	if err := m.client.Release(); err != nil {
+		return nil, fmt.Errorf("failed to release mempool snapshot: %w", err)
+	}
+
+	var dropped [][]byte
+	for key := range m.pending {
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		// Skipped transactions were never reported as seen.
+		if _, ok := m.skipped[key]; ok {
+			delete(m.skipped, key)
+			continue
+		}
+		dropped = append(dropped, []byte(key))
+	}
+	slices.SortFunc(dropped, bytes.Compare)
+	for _, hash := range dropped {
+		events = append(events, &pbmempool.Event{
+			Type:      pbmempool.Event_TYPE_DROPPED,
+			TxHash:    hash,
+			Timestamp: timestamp,
+		})
+	}
+
+	m.pending = seen
+	return events, nil
+}
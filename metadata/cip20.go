@@ -0,0 +1,47 @@
+package metadata
+
+import (
+	"fmt"
+
+	pbcardano "github.com/no-witness-labs/firehose-cardano/types/pb/sf/cardano/type/v1"
+)
+
+// LabelCIP20 is the metadata label of CIP-20 transaction messages.
+const LabelCIP20 = 674
+
+// DecodeCIP20 decodes a CIP-20 transaction message, the value of label 674:
+// {"msg": [line, ...]} with lines of at most 64 bytes.
+//
+// Violations are returned as ValidationErrors along with the lines that could
+// be decoded; a nil record means the value is not a CIP-20 message at all.
+func DecodeCIP20(md *pbcardano.Metadatum) (*pbcardano.Cip20Message, error) {
+	var errs ValidationErrors
+	msg := field(md, "msg")
+	if msg == nil {
+		errs.add("674.msg", "missing")
+		return nil, errs
+	}
+
+	out := &pbcardano.Cip20Message{}
+	switch v := msg.GetMetadatum().(type) {
+	case *pbcardano.Metadatum_Array:
+		for i, item := range v.Array.Items {
+			line, ok := text(item)
+			if !ok {
+				errs.add(fmt.Sprintf("674.msg.%d", i), "not a string")
+				continue
+			}
+			if len(line) > maxChunkSize {
+				errs.add(fmt.Sprintf("674.msg.%d", i), "longer than %d bytes", maxChunkSize)
+			}
+			out.Lines = append(out.Lines, line)
+		}
+	case *pbcardano.Metadatum_Text:
+		// A common mistake, kept as a single line.
+		errs.add("674.msg", "not a list of strings")
+		out.Lines = []string{v.Text}
+	default:
+		errs.add("674.msg", "not a list of strings")
+	}
+	return out, errs.err()
+}
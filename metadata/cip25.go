@@ -0,0 +1,201 @@
+package metadata
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	pbcardano "github.com/no-witness-labs/firehose-cardano/types/pb/sf/cardano/type/v1"
+)
+
+// LabelCIP25 is the metadata label of CIP-25 NFT metadata.
+const LabelCIP25 = 721
+
+const policyIDSize = 28
+
+// DecodeCIP25 decodes CIP-25 NFT metadata, the value of label 721. Version 1
+// keys policies and asset names by text (hex policy id, UTF-8 asset name),
+// version 2 by bytes.
+//
+// Violations are returned as ValidationErrors along with the assets that
+// could be decoded; a nil record means the value is not CIP-25 metadata at
+// all.
+func DecodeCIP25(md *pbcardano.Metadatum) (*pbcardano.Cip25Metadata, error) {
+	var errs ValidationErrors
+	root := md.GetMap()
+	if root == nil {
+		errs.add("721", "not a map")
+		return nil, errs
+	}
+
+	out := &pbcardano.Cip25Metadata{Version: 1}
+	if version := field(md, "version"); version != nil {
+		switch v := version.GetMetadatum().(type) {
+		case *pbcardano.Metadatum_Int:
+			out.Version = uint32(v.Int)
+		case *pbcardano.Metadatum_Text:
+			switch v.Text {
+			case "1", "1.0":
+				out.Version = 1
+			case "2", "2.0":
+				out.Version = 2
+			default:
+				out.Version = 0
+			}
+		}
+		if out.Version != 1 && out.Version != 2 {
+			errs.add("721.version", "unsupported version")
+			return out, errs
+		}
+	}
+
+	for _, policy := range root.Pairs {
+		if key, ok := text(policy.Key); ok && key == "version" {
+			continue
+		}
+
+		policyID, policyPath, ok := cip25Key(policy.Key, out.Version, true)
+		if !ok {
+			errs.add("721."+policyPath, "invalid policy id")
+			continue
+		}
+		if policy.Value.GetMap() == nil {
+			errs.add("721."+policyPath, "policy is not a map")
+			continue
+		}
+
+		for _, asset := range policy.Value.GetMap().Pairs {
+			name, namePath, ok := cip25Key(asset.Key, out.Version, false)
+			path := "721." + policyPath + "." + namePath
+			if !ok {
+				errs.add(path, "invalid asset name")
+				continue
+			}
+			if a := decodeCIP25Asset(path, asset.Value, &errs); a != nil {
+				a.PolicyId = policyID
+				a.AssetName = name
+				out.Assets = append(out.Assets, a)
+			}
+		}
+	}
+	return out, errs.err()
+}
+
+// cip25Key decodes a policy id or asset name key, returning it with its
+// rendering in error paths.
+func cip25Key(key *pbcardano.Metadatum, version uint32, policy bool) ([]byte, string, bool) {
+	var b []byte
+	switch v := key.GetMetadatum().(type) {
+	case *pbcardano.Metadatum_Text:
+		if version != 1 {
+			return nil, v.Text, false
+		}
+		if !policy {
+			return []byte(v.Text), v.Text, true
+		}
+		var err error
+		if b, err = hex.DecodeString(v.Text); err != nil {
+			return nil, v.Text, false
+		}
+	case *pbcardano.Metadatum_Bytes:
+		if version != 2 {
+			return nil, hex.EncodeToString(v.Bytes), false
+		}
+		b = v.Bytes
+	default:
+		return nil, "?", false
+	}
+
+	if policy && len(b) != policyIDSize {
+		return nil, hex.EncodeToString(b), false
+	}
+	return b, hex.EncodeToString(b), true
+}
+
+func decodeCIP25Asset(path string, md *pbcardano.Metadatum, errs *ValidationErrors) *pbcardano.Cip25Asset {
+	if md.GetMap() == nil {
+		errs.add(path, "asset is not a map")
+		return nil
+	}
+
+	out := &pbcardano.Cip25Asset{}
+	for _, pair := range md.GetMap().Pairs {
+		key, _ := text(pair.Key)
+		var ok bool
+		switch key {
+		case "name":
+			if out.Name, ok = text(pair.Value); !ok {
+				errs.add(path+".name", "not a string")
+			}
+		case "image":
+			if out.Image, ok = chunkedText(pair.Value); !ok {
+				errs.add(path+".image", "not a string or list of strings")
+			}
+		case "mediaType":
+			if out.MediaType, ok = text(pair.Value); !ok || !strings.HasPrefix(out.MediaType, "image/") {
+				errs.add(path+".mediaType", "not an image media type")
+			}
+		case "description":
+			if out.Description, ok = chunkedText(pair.Value); !ok {
+				errs.add(path+".description", "not a string or list of strings")
+			}
+		case "files":
+			items := pair.Value.GetArray()
+			if items == nil {
+				errs.add(path+".files", "not a list")
+				continue
+			}
+			for i, item := range items.Items {
+				if file := decodeCIP25File(fmt.Sprintf("%s.files.%d", path, i), item, errs); file != nil {
+					out.Files = append(out.Files, file)
+				}
+			}
+		default:
+			out.Properties = append(out.Properties, pair)
+		}
+	}
+
+	if field(md, "name") == nil {
+		errs.add(path+".name", "missing")
+	}
+	if field(md, "image") == nil {
+		errs.add(path+".image", "missing")
+	}
+	return out
+}
+
+func decodeCIP25File(path string, md *pbcardano.Metadatum, errs *ValidationErrors) *pbcardano.Cip25File {
+	if md.GetMap() == nil {
+		errs.add(path, "file is not a map")
+		return nil
+	}
+
+	out := &pbcardano.Cip25File{}
+	for _, pair := range md.GetMap().Pairs {
+		key, _ := text(pair.Key)
+		var ok bool
+		switch key {
+		case "name":
+			if out.Name, ok = text(pair.Value); !ok {
+				errs.add(path+".name", "not a string")
+			}
+		case "mediaType":
+			if out.MediaType, ok = text(pair.Value); !ok {
+				errs.add(path+".mediaType", "not a string")
+			}
+		case "src":
+			if out.Src, ok = chunkedText(pair.Value); !ok {
+				errs.add(path+".src", "not a string or list of strings")
+			}
+		default:
+			out.Properties = append(out.Properties, pair)
+		}
+	}
+
+	for _, key := range []string{"mediaType", "src"} {
+		if field(md, key) == nil {
+			errs.add(path+"."+key, "missing")
+		}
+	}
+	return out
+}
@@ -0,0 +1,98 @@
+package metadata
+
+import (
+	"fmt"
+	"math"
+
+	pbcardano "github.com/no-witness-labs/firehose-cardano/types/pb/sf/cardano/type/v1"
+)
+
+// Metadata labels of CIP-36 (and CIP-15) vote registrations and of their
+// witness.
+const (
+	LabelCIP36Registration = 61284
+	LabelCIP36Witness      = 61285
+)
+
+const (
+	votingKeySize = 32
+	stakeKeySize  = 32
+	signatureSize = 64
+)
+
+// DecodeCIP36 decodes a CIP-36 vote registration, the value of label 61284,
+// along with the signature of its witness, the value of label 61285 (nil when
+// absent). CIP-15 registrations, which carry a single voting key, are
+// decoded as one delegation of weight 1. The signature is not verified.
+//
+// Violations are returned as ValidationErrors along with the fields that
+// could be decoded; a nil record means the value is not a registration at
+// all.
+func DecodeCIP36(registration, witness *pbcardano.Metadatum) (*pbcardano.Cip36Registration, error) {
+	var errs ValidationErrors
+	if registration.GetMap() == nil {
+		errs.add("61284", "not a map")
+		return nil, errs
+	}
+
+	out := &pbcardano.Cip36Registration{}
+	switch v := intField(registration, 1).GetMetadatum().(type) {
+	case nil:
+		errs.add("61284.1", "missing voting key")
+	case *pbcardano.Metadatum_Bytes:
+		out.LegacyVotingKey = true
+		out.Delegations = []*pbcardano.Cip36Delegation{{VotingKey: v.Bytes, Weight: 1}}
+		if len(v.Bytes) != votingKeySize {
+			errs.add("61284.1", "voting key is not %d bytes", votingKeySize)
+		}
+	case *pbcardano.Metadatum_Array:
+		if len(v.Array.Items) == 0 {
+			errs.add("61284.1", "no delegation")
+		}
+		for i, item := range v.Array.Items {
+			path := fmt.Sprintf("61284.1.%d", i)
+			pair := item.GetArray().GetItems()
+			if len(pair) != 2 {
+				errs.add(path, "delegation is not a [voting key, weight] pair")
+				continue
+			}
+			key := pair[0].GetBytes()
+			weight, ok := uint64Value(pair[1])
+			if len(key) != votingKeySize {
+				errs.add(path, "voting key is not %d bytes", votingKeySize)
+			}
+			if !ok || weight > math.MaxUint32 {
+				errs.add(path, "weight is not a 32 bits unsigned integer")
+			}
+			out.Delegations = append(out.Delegations, &pbcardano.Cip36Delegation{VotingKey: key, Weight: uint32(weight)})
+		}
+	default:
+		errs.add("61284.1", "neither a voting key nor a list of delegations")
+	}
+
+	if out.StakeKey = intField(registration, 2).GetBytes(); len(out.StakeKey) != stakeKeySize {
+		errs.add("61284.2", "stake key is not %d bytes", stakeKeySize)
+	}
+	if out.PaymentAddress = intField(registration, 3).GetBytes(); len(out.PaymentAddress) == 0 {
+		errs.add("61284.3", "missing payment address")
+	}
+
+	var ok bool
+	if nonce := intField(registration, 4); nonce == nil {
+		errs.add("61284.4", "missing nonce")
+	} else if out.Nonce, ok = uint64Value(nonce); !ok {
+		errs.add("61284.4", "nonce is not an unsigned integer")
+	}
+	if purpose := intField(registration, 5); purpose != nil {
+		if out.VotingPurpose, ok = uint64Value(purpose); !ok {
+			errs.add("61284.5", "voting purpose is not an unsigned integer")
+		}
+	}
+
+	if witness == nil {
+		errs.add("61285", "missing witness")
+	} else if out.Signature = intField(witness, 1).GetBytes(); len(out.Signature) != signatureSize {
+		errs.add("61285.1", "signature is not %d bytes", signatureSize)
+	}
+	return out, errs.err()
+}
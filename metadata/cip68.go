@@ -0,0 +1,229 @@
+package metadata
+
+import (
+	"encoding/binary"
+	"fmt"
+	"unicode/utf8"
+
+	pbcardano "github.com/no-witness-labs/firehose-cardano/types/pb/sf/cardano/type/v1"
+)
+
+// CIP-67 labels of the CIP-68 token classes: the reference NFT holding the
+// metadata and the user tokens it describes.
+const (
+	LabelCIP68Reference = 100
+	LabelCIP68NFT       = 222
+	LabelCIP68FT        = 333
+	LabelCIP68RFT       = 444
+)
+
+const labelPrefixSize = 4
+
+// AssetLabel returns the CIP-67 label prefixing an asset name, when it is one
+// of the CIP-68 labels.
+func AssetLabel(assetName []byte) (uint32, bool) {
+	if len(assetName) < labelPrefixSize {
+		return 0, false
+	}
+	// The prefix is 0000 | 16 bits label | 8 bits CRC-8 of the label | 0000.
+	prefix := binary.BigEndian.Uint32(assetName)
+	if prefix>>28 != 0 || prefix&0xf != 0 {
+		return 0, false
+	}
+	label := uint16(prefix >> 12)
+	if byte(prefix>>4) != crc8(label) {
+		return 0, false
+	}
+	switch label {
+	case LabelCIP68Reference, LabelCIP68NFT, LabelCIP68FT, LabelCIP68RFT:
+		return uint32(label), true
+	}
+	return 0, false
+}
+
+// LabelAssetName returns an asset name with its CIP-67 label replaced, e.g.
+// the name of the reference NFT of a user token.
+func LabelAssetName(assetName []byte, label uint32) []byte {
+	prefix := uint32(label)<<12 | uint32(crc8(uint16(label)))<<4
+	out := binary.BigEndian.AppendUint32(nil, prefix)
+	if len(assetName) >= labelPrefixSize {
+		out = append(out, assetName[labelPrefixSize:]...)
+	}
+	return out
+}
+
+// crc8 computes the CIP-67 checksum of a label: CRC-8 with polynomial 0x07
+// over its big endian bytes.
+func crc8(label uint16) byte {
+	var crc byte
+	for _, b := range []byte{byte(label >> 8), byte(label)} {
+		crc ^= b
+		for range 8 {
+			if crc&0x80 != 0 {
+				crc = crc<<1 ^ 0x07
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
+
+// DecodeCIP68 decodes the reference datum of CIP-68 tokens,
+// Constr 0 [metadata, version, extra]. The metadata is checked against the
+// fields required for tokens of the given label (222, 333 or 444), 0 to only
+// check its structure.
+//
+// Violations are returned as ValidationErrors along with the fields that
+// could be decoded; a nil record means the datum is not a CIP-68 one at all.
+func DecodeCIP68(datum *pbcardano.PlutusData, label uint32) (*pbcardano.Cip68Metadata, error) {
+	var errs ValidationErrors
+	constr := datum.GetConstr()
+	if constr == nil || pbcardano.ConstrIndex(constr) != 0 || len(constr.Fields) < 2 {
+		errs.add("datum", "not a Constr 0 [metadata, version, extra]")
+		return nil, errs
+	}
+	metadata := constr.Fields[0].GetMap()
+	if metadata == nil {
+		errs.add("metadata", "not a map")
+		return nil, errs
+	}
+
+	out := &pbcardano.Cip68Metadata{}
+	if version, ok := plutusUint64(constr.Fields[1]); !ok || version == 0 {
+		errs.add("version", "not a positive integer")
+	} else {
+		out.Version = version
+	}
+	if len(constr.Fields) > 2 {
+		out.Extra = constr.Fields[2]
+	} else {
+		errs.add("extra", "missing")
+	}
+
+	for _, pair := range metadata.Pairs {
+		key, _ := plutusText(pair.Key)
+		path := "metadata." + key
+		var ok bool
+		switch key {
+		case "name":
+			out.Name, ok = plutusText(pair.Value)
+		case "image":
+			out.Image, ok = plutusText(pair.Value)
+		case "mediaType":
+			out.MediaType, ok = plutusText(pair.Value)
+		case "description":
+			out.Description, ok = plutusText(pair.Value)
+		case "ticker":
+			out.Ticker, ok = plutusText(pair.Value)
+		case "url":
+			out.Url, ok = plutusText(pair.Value)
+		case "logo":
+			out.Logo, ok = plutusText(pair.Value)
+		case "decimals":
+			if out.Decimals, ok = plutusUint64(pair.Value); !ok {
+				errs.add(path, "not an unsigned integer")
+			}
+			continue
+		case "files":
+			items := pair.Value.GetArray()
+			if items == nil {
+				errs.add(path, "not a list")
+				continue
+			}
+			for i, item := range items.Items {
+				if file := decodeCIP68File(fmt.Sprintf("%s.%d", path, i), item, &errs); file != nil {
+					out.Files = append(out.Files, file)
+				}
+			}
+			continue
+		default:
+			out.Properties = append(out.Properties, pair)
+			continue
+		}
+		if !ok {
+			errs.add(path, "not UTF-8 bytes")
+		}
+	}
+
+	var required []string
+	switch label {
+	case LabelCIP68NFT:
+		required = []string{"name", "image"}
+	case LabelCIP68FT:
+		required = []string{"name", "description"}
+	case LabelCIP68RFT:
+		required = []string{"name", "image", "decimals"}
+	}
+	for _, key := range required {
+		if plutusField(metadata, key) == nil {
+			errs.add("metadata."+key, "missing")
+		}
+	}
+	return out, errs.err()
+}
+
+func decodeCIP68File(path string, pd *pbcardano.PlutusData, errs *ValidationErrors) *pbcardano.Cip68File {
+	fields := pd.GetMap()
+	if fields == nil {
+		errs.add(path, "file is not a map")
+		return nil
+	}
+
+	out := &pbcardano.Cip68File{}
+	for _, pair := range fields.Pairs {
+		key, _ := plutusText(pair.Key)
+		var ok bool
+		switch key {
+		case "name":
+			out.Name, ok = plutusText(pair.Value)
+		case "mediaType":
+			out.MediaType, ok = plutusText(pair.Value)
+		case "src":
+			out.Src, ok = plutusText(pair.Value)
+		default:
+			out.Properties = append(out.Properties, pair)
+			continue
+		}
+		if !ok {
+			errs.add(path+"."+key, "not UTF-8 bytes")
+		}
+	}
+
+	for _, key := range []string{"mediaType", "src"} {
+		if plutusField(fields, key) == nil {
+			errs.add(path+"."+key, "missing")
+		}
+	}
+	return out
+}
+
+// plutusField returns the value of the UTF-8 bytes key of a Plutus data map,
+// nil when absent.
+func plutusField(m *pbcardano.PlutusDataMap, key string) *pbcardano.PlutusData {
+	for _, pair := range m.GetPairs() {
+		if k, ok := plutusText(pair.Key); ok && k == key {
+			return pair.Value
+		}
+	}
+	return nil
+}
+
+func plutusText(pd *pbcardano.PlutusData) (string, bool) {
+	v, ok := pd.GetPlutusData().(*pbcardano.PlutusData_BoundedBytes)
+	if !ok || !utf8.Valid(v.BoundedBytes) {
+		return "", false
+	}
+	return string(v.BoundedBytes), true
+}
+
+func plutusUint64(pd *pbcardano.PlutusData) (uint64, bool) {
+	if pd.GetBigInt() == nil {
+		return 0, false
+	}
+	n := pd.GetBigInt().Value()
+	if !n.IsUint64() {
+		return 0, false
+	}
+	return n.Uint64(), true
+}
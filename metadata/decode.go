@@ -0,0 +1,227 @@
+// Package metadata decodes transaction metadata: the generic metadatum tree
+// of auxiliary data and the records of the CIPs built on it (CIP-20, CIP-25
+// and CIP-36), as well as the datum-based token metadata of CIP-68.
+package metadata
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"unicode/utf8"
+
+	pbcardano "github.com/no-witness-labs/firehose-cardano/types/pb/sf/cardano/type/v1"
+)
+
+// maxDepth bounds the nesting of decoded metadata.
+const maxDepth = 256
+
+var errUnexpectedEnd = errors.New("unexpected end of data")
+
+// Decode decodes the CBOR metadata map of auxiliary data, label by label.
+// Labels and map entries keep their on-chain order.
+func Decode(data []byte) ([]*pbcardano.Metadata, error) {
+	d := &decoder{data: data}
+	major, count, err := d.header()
+	if err != nil {
+		return nil, err
+	}
+	if major != majorMap {
+		return nil, fmt.Errorf("metadata is not a map")
+	}
+
+	var out []*pbcardano.Metadata
+	for i := uint64(0); count == indefinite || i < count; i++ {
+		if count == indefinite && d.atBreak() {
+			break
+		}
+		major, label, err := d.header()
+		if err != nil {
+			return nil, err
+		}
+		if major != majorUnsigned || label == indefinite {
+			return nil, fmt.Errorf("metadata label is not an unsigned integer")
+		}
+		value, err := d.decode(0)
+		if err != nil {
+			return nil, fmt.Errorf("label %d: %w", label, err)
+		}
+		out = append(out, &pbcardano.Metadata{Label: label, Value: value})
+	}
+
+	if d.pos != len(d.data) {
+		return nil, fmt.Errorf("%d trailing bytes after metadata", len(d.data)-d.pos)
+	}
+	return out, nil
+}
+
+type decoder struct {
+	data []byte
+	pos  int
+}
+
+const (
+	majorUnsigned = 0
+	majorNegative = 1
+	majorBytes    = 2
+	majorText     = 3
+	majorArray    = 4
+	majorMap      = 5
+
+	indefinite = math.MaxUint64
+	breakCode  = 0xff
+)
+
+// header reads the major type and argument of the next item. Indefinite
+// lengths are returned as the indefinite argument.
+func (d *decoder) header() (byte, uint64, error) {
+	if d.pos >= len(d.data) {
+		return 0, 0, errUnexpectedEnd
+	}
+	initial := d.data[d.pos]
+	d.pos++
+
+	major, info := initial>>5, initial&0x1f
+	switch {
+	case info < 24:
+		return major, uint64(info), nil
+	case info == 31:
+		return major, indefinite, nil
+	case info > 27:
+		return 0, 0, fmt.Errorf("invalid additional information %d", info)
+	}
+
+	size := 1 << (info - 24)
+	if d.pos+size > len(d.data) {
+		return 0, 0, errUnexpectedEnd
+	}
+	raw := d.data[d.pos : d.pos+size]
+	d.pos += size
+
+	switch size {
+	case 1:
+		return major, uint64(raw[0]), nil
+	case 2:
+		return major, uint64(binary.BigEndian.Uint16(raw)), nil
+	case 4:
+		return major, uint64(binary.BigEndian.Uint32(raw)), nil
+	default:
+		return major, binary.BigEndian.Uint64(raw), nil
+	}
+}
+
+func (d *decoder) atBreak() bool {
+	if d.pos < len(d.data) && d.data[d.pos] == breakCode {
+		d.pos++
+		return true
+	}
+	return false
+}
+
+func (d *decoder) decode(depth int) (*pbcardano.Metadatum, error) {
+	if depth > maxDepth {
+		return nil, fmt.Errorf("metadata nested deeper than %d", maxDepth)
+	}
+
+	start := d.pos
+	major, arg, err := d.header()
+	if err != nil {
+		return nil, err
+	}
+
+	switch major {
+	case majorUnsigned, majorNegative:
+		if arg <= math.MaxInt64 {
+			n := int64(arg)
+			if major == majorNegative {
+				n = -1 - n
+			}
+			return &pbcardano.Metadatum{Metadatum: &pbcardano.Metadatum_Int{Int: n}}, nil
+		}
+		value := new(big.Int).SetUint64(arg)
+		if major == majorNegative {
+			value.Neg(value).Sub(value, big.NewInt(1))
+		}
+		return &pbcardano.Metadatum{Metadatum: &pbcardano.Metadatum_BigInt{BigInt: pbcardano.NewBigInt(value)}}, nil
+
+	case majorBytes, majorText:
+		d.pos = start
+		b, err := d.bytes(major)
+		if err != nil {
+			return nil, err
+		}
+		if major == majorBytes {
+			return &pbcardano.Metadatum{Metadatum: &pbcardano.Metadatum_Bytes{Bytes: b}}, nil
+		}
+		if !utf8.Valid(b) {
+			return nil, fmt.Errorf("invalid UTF-8 text")
+		}
+		return &pbcardano.Metadatum{Metadatum: &pbcardano.Metadatum_Text{Text: string(b)}}, nil
+
+	case majorArray:
+		array := &pbcardano.MetadatumArray{}
+		for i := uint64(0); arg == indefinite || i < arg; i++ {
+			if arg == indefinite && d.atBreak() {
+				break
+			}
+			item, err := d.decode(depth + 1)
+			if err != nil {
+				return nil, err
+			}
+			array.Items = append(array.Items, item)
+		}
+		return &pbcardano.Metadatum{Metadatum: &pbcardano.Metadatum_Array{Array: array}}, nil
+
+	case majorMap:
+		mp := &pbcardano.MetadatumMap{}
+		for i := uint64(0); arg == indefinite || i < arg; i++ {
+			if arg == indefinite && d.atBreak() {
+				break
+			}
+			key, err := d.decode(depth + 1)
+			if err != nil {
+				return nil, err
+			}
+			value, err := d.decode(depth + 1)
+			if err != nil {
+				return nil, err
+			}
+			mp.Pairs = append(mp.Pairs, &pbcardano.MetadatumPair{Key: key, Value: value})
+		}
+		return &pbcardano.Metadatum{Metadatum: &pbcardano.Metadatum_Map{Map: mp}}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported CBOR major type %d in metadata", major)
+}
+
+// bytes reads a byte or text string, concatenating the chunks of an
+// indefinite one.
+func (d *decoder) bytes(expected byte) ([]byte, error) {
+	major, arg, err := d.header()
+	if err != nil {
+		return nil, err
+	}
+	if major != expected {
+		return nil, fmt.Errorf("expected major type %d, got %d", expected, major)
+	}
+
+	if arg != indefinite {
+		if arg > uint64(len(d.data)-d.pos) {
+			return nil, errUnexpectedEnd
+		}
+		b := append([]byte{}, d.data[d.pos:d.pos+int(arg)]...)
+		d.pos += int(arg)
+		return b, nil
+	}
+
+	b := []byte{}
+	for !d.atBreak() {
+		chunk, err := d.bytes(expected)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, chunk...)
+	}
+	return b, nil
+}
@@ -0,0 +1,41 @@
+package metadata
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ValidationError is a violation of a CIP by transaction metadata or a
+// reference datum.
+type ValidationError struct {
+	Path string // Location of the violation, e.g. 721.<policy>.<asset>.image.
+	Msg  string
+}
+
+func (e *ValidationError) Error() string {
+	return e.Path + ": " + e.Msg
+}
+
+// ValidationErrors lists the violations found while decoding a record. The
+// decoders return them along with the part of the record they could decode.
+type ValidationErrors []*ValidationError
+
+func (e ValidationErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+func (e *ValidationErrors) add(path, format string, args ...any) {
+	*e = append(*e, &ValidationError{Path: path, Msg: fmt.Sprintf(format, args...)})
+}
+
+// err returns the violations as an error, nil when there are none.
+func (e ValidationErrors) err() error {
+	if len(e) == 0 {
+		return nil
+	}
+	return e
+}
@@ -0,0 +1,68 @@
+package metadata
+
+import (
+	"strings"
+
+	pbcardano "github.com/no-witness-labs/firehose-cardano/types/pb/sf/cardano/type/v1"
+)
+
+// maxChunkSize is the longest string or byte string metadata can hold, longer
+// values are split in lists of chunks.
+const maxChunkSize = 64
+
+// field returns the value of the text key of a metadatum map, nil when absent.
+func field(md *pbcardano.Metadatum, key string) *pbcardano.Metadatum {
+	for _, pair := range md.GetMap().GetPairs() {
+		if text, ok := pair.Key.GetMetadatum().(*pbcardano.Metadatum_Text); ok && text.Text == key {
+			return pair.Value
+		}
+	}
+	return nil
+}
+
+// intField returns the value of the integer key of a metadatum map, nil when
+// absent.
+func intField(md *pbcardano.Metadatum, key int64) *pbcardano.Metadatum {
+	for _, pair := range md.GetMap().GetPairs() {
+		if n, ok := pair.Key.GetMetadatum().(*pbcardano.Metadatum_Int); ok && n.Int == key {
+			return pair.Value
+		}
+	}
+	return nil
+}
+
+// chunkedText returns a string, or the concatenation of a list of strings,
+// as used for values that may exceed the 64 bytes limit.
+func chunkedText(md *pbcardano.Metadatum) (string, bool) {
+	switch v := md.GetMetadatum().(type) {
+	case *pbcardano.Metadatum_Text:
+		return v.Text, true
+	case *pbcardano.Metadatum_Array:
+		var b strings.Builder
+		for _, item := range v.Array.Items {
+			text, ok := item.GetMetadatum().(*pbcardano.Metadatum_Text)
+			if !ok {
+				return "", false
+			}
+			b.WriteString(text.Text)
+		}
+		return b.String(), true
+	}
+	return "", false
+}
+
+func text(md *pbcardano.Metadatum) (string, bool) {
+	v, ok := md.GetMetadatum().(*pbcardano.Metadatum_Text)
+	if !ok {
+		return "", false
+	}
+	return v.Text, true
+}
+
+func uint64Value(md *pbcardano.Metadatum) (uint64, bool) {
+	v, ok := md.GetMetadatum().(*pbcardano.Metadatum_Int)
+	if !ok || v.Int < 0 {
+		return 0, false
+	}
+	return uint64(v.Int), true
+}
@@ -0,0 +1,56 @@
+package metadata
+
+import (
+	"errors"
+
+	pbcardano "github.com/no-witness-labs/firehose-cardano/types/pb/sf/cardano/type/v1"
+)
+
+// DecodeRecords decodes the metadata labels of a transaction for which a CIP
+// decoder exists. Records come in label order, each with the violations of
+// its CIP; values that are not records at all are skipped.
+func DecodeRecords(metadata []*pbcardano.Metadata) []*pbcardano.DecodedMetadata {
+	var witness *pbcardano.Metadatum
+	for _, md := range metadata {
+		if md.Label == LabelCIP36Witness {
+			witness = md.Value
+		}
+	}
+
+	var out []*pbcardano.DecodedMetadata
+	for _, md := range metadata {
+		record := &pbcardano.DecodedMetadata{Label: md.Label}
+		var err error
+		switch md.Label {
+		case LabelCIP25:
+			var cip25 *pbcardano.Cip25Metadata
+			if cip25, err = DecodeCIP25(md.Value); cip25 != nil {
+				record.Record = &pbcardano.DecodedMetadata_Cip25{Cip25: cip25}
+			}
+		case LabelCIP20:
+			var cip20 *pbcardano.Cip20Message
+			if cip20, err = DecodeCIP20(md.Value); cip20 != nil {
+				record.Record = &pbcardano.DecodedMetadata_Cip20{Cip20: cip20}
+			}
+		case LabelCIP36Registration:
+			var cip36 *pbcardano.Cip36Registration
+			if cip36, err = DecodeCIP36(md.Value, witness); cip36 != nil {
+				record.Record = &pbcardano.DecodedMetadata_Cip36{Cip36: cip36}
+			}
+		default:
+			continue
+		}
+		if record.Record == nil {
+			continue
+		}
+
+		var errs ValidationErrors
+		if errors.As(err, &errs) {
+			for _, e := range errs {
+				record.ValidationErrors = append(record.ValidationErrors, e.Error())
+			}
+		}
+		out = append(out, record)
+	}
+	return out
+}
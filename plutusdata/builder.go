@@ -0,0 +1,45 @@
+package plutusdata
+
+import (
+	"math/big"
+
+	pbcardano "github.com/no-witness-labs/firehose-cardano/types/pb/sf/cardano/type/v1"
+)
+
+// Constr returns a constructor with its canonical tag.
+func Constr(index uint64, fields ...*pbcardano.PlutusData) *pbcardano.PlutusData {
+	c := &pbcardano.Constr{Tag: pbcardano.CanonicalConstrTag(index), Fields: fields}
+	if c.Tag == 102 {
+		c.AnyConstructor = index
+	}
+	return constrData(c)
+}
+
+// Map returns a map of the given key and value pairs.
+func Map(pairs ...[2]*pbcardano.PlutusData) *pbcardano.PlutusData {
+	mp := &pbcardano.PlutusDataMap{}
+	for _, pair := range pairs {
+		mp.Pairs = append(mp.Pairs, &pbcardano.PlutusDataPair{Key: pair[0], Value: pair[1]})
+	}
+	return &pbcardano.PlutusData{PlutusData: &pbcardano.PlutusData_Map{Map: mp}}
+}
+
+// List returns a list of the given items.
+func List(items ...*pbcardano.PlutusData) *pbcardano.PlutusData {
+	return &pbcardano.PlutusData{PlutusData: &pbcardano.PlutusData_Array{Array: &pbcardano.PlutusDataArray{Items: items}}}
+}
+
+// Int returns an integer.
+func Int(value int64) *pbcardano.PlutusData {
+	return bigIntData(&pbcardano.BigInt{BigInt: &pbcardano.BigInt_Int{Int: value}})
+}
+
+// BigInt returns an integer of any size.
+func BigInt(value *big.Int) *pbcardano.PlutusData {
+	return bigIntData(pbcardano.NewBigInt(value))
+}
+
+// Bytes returns a byte string.
+func Bytes(b []byte) *pbcardano.PlutusData {
+	return &pbcardano.PlutusData{PlutusData: &pbcardano.PlutusData_BoundedBytes{BoundedBytes: b}}
+}
@@ -0,0 +1,278 @@
+// Package plutusdata converts Plutus data between its CBOR encoding, the
+// cardano-cli detailed JSON schema and the sf.cardano.type.v1.PlutusData
+// message, and computes datum hashes.
+package plutusdata
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+
+	pbcardano "github.com/no-witness-labs/firehose-cardano/types/pb/sf/cardano/type/v1"
+)
+
+// maxDepth bounds the nesting of decoded data.
+const maxDepth = 256
+
+var errUnexpectedEnd = errors.New("unexpected end of data")
+
+// Decode decodes CBOR-encoded Plutus data. Map entries keep their on-chain
+// order.
+func Decode(data []byte) (*pbcardano.PlutusData, error) {
+	d := &decoder{data: data}
+	pd, err := d.decode(0)
+	if err != nil {
+		return nil, err
+	}
+	if d.pos != len(d.data) {
+		return nil, fmt.Errorf("%d trailing bytes after plutus data", len(d.data)-d.pos)
+	}
+	return pd, nil
+}
+
+type decoder struct {
+	data []byte
+	pos  int
+}
+
+const (
+	majorUnsigned = 0
+	majorNegative = 1
+	majorBytes    = 2
+	majorArray    = 4
+	majorMap      = 5
+	majorTag      = 6
+
+	indefinite = math.MaxUint64
+	breakCode  = 0xff
+)
+
+// header reads the major type and argument of the next item. Indefinite
+// lengths are returned as the indefinite argument.
+func (d *decoder) header() (byte, uint64, error) {
+	if d.pos >= len(d.data) {
+		return 0, 0, errUnexpectedEnd
+	}
+	initial := d.data[d.pos]
+	d.pos++
+
+	major, info := initial>>5, initial&0x1f
+	switch {
+	case info < 24:
+		return major, uint64(info), nil
+	case info == 31:
+		return major, indefinite, nil
+	case info > 27:
+		return 0, 0, fmt.Errorf("invalid additional information %d", info)
+	}
+
+	size := 1 << (info - 24)
+	if d.pos+size > len(d.data) {
+		return 0, 0, errUnexpectedEnd
+	}
+	raw := d.data[d.pos : d.pos+size]
+	d.pos += size
+
+	switch size {
+	case 1:
+		return major, uint64(raw[0]), nil
+	case 2:
+		return major, uint64(binary.BigEndian.Uint16(raw)), nil
+	case 4:
+		return major, uint64(binary.BigEndian.Uint32(raw)), nil
+	default:
+		return major, binary.BigEndian.Uint64(raw), nil
+	}
+}
+
+func (d *decoder) atBreak() bool {
+	if d.pos < len(d.data) && d.data[d.pos] == breakCode {
+		d.pos++
+		return true
+	}
+	return false
+}
+
+func (d *decoder) decode(depth int) (*pbcardano.PlutusData, error) {
+	if depth > maxDepth {
+		return nil, fmt.Errorf("plutus data nested deeper than %d", maxDepth)
+	}
+
+	start := d.pos
+	major, arg, err := d.header()
+	if err != nil {
+		return nil, err
+	}
+
+	switch major {
+	case majorUnsigned, majorNegative:
+		return bigIntData(integer(major, arg)), nil
+
+	case majorBytes:
+		d.pos = start
+		b, err := d.bytes()
+		if err != nil {
+			return nil, err
+		}
+		return &pbcardano.PlutusData{PlutusData: &pbcardano.PlutusData_BoundedBytes{BoundedBytes: b}}, nil
+
+	case majorArray:
+		items, err := d.items(arg, depth)
+		if err != nil {
+			return nil, err
+		}
+		return &pbcardano.PlutusData{PlutusData: &pbcardano.PlutusData_Array{Array: &pbcardano.PlutusDataArray{Items: items}}}, nil
+
+	case majorMap:
+		mp := &pbcardano.PlutusDataMap{}
+		for i := uint64(0); arg == indefinite || i < arg; i++ {
+			if arg == indefinite && d.atBreak() {
+				break
+			}
+			key, err := d.decode(depth + 1)
+			if err != nil {
+				return nil, err
+			}
+			value, err := d.decode(depth + 1)
+			if err != nil {
+				return nil, err
+			}
+			mp.Pairs = append(mp.Pairs, &pbcardano.PlutusDataPair{Key: key, Value: value})
+		}
+		return &pbcardano.PlutusData{PlutusData: &pbcardano.PlutusData_Map{Map: mp}}, nil
+
+	case majorTag:
+		return d.tagged(arg, depth)
+	}
+
+	return nil, fmt.Errorf("unsupported CBOR major type %d in plutus data", major)
+}
+
+func (d *decoder) tagged(tag uint64, depth int) (*pbcardano.PlutusData, error) {
+	switch {
+	case tag == 2 || tag == 3:
+		b, err := d.bytes()
+		if err != nil {
+			return nil, fmt.Errorf("invalid bignum: %w", err)
+		}
+		bi := &pbcardano.BigInt{BigInt: &pbcardano.BigInt_BigUInt{BigUInt: b}}
+		if tag == 3 {
+			bi.BigInt = &pbcardano.BigInt_BigNInt{BigNInt: b}
+		}
+		return &pbcardano.PlutusData{PlutusData: &pbcardano.PlutusData_BigInt{BigInt: bi}}, nil
+
+	case (tag >= 121 && tag <= 127) || (tag >= 1280 && tag <= 1400):
+		major, arg, err := d.header()
+		if err != nil {
+			return nil, err
+		}
+		if major != majorArray {
+			return nil, fmt.Errorf("constructor %d fields are not an array", tag)
+		}
+		fields, err := d.items(arg, depth)
+		if err != nil {
+			return nil, err
+		}
+		return constrData(&pbcardano.Constr{Tag: uint32(tag), Fields: fields}), nil
+
+	case tag == 102:
+		// General form: [constructor, [fields]].
+		major, arg, err := d.header()
+		if err != nil {
+			return nil, err
+		}
+		if major != majorArray || arg != 2 {
+			return nil, fmt.Errorf("constructor with tag 102 is not a 2 items array")
+		}
+		major, index, err := d.header()
+		if err != nil {
+			return nil, err
+		}
+		if major != majorUnsigned {
+			return nil, fmt.Errorf("constructor index is not an unsigned integer")
+		}
+		major, arg, err = d.header()
+		if err != nil {
+			return nil, err
+		}
+		if major != majorArray {
+			return nil, fmt.Errorf("constructor %d fields are not an array", index)
+		}
+		fields, err := d.items(arg, depth)
+		if err != nil {
+			return nil, err
+		}
+		return constrData(&pbcardano.Constr{Tag: 102, AnyConstructor: index, Fields: fields}), nil
+	}
+
+	return nil, fmt.Errorf("unsupported CBOR tag %d in plutus data", tag)
+}
+
+func (d *decoder) items(count uint64, depth int) ([]*pbcardano.PlutusData, error) {
+	var items []*pbcardano.PlutusData
+	for i := uint64(0); count == indefinite || i < count; i++ {
+		if count == indefinite && d.atBreak() {
+			break
+		}
+		item, err := d.decode(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// bytes reads a byte string, concatenating the chunks of an indefinite one.
+func (d *decoder) bytes() ([]byte, error) {
+	major, arg, err := d.header()
+	if err != nil {
+		return nil, err
+	}
+	if major != majorBytes {
+		return nil, fmt.Errorf("expected byte string, got major type %d", major)
+	}
+
+	if arg != indefinite {
+		if arg > uint64(len(d.data)-d.pos) {
+			return nil, errUnexpectedEnd
+		}
+		b := append([]byte{}, d.data[d.pos:d.pos+int(arg)]...)
+		d.pos += int(arg)
+		return b, nil
+	}
+
+	b := []byte{}
+	for !d.atBreak() {
+		chunk, err := d.bytes()
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, chunk...)
+	}
+	return b, nil
+}
+
+// integer returns the BigInt of a CBOR integer, using bignum bytes for the
+// values an int64 cannot hold.
+func integer(major byte, arg uint64) *pbcardano.BigInt {
+	switch {
+	case major == majorUnsigned && arg <= math.MaxInt64:
+		return &pbcardano.BigInt{BigInt: &pbcardano.BigInt_Int{Int: int64(arg)}}
+	case major == majorNegative && arg <= math.MaxInt64:
+		return &pbcardano.BigInt{BigInt: &pbcardano.BigInt_Int{Int: -1 - int64(arg)}}
+	case major == majorUnsigned:
+		return &pbcardano.BigInt{BigInt: &pbcardano.BigInt_BigUInt{BigUInt: binary.BigEndian.AppendUint64(nil, arg)}}
+	default:
+		return &pbcardano.BigInt{BigInt: &pbcardano.BigInt_BigNInt{BigNInt: binary.BigEndian.AppendUint64(nil, arg)}}
+	}
+}
+
+func bigIntData(bi *pbcardano.BigInt) *pbcardano.PlutusData {
+	return &pbcardano.PlutusData{PlutusData: &pbcardano.PlutusData_BigInt{BigInt: bi}}
+}
+
+func constrData(c *pbcardano.Constr) *pbcardano.PlutusData {
+	return &pbcardano.PlutusData{PlutusData: &pbcardano.PlutusData_Constr{Constr: c}}
+}
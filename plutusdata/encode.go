@@ -0,0 +1,135 @@
+package plutusdata
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/big"
+
+	pbcardano "github.com/no-witness-labs/firehose-cardano/types/pb/sf/cardano/type/v1"
+)
+
+// maxChunkSize is the longest byte string Plutus encodes in one piece, longer
+// ones are split in chunks of this size.
+const maxChunkSize = 64
+
+// Encode returns the canonical CBOR encoding of Plutus data, the one of the
+// Plutus ledger and cardano-cli: constructors use tags 121-127, 1280-1400 or
+// the general form 102 according to their index, non-empty lists are
+// indefinite, maps definite, integers beyond 64 bits are bignums and byte
+// strings longer than 64 bytes are chunked.
+//
+// The on-chain encoding of a datum may differ; Datum.original_cbor keeps it.
+func Encode(pd *pbcardano.PlutusData) ([]byte, error) {
+	return appendData(nil, pd, 0)
+}
+
+func appendData(buf []byte, pd *pbcardano.PlutusData, depth int) ([]byte, error) {
+	if depth > maxDepth {
+		return nil, fmt.Errorf("plutus data nested deeper than %d", maxDepth)
+	}
+
+	var err error
+	switch v := pd.GetPlutusData().(type) {
+	case *pbcardano.PlutusData_Constr:
+		index := pbcardano.ConstrIndex(v.Constr)
+		tag := pbcardano.CanonicalConstrTag(index)
+		buf = appendHead(buf, majorTag, uint64(tag))
+		if tag == 102 {
+			buf = appendHead(buf, majorArray, 2)
+			buf = appendHead(buf, majorUnsigned, index)
+		}
+		return appendList(buf, v.Constr.Fields, depth)
+
+	case *pbcardano.PlutusData_Map:
+		pairs := v.Map.GetPairs()
+		buf = appendHead(buf, majorMap, uint64(len(pairs)))
+		for _, pair := range pairs {
+			if buf, err = appendData(buf, pair.Key, depth+1); err != nil {
+				return nil, err
+			}
+			if buf, err = appendData(buf, pair.Value, depth+1); err != nil {
+				return nil, err
+			}
+		}
+		return buf, nil
+
+	case *pbcardano.PlutusData_Array:
+		return appendList(buf, v.Array.GetItems(), depth)
+
+	case *pbcardano.PlutusData_BigInt:
+		return appendInteger(buf, v.BigInt.Value()), nil
+
+	case *pbcardano.PlutusData_BoundedBytes:
+		return appendBytes(buf, v.BoundedBytes), nil
+	}
+	return nil, fmt.Errorf("empty plutus data")
+}
+
+func appendList(buf []byte, items []*pbcardano.PlutusData, depth int) ([]byte, error) {
+	if len(items) == 0 {
+		return appendHead(buf, majorArray, 0), nil
+	}
+
+	buf = append(buf, majorArray<<5|31)
+	for _, item := range items {
+		var err error
+		if buf, err = appendData(buf, item, depth+1); err != nil {
+			return nil, err
+		}
+	}
+	return append(buf, breakCode), nil
+}
+
+var maxUint64 = new(big.Int).SetUint64(^uint64(0))
+
+func appendInteger(buf []byte, value *big.Int) []byte {
+	if value.Sign() >= 0 {
+		if value.IsUint64() {
+			return appendHead(buf, majorUnsigned, value.Uint64())
+		}
+		buf = appendHead(buf, majorTag, 2)
+		return appendBytes(buf, value.Bytes())
+	}
+
+	// Negative integers encode -1 - value.
+	n := new(big.Int).Neg(value)
+	n.Sub(n, big.NewInt(1))
+	if n.Cmp(maxUint64) <= 0 {
+		return appendHead(buf, majorNegative, n.Uint64())
+	}
+	buf = appendHead(buf, majorTag, 3)
+	return appendBytes(buf, n.Bytes())
+}
+
+func appendBytes(buf, b []byte) []byte {
+	if len(b) <= maxChunkSize {
+		buf = appendHead(buf, majorBytes, uint64(len(b)))
+		return append(buf, b...)
+	}
+
+	buf = append(buf, majorBytes<<5|31)
+	for len(b) > 0 {
+		chunk := b[:min(len(b), maxChunkSize)]
+		buf = appendHead(buf, majorBytes, uint64(len(chunk)))
+		buf = append(buf, chunk...)
+		b = b[len(chunk):]
+	}
+	return append(buf, breakCode)
+}
+
+// appendHead appends the initial byte and shortest argument of an item.
+func appendHead(buf []byte, major byte, arg uint64) []byte {
+	major <<= 5
+	switch {
+	case arg < 24:
+		return append(buf, major|byte(arg))
+	case arg <= 0xff:
+		return append(buf, major|24, byte(arg))
+	case arg <= 0xffff:
+		return binary.BigEndian.AppendUint16(append(buf, major|25), uint16(arg))
+	case arg <= 0xffffffff:
+		return binary.BigEndian.AppendUint32(append(buf, major|26), uint32(arg))
+	default:
+		return binary.BigEndian.AppendUint64(append(buf, major|27), arg)
+	}
+}
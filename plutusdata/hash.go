@@ -0,0 +1,64 @@
+package plutusdata
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	pbcardano "github.com/no-witness-labs/firehose-cardano/types/pb/sf/cardano/type/v1"
+	"golang.org/x/crypto/blake2b"
+	"google.golang.org/protobuf/proto"
+)
+
+// ErrDatumMismatch is returned by VerifyDatum when a datum does not match its
+// hash.
+var ErrDatumMismatch = errors.New("datum does not match its hash")
+
+// Hash returns the datum hash of CBOR-encoded Plutus data: the blake2b-256
+// of its bytes as they appear on chain.
+func Hash(data []byte) []byte {
+	hash := blake2b.Sum256(data)
+	return hash[:]
+}
+
+// DatumHash returns the hash of Plutus data in its canonical encoding. It
+// differs from the on-chain hash of datums encoded otherwise.
+func DatumHash(pd *pbcardano.PlutusData) ([]byte, error) {
+	data, err := Encode(pd)
+	if err != nil {
+		return nil, err
+	}
+	return Hash(data), nil
+}
+
+// VerifyDatum checks that the payload and original CBOR of a datum match its
+// hash. The original CBOR, when present, is hashed and has to decode to the
+// payload; the payload alone is hashed in its canonical encoding. A datum
+// known by its hash only verifies.
+func VerifyDatum(datum *pbcardano.Datum) error {
+	switch {
+	case datum.GetOriginalCbor() != nil:
+		if hash := Hash(datum.OriginalCbor); !bytes.Equal(hash, datum.Hash) {
+			return fmt.Errorf("%w: original CBOR hashes to %x, not %x", ErrDatumMismatch, hash, datum.Hash)
+		}
+		if datum.Payload == nil {
+			return nil
+		}
+		payload, err := Decode(datum.OriginalCbor)
+		if err != nil {
+			return fmt.Errorf("failed to decode original CBOR: %w", err)
+		}
+		if !proto.Equal(payload, datum.Payload) {
+			return fmt.Errorf("%w: payload differs from the original CBOR", ErrDatumMismatch)
+		}
+	case datum.GetPayload() != nil:
+		hash, err := DatumHash(datum.Payload)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(hash, datum.Hash) {
+			return fmt.Errorf("%w: payload hashes to %x, not %x", ErrDatumMismatch, hash, datum.Hash)
+		}
+	}
+	return nil
+}
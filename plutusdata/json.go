@@ -0,0 +1,20 @@
+package plutusdata
+
+import (
+	pbcardano "github.com/no-witness-labs/firehose-cardano/types/pb/sf/cardano/type/v1"
+)
+
+// ToJSON renders Plutus data in the cardano-cli detailed schema, see
+// pbcardano.MarshalJSON.
+func ToJSON(pd *pbcardano.PlutusData) ([]byte, error) {
+	return pbcardano.MarshalJSON(pd)
+}
+
+// FromJSON parses Plutus data in the cardano-cli detailed schema.
+func FromJSON(data []byte) (*pbcardano.PlutusData, error) {
+	pd := &pbcardano.PlutusData{}
+	if err := pbcardano.UnmarshalJSON(data, pd); err != nil {
+		return nil, err
+	}
+	return pd, nil
+}
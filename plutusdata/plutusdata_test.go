@@ -0,0 +1,116 @@
+package plutusdata
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+)
+
+func TestRoundTrip(t *testing.T) {
+	chunk := strings.Repeat("ab", 64)
+	for _, tc := range []struct {
+		name      string
+		cbor      string
+		canonical string // Encoding of the decoded data, when it differs
+	}{
+		{name: "unit", cbor: "d87980"},
+		{name: "constructor 1", cbor: "d87a80"},
+		{name: "constructor 6", cbor: "d87f80"},
+		{name: "constructor 7", cbor: "d9050080"},
+		{name: "constructor 127", cbor: "d9057880"},
+		{name: "constructor 128", cbor: "d86682188080"},
+		{name: "general form of a small index", cbor: "d866820180", canonical: "d87a80"},
+		{name: "constructor fields", cbor: "d8799f0102ff"},
+		{name: "definite constructor fields", cbor: "d879820102", canonical: "d8799f0102ff"},
+		{name: "general form fields", cbor: "d8668218809f4101ff"},
+		{name: "empty list", cbor: "80"},
+		{name: "list", cbor: "9f0102ff"},
+		{name: "definite list", cbor: "820102", canonical: "9f0102ff"},
+		{name: "nested lists", cbor: "9f9f01ff80ff"},
+		{name: "map", cbor: "a201024003"},
+		{name: "indefinite map", cbor: "bf0102ff", canonical: "a10102"},
+		{name: "zero", cbor: "00"},
+		{name: "negative", cbor: "20"},
+		{name: "max uint64", cbor: "1bffffffffffffffff"},
+		{name: "min negative", cbor: "3bffffffffffffffff"},
+		{name: "bignum", cbor: "c249010000000000000000"},
+		{name: "negative bignum", cbor: "c349010000000000000000"},
+		{name: "small bignum", cbor: "c2412a", canonical: "182a"},
+		{name: "bytes", cbor: "4401020304"},
+		{name: "64 bytes", cbor: "5840" + chunk},
+		{name: "65 bytes", cbor: "5f5840" + chunk + "41abff"},
+		{name: "129 bytes", cbor: "5f5840" + chunk + "5840" + chunk + "41abff"},
+		{name: "indefinite short bytes", cbor: "5f4201024103ff", canonical: "43010203"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := hex.DecodeString(tc.cbor)
+			if err != nil {
+				t.Fatal(err)
+			}
+			canonical := tc.canonical
+			if canonical == "" {
+				canonical = tc.cbor
+			}
+
+			pd, err := Decode(data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			encoded, err := Encode(pd)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if hex.EncodeToString(encoded) != canonical {
+				t.Errorf("encoded %x, expected %s", encoded, canonical)
+			}
+			again, err := Decode(encoded)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if reencoded, err := Encode(again); err != nil || !bytes.Equal(reencoded, encoded) {
+				t.Errorf("encoded %x once decoded again, %v", reencoded, err)
+			}
+
+			json, err := ToJSON(pd)
+			if err != nil {
+				t.Fatal(err)
+			}
+			fromJSON, err := FromJSON(json)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !proto.Equal(fromJSON, pd) {
+				t.Errorf("%s decoded as %v, expected %v", json, fromJSON, pd)
+			}
+
+			if hash, err := DatumHash(pd); err != nil || !bytes.Equal(hash, Hash(encoded)) {
+				t.Errorf("datum hash %x, %v, expected the hash of the canonical encoding", hash, err)
+			}
+		})
+	}
+}
+
+func TestDatumHash(t *testing.T) {
+	for _, tc := range []struct {
+		cbor string
+		hash string
+	}{
+		{cbor: "d87980", hash: "923918e403bf43c34b4ef6b48eb2ee04babed17320d8d1b9ff9ad086e86f44ec"},
+		{cbor: "00", hash: "03170a2e7597b7b7e3d84c05391d139a62b157e78786d8c082f29dcf4c111314"},
+	} {
+		data, err := hex.DecodeString(tc.cbor)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pd, err := Decode(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if hash, err := DatumHash(pd); err != nil || hex.EncodeToString(hash) != tc.hash {
+			t.Errorf("datum hash of %s %x, %v, expected %s", tc.cbor, hash, err, tc.hash)
+		}
+	}
+}
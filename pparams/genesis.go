@@ -0,0 +1,245 @@
+package pparams
+
+import (
+	"fmt"
+	"math/big"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/blinklabs-io/gouroboros/ledger/alonzo"
+	"github.com/blinklabs-io/gouroboros/ledger/common"
+	"github.com/blinklabs-io/gouroboros/ledger/conway"
+	"github.com/blinklabs-io/gouroboros/ledger/shelley"
+	pbcardano "github.com/no-witness-labs/firehose-cardano/types/pb/sf/cardano/type/v1"
+)
+
+// Genesis holds the protocol parameters the genesis files of a network set:
+// the initial Shelley parameters and the ones the Alonzo and Conway hard
+// forks introduce.
+type Genesis struct {
+	Shelley      *pbcardano.PParams
+	UpdateQuorum uint64 // Genesis delegates needed to adopt an update
+
+	// Alonzo and Conway only set the parameters their era introduces, nil
+	// when the genesis file was not given.
+	Alonzo *pbcardano.PParams
+	Conway *pbcardano.PParams
+}
+
+// LoadGenesis reads the Shelley, Alonzo and Conway genesis files of a
+// network. The Alonzo and Conway paths may be empty, the tracker then fails
+// when the chain reaches their era.
+func LoadGenesis(shelleyPath, alonzoPath, conwayPath string) (*Genesis, error) {
+	shelleyGenesis, err := shelley.NewShelleyGenesisFromFile(shelleyPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read Shelley genesis %s: %w", shelleyPath, err)
+	}
+	out := &Genesis{UpdateQuorum: uint64(shelleyGenesis.UpdateQuorum)}
+	if out.Shelley, err = shelleyParams(&shelleyGenesis.ProtocolParameters); err != nil {
+		return nil, fmt.Errorf("invalid Shelley genesis %s: %w", shelleyPath, err)
+	}
+
+	if alonzoPath != "" {
+		alonzoGenesis, err := alonzo.NewAlonzoGenesisFromFile(alonzoPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read Alonzo genesis %s: %w", alonzoPath, err)
+		}
+		if out.Alonzo, err = alonzoParams(&alonzoGenesis); err != nil {
+			return nil, fmt.Errorf("invalid Alonzo genesis %s: %w", alonzoPath, err)
+		}
+	}
+
+	if conwayPath != "" {
+		conwayGenesis, err := conway.NewConwayGenesisFromFile(conwayPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read Conway genesis %s: %w", conwayPath, err)
+		}
+		if out.Conway, err = conwayParams(&conwayGenesis); err != nil {
+			return nil, fmt.Errorf("invalid Conway genesis %s: %w", conwayPath, err)
+		}
+	}
+	return out, nil
+}
+
+func shelleyParams(g *shelley.ShelleyGenesisProtocolParams) (*pbcardano.PParams, error) {
+	out := &pbcardano.PParams{
+		MinFeeCoefficient:        uint64(g.MinFeeA),
+		MinFeeConstant:           uint64(g.MinFeeB),
+		MaxBlockBodySize:         uint64(g.MaxBlockBodySize),
+		MaxTxSize:                uint64(g.MaxTxSize),
+		MaxBlockHeaderSize:       uint64(g.MaxBlockHeaderSize),
+		StakeKeyDeposit:          uint64(g.KeyDeposit),
+		PoolDeposit:              uint64(g.PoolDeposit),
+		PoolRetirementEpochBound: uint64(g.MaxEpoch),
+		DesiredNumberOfPools:     uint64(g.NOpt),
+		ProtocolVersion: &pbcardano.ProtocolVersion{
+			Major: uint32(g.ProtocolVersion.Major),
+			Minor: uint32(g.ProtocolVersion.Minor),
+		},
+		MinUtxoValue: uint64(g.MinUtxoValue),
+		MinPoolCost:  uint64(g.MinPoolCost),
+		ExtraEntropy: []byte{},
+	}
+	if g.ExtraEntropy.Type != common.NonceTypeNeutral {
+		out.ExtraEntropy = g.ExtraEntropy.Value[:]
+	}
+
+	var err error
+	rationals := []struct {
+		name string
+		in   *common.GenesisRat
+		out  **pbcardano.RationalNumber
+	}{
+		{"a0", g.A0, &out.PoolInfluence},
+		{"rho", g.Rho, &out.MonetaryExpansion},
+		{"tau", g.Tau, &out.TreasuryExpansion},
+		{"decentralisationParam", g.Decentralization, &out.Decentralization},
+	}
+	for _, r := range rationals {
+		if *r.out, err = genesisRational(r.in); err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", r.name, err)
+		}
+	}
+	return out, nil
+}
+
+func alonzoParams(g *alonzo.AlonzoGenesis) (*pbcardano.PParams, error) {
+	out := &pbcardano.PParams{
+		CoinsPerUtxoWord:     g.LovelacePerUtxoWord,
+		MaxValueSize:         uint64(g.MaxValueSize),
+		CollateralPercentage: uint64(g.CollateralPercentage),
+		MaxCollateralInputs:  uint64(g.MaxCollateralInputs),
+		MaxExecutionUnitsPerTransaction: &pbcardano.ExUnits{
+			Steps:  uint64(g.MaxTxExUnits.Steps),
+			Memory: uint64(g.MaxTxExUnits.Mem),
+		},
+		MaxExecutionUnitsPerBlock: &pbcardano.ExUnits{
+			Steps:  uint64(g.MaxBlockExUnits.Steps),
+			Memory: uint64(g.MaxBlockExUnits.Mem),
+		},
+		CostModels: &pbcardano.CostModels{},
+	}
+
+	var err error
+	out.Prices = &pbcardano.ExPrices{}
+	if out.Prices.Steps, err = genesisRational(g.ExecutionPrices.Steps); err != nil {
+		return nil, fmt.Errorf("invalid prSteps: %w", err)
+	}
+	if out.Prices.Memory, err = genesisRational(g.ExecutionPrices.Mem); err != nil {
+		return nil, fmt.Errorf("invalid prMem: %w", err)
+	}
+
+	for language, model := range g.CostModels {
+		values := costModelValues(model)
+		switch language {
+		case "PlutusV1":
+			out.CostModels.PlutusV1 = &pbcardano.CostModel{Values: values}
+		case "PlutusV2":
+			out.CostModels.PlutusV2 = &pbcardano.CostModel{Values: values}
+		case "PlutusV3":
+			out.CostModels.PlutusV3 = &pbcardano.CostModel{Values: values}
+		default:
+			return nil, fmt.Errorf("unknown cost model language %q", language)
+		}
+	}
+	return out, nil
+}
+
+// costModelValues lists the values of a cost model given by parameter name
+// or by index: names sort in the order of the list form of the model.
+func costModelValues(model alonzo.CostModel) []int64 {
+	keys := make([]string, 0, len(model))
+	indexes := map[string]int{}
+	for key := range model {
+		keys = append(keys, key)
+		if index, err := strconv.Atoi(strings.TrimPrefix(key, "param")); err == nil {
+			indexes[key] = index
+		}
+	}
+
+	if len(indexes) == len(keys) {
+		slices.SortFunc(keys, func(a, b string) int { return indexes[a] - indexes[b] })
+	} else {
+		slices.Sort(keys)
+	}
+
+	values := make([]int64, len(keys))
+	for i, key := range keys {
+		values[i] = int64(model[key])
+	}
+	return values
+}
+
+func conwayParams(g *conway.ConwayGenesis) (*pbcardano.PParams, error) {
+	out := &pbcardano.PParams{
+		MinCommitteeSize:               uint32(g.MinCommitteeSize),
+		CommitteeTermLimit:             g.CommitteeTermLimit,
+		GovernanceActionValidityPeriod: g.GovActionValidityPeriod,
+		GovernanceActionDeposit:        g.GovActionDeposit,
+		DrepDeposit:                    g.DRepDeposit,
+		DrepInactivityPeriod:           g.DRepInactivityPeriod,
+	}
+	if len(g.PlutusV3CostModel) > 0 {
+		out.CostModels = &pbcardano.CostModels{
+			PlutusV3: &pbcardano.CostModel{Values: g.PlutusV3CostModel},
+		}
+	}
+
+	var err error
+	if out.MinFeeScriptRefCostPerByte, err = genesisRational(g.MinFeeRefScriptCostPerByte); err != nil {
+		return nil, fmt.Errorf("invalid minFeeRefScriptCostPerByte: %w", err)
+	}
+
+	pool := g.PoolVotingThresholds
+	if out.PoolVotingThresholds, err = votingThresholds(
+		pool.MotionNoConfidence, pool.CommitteeNormal, pool.CommitteeNoConfidence,
+		pool.HardForkInitiation, pool.PpSecurityGroup,
+	); err != nil {
+		return nil, fmt.Errorf("invalid poolVotingThresholds: %w", err)
+	}
+	drep := g.DRepVotingThresholds
+	if out.DrepVotingThresholds, err = votingThresholds(
+		drep.MotionNoConfidence, drep.CommitteeNormal, drep.CommitteeNoConfidence,
+		drep.UpdateToConstitution, drep.HardForkInitiation, drep.PpNetworkGroup,
+		drep.PpEconomicGroup, drep.PpTechnicalGroup, drep.PpGovGroup, drep.TreasuryWithdrawal,
+	); err != nil {
+		return nil, fmt.Errorf("invalid dRepVotingThresholds: %w", err)
+	}
+	return out, nil
+}
+
+func votingThresholds(thresholds ...*common.GenesisRat) (*pbcardano.VotingThresholds, error) {
+	out := &pbcardano.VotingThresholds{}
+	for i, threshold := range thresholds {
+		r, err := genesisRational(threshold)
+		if err != nil {
+			return nil, err
+		}
+		if r == nil {
+			return nil, fmt.Errorf("threshold %d is missing", i)
+		}
+		out.Thresholds = append(out.Thresholds, r)
+	}
+	return out, nil
+}
+
+// genesisRational converts a rational of a genesis file, nil when it is
+// absent.
+func genesisRational(r *common.GenesisRat) (*pbcardano.RationalNumber, error) {
+	if r == nil || r.Rat == nil {
+		return nil, nil
+	}
+	num, denom := r.Num(), r.Denom()
+	if !fitsInt32(num) || !denom.IsUint64() || denom.Uint64() >= 1<<32 {
+		return nil, fmt.Errorf("%s does not fit a 32-bit rational", r.String())
+	}
+	return &pbcardano.RationalNumber{
+		Numerator:   int32(num.Int64()),
+		Denominator: uint32(denom.Uint64()),
+	}, nil
+}
+
+func fitsInt32(n *big.Int) bool {
+	return n.IsInt64() && n.Int64() >= -1<<31 && n.Int64() < 1<<31
+}
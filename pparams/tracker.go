@@ -0,0 +1,485 @@
+// Package pparams tracks the protocol parameters in force on a chain, epoch
+// after epoch, from the blocks the fetcher converts.
+//
+// The tracker starts from the parameters of the Shelley genesis and follows
+// the chain from the first Shelley epoch on. At each epoch boundary it adopts
+// the pre-Conway updates enough genesis delegates proposed for the closing
+// epoch and upgrades the parameters to the era the new epoch belongs to. The
+// parameters in force are attached to the first block of every epoch.
+//
+// Conway governance actions are enacted after ratification by stake-weighted
+// votes the block stream does not carry. When parameter change or hard fork
+// actions could have been enacted at a boundary, the tracker takes the
+// parameters in force from a Ledger, a local node. Without one, or when the
+// node no longer has the ledger state of the boundary, the parameters are
+// attached flagged as unreliable (Block.protocol_params_unreliable) until a
+// ledger tells them again.
+//
+// Like the UTxO store, the tracker keeps the undo records of its last
+// security parameter blocks so that chain-sync rollbacks can be reverted.
+package pparams
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/blinklabs-io/gouroboros/protocol/common"
+	"github.com/fxamacker/cbor/v2"
+	"github.com/no-witness-labs/firehose-cardano/era"
+	"github.com/no-witness-labs/firehose-cardano/internal/undolog"
+	pbcardano "github.com/no-witness-labs/firehose-cardano/types/pb/sf/cardano/type/v1"
+	bolt "go.etcd.io/bbolt"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	metaBucket = []byte("meta")
+
+	stateKey = []byte("state")
+)
+
+var (
+	// ErrRollbackTooDeep is returned when a rollback goes past the retained
+	// undo records. The tracker is then unusable and has to be rebuilt.
+	ErrRollbackTooDeep = undolog.ErrRollbackTooDeep
+
+	// ErrNotFromGenesis is returned when an empty tracker is given a block
+	// past the first Shelley epoch, whose parameters it cannot know.
+	ErrNotFromGenesis = errors.New("protocol parameters have to be tracked from the first Shelley epoch")
+)
+
+// Ledger tells the protocol parameters in force at the start of an epoch,
+// the enacted Conway governance actions included.
+type Ledger interface {
+	// Params returns the parameters in force at point, the first block of
+	// epoch, nil when the ledger state at point is not available.
+	Params(point common.Point, epoch uint64) (*pbcardano.PParams, error)
+}
+
+// Option configures a Tracker.
+type Option func(*Tracker)
+
+// WithLedger takes the parameters in force from ledger at the boundaries
+// Conway governance actions could have been enacted at.
+func WithLedger(ledger Ledger) Option {
+	return func(t *Tracker) {
+		t.ledger = ledger
+	}
+}
+
+// Tracker is a persistent protocol parameter tracker.
+type Tracker struct {
+	db      *bolt.DB
+	history *era.History
+	genesis *Genesis
+	ledger  Ledger
+	log     *undolog.Log
+}
+
+// state is what the tracker knows at a point of the chain.
+type state struct {
+	Epoch   uint64         `cbor:"0,keyasint"`
+	Params  []byte         `cbor:"1,keyasint"` // sf.cardano.type.v1.PParams, nil before the first Shelley epoch
+	Updates []updateRecord `cbor:"2,keyasint"`
+	Actions []actionRecord `cbor:"3,keyasint"`
+
+	// Unreliable is set when Conway actions may have changed the parameters
+	// without a ledger telling them.
+	Unreliable bool `cbor:"4,keyasint"`
+}
+
+// updateRecord is the pre-Conway update a genesis delegate proposed for an
+// epoch. A delegate proposing again for the same epoch replaces it.
+type updateRecord struct {
+	Epoch       uint64 `cbor:"0,keyasint"`
+	GenesisHash []byte `cbor:"1,keyasint"`
+	Update      []byte `cbor:"2,keyasint"` // sf.cardano.type.v1.PParamsUpdate
+}
+
+// actionRecord is a Conway parameter change or hard fork initiation action
+// not known to be enacted yet.
+type actionRecord struct {
+	TxHash []byte `cbor:"0,keyasint"`
+	Index  uint32 `cbor:"1,keyasint"`
+	Epoch  uint64 `cbor:"2,keyasint"` // Epoch the action was proposed in
+	Update []byte `cbor:"3,keyasint"` // sf.cardano.type.v1.PParamsUpdate
+}
+
+// Open opens, or creates, the tracker database at path.
+func Open(path string, history *era.History, genesis *Genesis, opts ...Option) (*Tracker, error) {
+	db, err := bolt.Open(path, 0600, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open protocol parameter tracker %s: %w", path, err)
+	}
+
+	log := undolog.New("protocol parameter tracker", func(slot uint64) uint64 { return history.EraAt(slot).SecurityParam })
+	if err := db.Update(log.Init); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to initialize protocol parameter tracker %s: %w", path, err)
+	}
+
+	t := &Tracker{db: db, history: history, genesis: genesis, log: log}
+	for _, opt := range opts {
+		opt(t)
+	}
+	return t, nil
+}
+
+func (t *Tracker) Close() error {
+	return t.db.Close()
+}
+
+// Tip returns the point of the last applied block, nil for an empty tracker.
+func (t *Tracker) Tip() (*common.Point, error) {
+	var point *common.Point
+	err := t.db.View(func(tx *bolt.Tx) error {
+		var err error
+		point, err = t.log.Tip(tx)
+		return err
+	})
+	return point, err
+}
+
+// Params returns the protocol parameters in force at the tip and their
+// epoch, nil before the first Shelley epoch.
+func (t *Tracker) Params() (*pbcardano.PParams, uint64, error) {
+	var params *pbcardano.PParams
+	var epoch uint64
+	err := t.db.View(func(tx *bolt.Tx) error {
+		st, err := readState(tx)
+		if err != nil || st.Params == nil {
+			return err
+		}
+		params = &pbcardano.PParams{}
+		epoch = st.Epoch
+		return proto.Unmarshal(st.Params, params)
+	})
+	return params, epoch, err
+}
+
+// ApplyBlock follows a converted block: when it is the first block of an
+// epoch, the epoch boundaries since the previous block are crossed and the
+// parameters in force are attached to it. The parameter updates its
+// transactions propose are then recorded.
+func (t *Tracker) ApplyBlock(block *pbcardano.Block) error {
+	slot, hash := block.GetHeader().GetSlot(), block.GetHeader().GetHash()
+	return t.db.Update(func(tx *bolt.Tx) error {
+		tip, err := t.log.Tip(tx)
+		if err != nil {
+			return err
+		}
+		if tip != nil && slot < tip.Slot {
+			return fmt.Errorf("block at slot %d does not extend protocol parameter tracker tip at slot %d", slot, tip.Slot)
+		}
+
+		st, err := readState(tx)
+		if err != nil {
+			return err
+		}
+		prev, err := cbor.Marshal(st)
+		if err != nil {
+			return fmt.Errorf("failed to encode protocol parameter state: %w", err)
+		}
+		if err := t.apply(st, block); err != nil {
+			return err
+		}
+
+		data, err := cbor.Marshal(st)
+		if err != nil {
+			return fmt.Errorf("failed to encode protocol parameter state: %w", err)
+		}
+		// The undo data is the state before the block, nil when the block
+		// left it unchanged.
+		var undo []byte
+		if !bytes.Equal(data, prev) {
+			undo = prev
+			if err := tx.Bucket(metaBucket).Put(stateKey, data); err != nil {
+				return err
+			}
+		}
+		return t.log.Push(tx, common.NewPoint(slot, hash), undo)
+	})
+}
+
+func (t *Tracker) apply(st *state, block *pbcardano.Block) error {
+	epoch := t.history.SlotToEpoch(block.GetHeader().GetSlot())
+	if t.history.EraOfEpoch(epoch).Name == "byron" {
+		return nil
+	}
+
+	params := &pbcardano.PParams{}
+	if st.Params == nil {
+		// The first Shelley epoch starts from the genesis parameters.
+		start := t.firstShelleyEra()
+		if epoch != start.StartEpoch {
+			return fmt.Errorf("%w: block at slot %d is in epoch %d, not %d", ErrNotFromGenesis, block.GetHeader().GetSlot(), epoch, start.StartEpoch)
+		}
+		params = proto.Clone(t.genesis.Shelley).(*pbcardano.PParams)
+		if err := t.upgrade(params, "shelley", start.Name); err != nil {
+			return err
+		}
+		st.Epoch = epoch
+		t.attach(block, params, epoch, false)
+	} else {
+		if err := proto.Unmarshal(st.Params, params); err != nil {
+			return fmt.Errorf("failed to decode protocol parameters: %w", err)
+		}
+		if epoch > st.Epoch {
+			// Actions alive at a boundary may have been enacted at it.
+			enactable := false
+			for st.Epoch < epoch {
+				enactable = enactable || len(st.Actions) > 0
+				if err := t.newEpoch(st, params, st.Epoch+1); err != nil {
+					return err
+				}
+			}
+			if enactable || st.Unreliable {
+				if err := t.settle(st, params, block, epoch); err != nil {
+					return err
+				}
+			}
+			t.attach(block, params, epoch, st.Unreliable)
+		}
+	}
+
+	var err error
+	if st.Params, err = marshal(params); err != nil {
+		return err
+	}
+
+	for _, tx := range block.GetBody().GetTx() {
+		if !tx.BodyApplied() {
+			continue
+		}
+		if err := st.addUpdates(tx.GetUpdate()); err != nil {
+			return err
+		}
+		for i, proposal := range tx.GetProposals() {
+			var change *pbcardano.PParamsUpdate
+			switch action := proposal.GetGovAction(); {
+			case action.GetParameterChangeAction() != nil:
+				change = action.GetParameterChangeAction().GetUpdate()
+			case action.GetHardForkInitiationAction() != nil:
+				change = &pbcardano.PParamsUpdate{ProtocolVersion: action.GetHardForkInitiationAction().GetProtocolVersion()}
+			default:
+				continue
+			}
+			update, err := marshal(change)
+			if err != nil {
+				return err
+			}
+			st.Actions = append(st.Actions, actionRecord{TxHash: tx.Hash, Index: uint32(i), Epoch: epoch, Update: update})
+		}
+	}
+	return nil
+}
+
+// newEpoch crosses the boundary into epoch.
+func (t *Tracker) newEpoch(st *state, params *pbcardano.PParams, epoch uint64) error {
+	closing := epoch - 1
+
+	// Delegates proposing the same update for the closing epoch get it
+	// adopted when they reach the quorum.
+	votes := map[string]uint64{}
+	var adopted []byte
+	var remaining []updateRecord
+	for _, u := range st.Updates {
+		if u.Epoch > closing {
+			remaining = append(remaining, u)
+			continue
+		}
+		if u.Epoch < closing {
+			continue
+		}
+		votes[string(u.Update)]++
+		if adopted == nil && votes[string(u.Update)] >= t.genesis.UpdateQuorum {
+			adopted = u.Update
+		}
+	}
+	st.Updates = remaining
+	if adopted != nil {
+		if err := applyUpdate(params, adopted); err != nil {
+			return err
+		}
+	}
+
+	// An action can be ratified at the end of the last epoch of its lifetime
+	// and enacted one epoch later.
+	var live []actionRecord
+	for _, action := range st.Actions {
+		if action.Epoch+params.GovernanceActionValidityPeriod+2 >= epoch {
+			live = append(live, action)
+		}
+	}
+	st.Actions = live
+
+	if from, to := t.history.EraOfEpoch(closing).Name, t.history.EraOfEpoch(epoch).Name; from != to {
+		if err := t.upgrade(params, from, to); err != nil {
+			return err
+		}
+	}
+	st.Epoch = epoch
+	return nil
+}
+
+// settle takes the parameters in force at the first block of epoch from the
+// ledger, Conway actions having possibly been enacted since the previous
+// block. Without them, the parameters are unreliable until a later boundary
+// settles them.
+func (t *Tracker) settle(st *state, params *pbcardano.PParams, block *pbcardano.Block, epoch uint64) error {
+	var current *pbcardano.PParams
+	if t.ledger != nil {
+		point := common.NewPoint(block.GetHeader().GetSlot(), block.GetHeader().GetHash())
+		var err error
+		if current, err = t.ledger.Params(point, epoch); err != nil {
+			return fmt.Errorf("failed to get the protocol parameters of epoch %d: %w", epoch, err)
+		}
+	}
+	if current == nil {
+		st.Unreliable = true
+		return nil
+	}
+	proto.Reset(params)
+	proto.Merge(params, current)
+	st.Unreliable = false
+	return nil
+}
+
+// shelleyEras are the eras sharing the Shelley parameters, in order.
+var shelleyEras = []string{"shelley", "allegra", "mary", "alonzo", "babbage", "conway"}
+
+// upgrade translates parameters through the hard forks from era from to era
+// to. Networks can start past Shelley, the hard forks are crossed all the
+// same.
+func (t *Tracker) upgrade(params *pbcardano.PParams, from, to string) error {
+	start, end := slices.Index(shelleyEras, from), slices.Index(shelleyEras, to)
+	if start < 0 || end < 0 {
+		return fmt.Errorf("unknown era transition from %s to %s", from, to)
+	}
+
+	for _, name := range shelleyEras[start+1 : end+1] {
+		switch name {
+		case "alonzo":
+			if t.genesis.Alonzo == nil {
+				return fmt.Errorf("the Alonzo genesis is needed to track protocol parameters into the Alonzo era")
+			}
+			params.MinUtxoValue = 0
+			proto.Merge(params, t.genesis.Alonzo)
+		case "babbage":
+			params.CoinsPerUtxoByte = params.CoinsPerUtxoWord / 8
+			params.CoinsPerUtxoWord = 0
+			params.Decentralization = nil
+			params.ExtraEntropy = nil
+		case "conway":
+			if t.genesis.Conway == nil {
+				return fmt.Errorf("the Conway genesis is needed to track protocol parameters into the Conway era")
+			}
+			proto.Merge(params, t.genesis.Conway)
+		}
+	}
+	return nil
+}
+
+func (t *Tracker) firstShelleyEra() *era.Era {
+	for i := range t.history.Eras {
+		if t.history.Eras[i].Name != "byron" {
+			return &t.history.Eras[i]
+		}
+	}
+	return &t.history.Eras[len(t.history.Eras)-1]
+}
+
+// attach sets the parameters in force and the era of the epoch on its first
+// block.
+func (t *Tracker) attach(block *pbcardano.Block, params *pbcardano.PParams, epoch uint64, unreliable bool) {
+	block.ProtocolParams = proto.Clone(params).(*pbcardano.PParams)
+	block.ProtocolParamsUnreliable = unreliable
+
+	eras := t.history.Eras
+	for i := range eras {
+		if i+1 < len(eras) && epoch >= eras[i+1].StartEpoch {
+			continue
+		}
+		block.Era = &pbcardano.EraSummary{
+			Name:  eras[i].Name,
+			Start: eraBoundary(&eras[i]),
+		}
+		if i+1 < len(eras) {
+			block.Era.End = eraBoundary(&eras[i+1])
+		}
+		return
+	}
+}
+
+func eraBoundary(e *era.Era) *pbcardano.EraBoundary {
+	return &pbcardano.EraBoundary{Time: uint64(e.StartTime), Slot: e.StartSlot, Epoch: e.StartEpoch}
+}
+
+// addUpdates records the updates a transaction proposes, replacing the
+// previous proposals of the same delegates for the same epoch.
+func (st *state) addUpdates(update *pbcardano.ProtocolParamUpdate) error {
+	for _, proposal := range update.GetProposals() {
+		data, err := marshal(proposal.GetParams())
+		if err != nil {
+			return err
+		}
+		record := updateRecord{Epoch: update.Epoch, GenesisHash: proposal.GenesisHash, Update: data}
+
+		replaced := false
+		for i, u := range st.Updates {
+			if u.Epoch == record.Epoch && bytes.Equal(u.GenesisHash, record.GenesisHash) {
+				st.Updates[i], replaced = record, true
+				break
+			}
+		}
+		if !replaced {
+			st.Updates = append(st.Updates, record)
+		}
+	}
+	return nil
+}
+
+func applyUpdate(params *pbcardano.PParams, data []byte) error {
+	update := &pbcardano.PParamsUpdate{}
+	if err := proto.Unmarshal(data, update); err != nil {
+		return fmt.Errorf("failed to decode protocol parameter update: %w", err)
+	}
+	update.Apply(params)
+	return nil
+}
+
+// marshal encodes a message deterministically, so that equal updates get
+// equal encodings.
+func marshal(m proto.Message) ([]byte, error) {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(m)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode %s: %w", m.ProtoReflect().Descriptor().Name(), err)
+	}
+	return data, nil
+}
+
+// Rollback reverts the blocks applied after point.
+func (t *Tracker) Rollback(point common.Point) error {
+	return t.db.Update(func(tx *bolt.Tx) error {
+		return t.log.Rollback(tx, point, func(prev []byte) error {
+			if prev == nil {
+				return nil
+			}
+			return tx.Bucket(metaBucket).Put(stateKey, prev)
+		})
+	})
+}
+
+func readState(tx *bolt.Tx) (*state, error) {
+	st := &state{}
+	data := tx.Bucket(metaBucket).Get(stateKey)
+	if data == nil {
+		return st, nil
+	}
+	if err := cbor.Unmarshal(data, st); err != nil {
+		return nil, fmt.Errorf("failed to decode protocol parameter state: %w", err)
+	}
+	return st, nil
+}
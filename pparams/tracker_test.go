@@ -0,0 +1,147 @@
+package pparams
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/blinklabs-io/gouroboros/protocol/common"
+	"github.com/no-witness-labs/firehose-cardano/era"
+	pbcardano "github.com/no-witness-labs/firehose-cardano/types/pb/sf/cardano/type/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// testHistory is a Conway-only chain of 10-slot epochs.
+var testHistory = &era.History{
+	Network: "test",
+	Eras:    []era.Era{{Name: "conway", SlotLength: 1000, EpochLength: 10, SecurityParam: 10}},
+}
+
+var testGenesis = &Genesis{
+	Shelley:      &pbcardano.PParams{MaxTxSize: 16384, ProtocolVersion: &pbcardano.ProtocolVersion{Major: 9}},
+	UpdateQuorum: 5,
+	Alonzo:       &pbcardano.PParams{},
+	Conway:       &pbcardano.PParams{GovernanceActionValidityPeriod: 6},
+}
+
+// ledgerFunc is a Ledger answering with a function.
+type ledgerFunc func(point common.Point, epoch uint64) (*pbcardano.PParams, error)
+
+func (f ledgerFunc) Params(point common.Point, epoch uint64) (*pbcardano.PParams, error) {
+	return f(point, epoch)
+}
+
+func testBlock(slot uint64, proposals ...*pbcardano.GovernanceActionProposal) *pbcardano.Block {
+	block := &pbcardano.Block{Header: &pbcardano.BlockHeader{Slot: slot, Hash: []byte{byte(slot)}}}
+	if len(proposals) > 0 {
+		block.Body = &pbcardano.BlockBody{Tx: []*pbcardano.Tx{{Hash: []byte{byte(slot)}, Proposals: proposals}}}
+	}
+	return block
+}
+
+func parameterChange(maxTxSize uint64) *pbcardano.GovernanceActionProposal {
+	return &pbcardano.GovernanceActionProposal{GovAction: &pbcardano.GovernanceAction{
+		GovernanceAction: &pbcardano.GovernanceAction_ParameterChangeAction{
+			ParameterChangeAction: &pbcardano.ParameterChangeAction{Update: &pbcardano.PParamsUpdate{MaxTxSize: proto.Uint64(maxTxSize)}},
+		},
+	}}
+}
+
+func TestConwayEnactment(t *testing.T) {
+	enacted := &pbcardano.PParams{MaxTxSize: 32768, ProtocolVersion: &pbcardano.ProtocolVersion{Major: 10}}
+	for _, tc := range []struct {
+		name       string
+		ledger     Ledger
+		maxTxSize  uint64
+		unreliable bool
+	}{
+		{name: "no ledger", maxTxSize: 16384, unreliable: true},
+		{
+			name:       "point too old",
+			ledger:     ledgerFunc(func(common.Point, uint64) (*pbcardano.PParams, error) { return nil, nil }),
+			maxTxSize:  16384,
+			unreliable: true,
+		},
+		{
+			name: "node params",
+			ledger: ledgerFunc(func(point common.Point, epoch uint64) (*pbcardano.PParams, error) {
+				if point.Slot != epoch*10 {
+					t.Errorf("queried slot %d of epoch %d, not its first block", point.Slot, epoch)
+				}
+				return enacted, nil
+			}),
+			maxTxSize: 32768,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var opts []Option
+			if tc.ledger != nil {
+				opts = append(opts, WithLedger(tc.ledger))
+			}
+			tracker, err := Open(filepath.Join(t.TempDir(), "pparams.db"), testHistory, testGenesis, opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer tracker.Close()
+
+			first := testBlock(0, parameterChange(32768))
+			if err := tracker.ApplyBlock(first); err != nil {
+				t.Fatal(err)
+			}
+			if first.ProtocolParams.GetMaxTxSize() != 16384 || first.ProtocolParamsUnreliable {
+				t.Fatalf("genesis parameters %v, unreliable %t", first.ProtocolParams, first.ProtocolParamsUnreliable)
+			}
+
+			next := testBlock(10)
+			if err := tracker.ApplyBlock(next); err != nil {
+				t.Fatal(err)
+			}
+			if got := next.ProtocolParams.GetMaxTxSize(); got != tc.maxTxSize {
+				t.Errorf("max tx size %d, expected %d", got, tc.maxTxSize)
+			}
+			if next.ProtocolParamsUnreliable != tc.unreliable {
+				t.Errorf("unreliable %t, expected %t", next.ProtocolParamsUnreliable, tc.unreliable)
+			}
+
+			// Unreliable parameters stay so until a ledger tells them.
+			later := testBlock(20)
+			if err := tracker.ApplyBlock(later); err != nil {
+				t.Fatal(err)
+			}
+			if later.ProtocolParamsUnreliable != tc.unreliable {
+				t.Errorf("unreliable %t in the next epoch, expected %t", later.ProtocolParamsUnreliable, tc.unreliable)
+			}
+
+			if err := tracker.Rollback(common.NewPoint(0, []byte{0})); err != nil {
+				t.Fatal(err)
+			}
+			params, epoch, err := tracker.Params()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if epoch != 0 || params.GetMaxTxSize() != 16384 {
+				t.Errorf("after rollback, epoch %d max tx size %d", epoch, params.GetMaxTxSize())
+			}
+		})
+	}
+}
+
+func TestNoActionNoQuery(t *testing.T) {
+	tracker, err := Open(filepath.Join(t.TempDir(), "pparams.db"), testHistory, testGenesis,
+		WithLedger(ledgerFunc(func(common.Point, uint64) (*pbcardano.PParams, error) {
+			t.Error("ledger queried without any action to enact")
+			return nil, nil
+		})))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tracker.Close()
+
+	for _, block := range []*pbcardano.Block{testBlock(0), testBlock(10)} {
+		if err := tracker.ApplyBlock(block); err != nil {
+			t.Fatal(err)
+		}
+		if block.ProtocolParams == nil || block.ProtocolParamsUnreliable {
+			t.Errorf("block at slot %d: parameters %v, unreliable %t", block.Header.Slot, block.ProtocolParams, block.ProtocolParamsUnreliable)
+		}
+	}
+}
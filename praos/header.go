@@ -0,0 +1,183 @@
+package praos
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/binary"
+	"fmt"
+	"strings"
+
+	"github.com/blinklabs-io/gouroboros/cbor"
+	"github.com/blinklabs-io/gouroboros/ledger"
+	"github.com/blinklabs-io/gouroboros/ledger/allegra"
+	"github.com/blinklabs-io/gouroboros/ledger/babbage"
+	"github.com/blinklabs-io/gouroboros/ledger/common"
+	"github.com/blinklabs-io/gouroboros/ledger/shelley"
+)
+
+// kesDepth is the depth of the Sum KES scheme of Cardano, 2^6 periods.
+const kesDepth = 6
+
+// HeaderError is returned for a block whose header is not valid.
+type HeaderError struct {
+	Slot    uint64
+	Hash    common.Blake2b256
+	Reasons []string
+}
+
+func (e *HeaderError) Error() string {
+	return fmt.Sprintf("invalid header of block %s at slot %d: %s", e.Hash, e.Slot, strings.Join(e.Reasons, "; "))
+}
+
+// header holds what the validation needs of a Shelley or later header.
+type header struct {
+	slot       uint64
+	hash       common.Blake2b256
+	prevHash   common.Blake2b256
+	issuerVkey []byte
+	vrfKey     []byte
+
+	// TPraos headers, up to Alonzo, carry a nonce and a leader VRF proof,
+	// Praos ones a single proof both are derived from, in nonceVrf.
+	praos     bool
+	conway    bool
+	nonceVrf  common.VrfResult
+	leaderVrf common.VrfResult
+
+	hotVkey   []byte
+	counter   uint64
+	kesPeriod uint64
+	opCertSig []byte
+
+	body      []byte // CBOR of the header body, as signed
+	signature []byte
+}
+
+// headerOf returns the header of a Shelley or later block, nil for Byron.
+func headerOf(h ledger.BlockHeader) (*header, error) {
+	var out *header
+	switch h := h.(type) {
+	case *ledger.ShelleyBlockHeader:
+		out = fromShelley(h)
+	case *allegra.AllegraBlockHeader:
+		out = fromShelley(&h.ShelleyBlockHeader)
+	case *ledger.MaryBlockHeader:
+		out = fromShelley(&h.ShelleyBlockHeader)
+	case *ledger.AlonzoBlockHeader:
+		out = fromShelley(&h.ShelleyBlockHeader)
+	case *ledger.BabbageBlockHeader:
+		out = fromBabbage(h)
+	case *ledger.ConwayBlockHeader:
+		out = fromBabbage(&h.BabbageBlockHeader)
+		out.conway = true
+	default:
+		return nil, nil
+	}
+
+	// A header is [header body, KES signature].
+	var parts []cbor.RawMessage
+	if _, err := cbor.Decode(h.Cbor(), &parts); err != nil || len(parts) != 2 {
+		return nil, fmt.Errorf("failed to decode header of block %s", h.Hash())
+	}
+	out.body = parts[0]
+	return out, nil
+}
+
+func fromShelley(h *shelley.ShelleyBlockHeader) *header {
+	return &header{
+		slot:       h.Body.Slot,
+		hash:       h.Hash(),
+		prevHash:   h.Body.PrevHash,
+		issuerVkey: h.Body.IssuerVkey[:],
+		vrfKey:     h.Body.VrfKey,
+		nonceVrf:   h.Body.NonceVrf,
+		leaderVrf:  h.Body.LeaderVrf,
+		hotVkey:    h.Body.OpCertHotVkey,
+		counter:    uint64(h.Body.OpCertSequenceNumber),
+		kesPeriod:  uint64(h.Body.OpCertKesPeriod),
+		opCertSig:  h.Body.OpCertSignature,
+		signature:  h.Signature,
+	}
+}
+
+func fromBabbage(h *babbage.BabbageBlockHeader) *header {
+	return &header{
+		slot:       h.Body.Slot,
+		hash:       h.Hash(),
+		prevHash:   h.Body.PrevHash,
+		issuerVkey: h.Body.IssuerVkey[:],
+		vrfKey:     h.Body.VrfKey,
+		praos:      true,
+		nonceVrf:   h.Body.VrfResult,
+		hotVkey:    h.Body.OpCert.HotVkey,
+		counter:    uint64(h.Body.OpCert.SequenceNumber),
+		kesPeriod:  uint64(h.Body.OpCert.KesPeriod),
+		opCertSig:  h.Body.OpCert.Signature,
+		signature:  h.Signature,
+	}
+}
+
+// issuer returns the hash of the cold key of the issuer, which operational
+// certificate counters are kept by.
+func (h *header) issuer() []byte {
+	return common.Blake2b224Hash(h.issuerVkey).Bytes()
+}
+
+// nonce returns the contribution of the block to the evolving nonce.
+func (h *header) nonce() []byte {
+	if h.praos {
+		tagged := common.Blake2b256Hash(append([]byte("N"), h.nonceVrf.Output...))
+		return common.Blake2b256Hash(tagged.Bytes()).Bytes()
+	}
+	return common.Blake2b256Hash(h.nonceVrf.Output).Bytes()
+}
+
+// check validates the header, lastCounter being the operational certificate
+// counter last seen for the issuer, nil when none was, and epochNonce the
+// nonce of the epoch, nil when unknown. It returns the reasons the header is
+// invalid for.
+func (h *header) check(params *Params, lastCounter *uint64, epochNonce []byte) []string {
+	var reasons []string
+
+	kesPeriod := h.slot / params.SlotsPerKESPeriod
+	if kesPeriod < h.kesPeriod || kesPeriod >= h.kesPeriod+params.MaxKESEvolutions {
+		reasons = append(reasons, fmt.Sprintf("KES period %d outside of the operational certificate periods [%d, %d)", kesPeriod, h.kesPeriod, h.kesPeriod+params.MaxKESEvolutions))
+	}
+
+	signable := binary.BigEndian.AppendUint64(bytes.Clone(h.hotVkey), h.counter)
+	signable = binary.BigEndian.AppendUint64(signable, h.kesPeriod)
+	if len(h.issuerVkey) != ed25519.PublicKeySize || !ed25519.Verify(h.issuerVkey, signable, h.opCertSig) {
+		reasons = append(reasons, "invalid operational certificate signature")
+	}
+	if lastCounter != nil && (h.counter < *lastCounter || h.counter > *lastCounter+1) {
+		reasons = append(reasons, fmt.Sprintf("operational certificate counter %d, last one was %d", h.counter, *lastCounter))
+	}
+
+	if len(h.hotVkey) != ed25519.PublicKeySize || len(h.signature) != ledger.SIGMA_SIZE+kesDepth*2*ledger.PUBLIC_KEY_SIZE {
+		reasons = append(reasons, "malformed KES signature")
+	} else if kesPeriod < h.kesPeriod || !ledger.NewSumKesFromByte(kesDepth, h.signature).Verify(kesPeriod-h.kesPeriod, h.hotVkey, h.body) {
+		reasons = append(reasons, "invalid KES signature")
+	}
+
+	if epochNonce == nil {
+		return reasons
+	}
+	if h.praos {
+		if !verifyVrf(h.vrfKey, h.nonceVrf, praosInput(h.slot, epochNonce)) {
+			reasons = append(reasons, "invalid VRF proof")
+		}
+		return reasons
+	}
+	if !verifyVrf(h.vrfKey, h.nonceVrf, tpraosSeed(seedEta, h.slot, epochNonce)) {
+		reasons = append(reasons, "invalid nonce VRF proof")
+	}
+	if !verifyVrf(h.vrfKey, h.leaderVrf, tpraosSeed(seedL, h.slot, epochNonce)) {
+		reasons = append(reasons, "invalid leader VRF proof")
+	}
+	return reasons
+}
+
+func verifyVrf(key []byte, result common.VrfResult, input []byte) bool {
+	output, err := ledger.VrfVerifyAndHash(key, result.Proof, input)
+	return err == nil && bytes.Equal(output, result.Output)
+}
@@ -0,0 +1,47 @@
+package praos
+
+import (
+	"encoding/binary"
+
+	"github.com/blinklabs-io/gouroboros/ledger/common"
+)
+
+// Nonces are 32 byte hashes, nil standing for the neutral nonce.
+
+// The nonces TPraos derives the seeds of the nonce and leader VRF proofs of
+// a slot with.
+var (
+	seedEta = common.Blake2b256Hash(binary.BigEndian.AppendUint64(nil, 0)).Bytes()
+	seedL   = common.Blake2b256Hash(binary.BigEndian.AppendUint64(nil, 1)).Bytes()
+)
+
+// combine returns a ⭒ b, the neutral nonce being the identity.
+func combine(a, b []byte) []byte {
+	if len(a) == 0 {
+		return b
+	}
+	if len(b) == 0 {
+		return a
+	}
+	return common.Blake2b256Hash(append(append([]byte{}, a...), b...)).Bytes()
+}
+
+// slotInput returns the hash of the slot and the epoch nonce.
+func slotInput(slot uint64, epochNonce []byte) common.Blake2b256 {
+	return common.Blake2b256Hash(append(binary.BigEndian.AppendUint64(nil, slot), epochNonce...))
+}
+
+// praosInput returns the input of the VRF proof of a Praos header.
+func praosInput(slot uint64, epochNonce []byte) []byte {
+	return slotInput(slot, epochNonce).Bytes()
+}
+
+// tpraosSeed returns the input of a VRF proof of a TPraos header, the slot
+// input xored with the seed of the proof.
+func tpraosSeed(seed []byte, slot uint64, epochNonce []byte) []byte {
+	out := slotInput(slot, epochNonce).Bytes()
+	for i := range out {
+		out[i] ^= seed[i]
+	}
+	return out
+}
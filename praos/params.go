@@ -0,0 +1,61 @@
+package praos
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/blinklabs-io/gouroboros/ledger/common"
+	"github.com/blinklabs-io/gouroboros/ledger/shelley"
+)
+
+// Params are the consensus parameters of a network, from its Shelley
+// genesis.
+type Params struct {
+	SlotsPerKESPeriod uint64
+	MaxKESEvolutions  uint64
+
+	// Slots before the end of an epoch from which the blocks no longer
+	// contribute to the nonce of the next epoch: 3k/f, 4k/f from Conway.
+	StabilityWindow           uint64
+	RandomnessStabilityWindow uint64
+
+	// The nonce of the first Shelley epoch, the hash of the Shelley genesis.
+	InitialNonce []byte
+}
+
+// LoadParams reads the Shelley genesis file of a network.
+func LoadParams(shelleyPath string) (*Params, error) {
+	data, err := os.ReadFile(shelleyPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read Shelley genesis %s: %w", shelleyPath, err)
+	}
+	genesis, err := shelley.NewShelleyGenesisFromReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read Shelley genesis %s: %w", shelleyPath, err)
+	}
+	f := genesis.ActiveSlotsCoeff.Rat
+	if f == nil || f.Sign() <= 0 || genesis.SecurityParam <= 0 || genesis.SlotsPerKESPeriod <= 0 || genesis.MaxKESEvolutions <= 0 {
+		return nil, fmt.Errorf("invalid Shelley genesis %s: missing consensus parameters", shelleyPath)
+	}
+
+	k := uint64(genesis.SecurityParam)
+	return &Params{
+		SlotsPerKESPeriod:         uint64(genesis.SlotsPerKESPeriod),
+		MaxKESEvolutions:          uint64(genesis.MaxKESEvolutions),
+		StabilityWindow:           window(3*k, f),
+		RandomnessStabilityWindow: window(4*k, f),
+		InitialNonce:              common.Blake2b256Hash(data).Bytes(),
+	}, nil
+}
+
+// window returns ⌈n/f⌉.
+func window(n uint64, f *big.Rat) uint64 {
+	w := new(big.Rat).Quo(new(big.Rat).SetInt64(int64(n)), f)
+	q, r := new(big.Int).QuoRem(w.Num(), w.Denom(), new(big.Int))
+	if r.Sign() != 0 {
+		q.Add(q, big.NewInt(1))
+	}
+	return q.Uint64()
+}
@@ -0,0 +1,263 @@
+// Package praos validates the headers of the blocks the fetcher follows
+// against the Praos consensus rules, for ingestion from untrusted peers.
+//
+// For each Shelley and later header, the validator checks the operational
+// certificate, signed by the cold key of the issuer, for the KES period of
+// the slot, and its counter against the last one seen for the issuer; the
+// KES signature of the header body; and the VRF proofs against the epoch
+// nonce, the TPraos nonce and leader proofs up to Alonzo, the Praos one
+// from Babbage. Without the ledger state, the VRF key registered for the
+// pool and the leader threshold of its stake are not checked.
+//
+// The epoch nonce is derived from the stream: every block contributes its
+// VRF output to the evolving nonce, frozen into the candidate nonce from the
+// stability window before the end of the epoch, which the next epoch nonce
+// is made of along with the hash of the last block of the previous epoch
+// and the extra entropy of the protocol parameters. The nonces start from
+// the hash of the Shelley genesis, so VRF proofs are only checked when the
+// validator follows the chain from the first Shelley epoch. Up to Alonzo,
+// they are also skipped for the epochs whose first block comes without the
+// parameters in force, their extra entropy being unknown.
+//
+// Like the epoch tracker, the validator keeps the undo records of its last
+// security parameter blocks so that chain-sync rollbacks can be reverted.
+package praos
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"slices"
+
+	"github.com/blinklabs-io/gouroboros/ledger"
+	"github.com/blinklabs-io/gouroboros/protocol/common"
+	"github.com/fxamacker/cbor/v2"
+	"github.com/no-witness-labs/firehose-cardano/era"
+	"github.com/no-witness-labs/firehose-cardano/internal/undolog"
+	pbcardano "github.com/no-witness-labs/firehose-cardano/types/pb/sf/cardano/type/v1"
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	metaBucket     = []byte("meta")
+	countersBucket = []byte("counters")
+
+	stateKey = []byte("state")
+)
+
+// ErrRollbackTooDeep is returned when a rollback goes past the retained undo
+// records. The validator is then unusable and has to be rebuilt.
+var ErrRollbackTooDeep = undolog.ErrRollbackTooDeep
+
+// Validator is a persistent Praos header validator.
+type Validator struct {
+	db         *bolt.DB
+	history    *era.History
+	params     *Params
+	log        *undolog.Log
+	firstEpoch uint64 // First Shelley epoch
+}
+
+// state holds the nonces of the tip.
+type state struct {
+	Epoch               uint64 `cbor:"0,keyasint"`
+	EpochNonce          []byte `cbor:"1,keyasint"`
+	EvolvingNonce       []byte `cbor:"2,keyasint"`
+	CandidateNonce      []byte `cbor:"3,keyasint"`
+	LabNonce            []byte `cbor:"4,keyasint"` // Hash of the block before the tip
+	LastEpochBlockNonce []byte `cbor:"5,keyasint"`
+	NoncesUnknown       bool   `cbor:"6,keyasint"` // The validator started after the first Shelley epoch
+	EntropyUnknown      bool   `cbor:"7,keyasint"` // The extra entropy of the epoch nonce was not given
+}
+
+// entropyEras are the eras whose protocol parameters carry an extra entropy.
+var entropyEras = []string{"shelley", "allegra", "mary", "alonzo"}
+
+// undoRecord holds what a block changed.
+type undoRecord struct {
+	State   []byte  `cbor:"0,keyasint"` // State before the block, nil for the first block
+	Issuer  []byte  `cbor:"1,keyasint"`
+	Counter *uint64 `cbor:"2,keyasint"` // Counter of the issuer before the block, nil when unseen
+}
+
+// Open opens, or creates, the validator database at path.
+func Open(path string, history *era.History, params *Params) (*Validator, error) {
+	db, err := bolt.Open(path, 0600, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open Praos validator %s: %w", path, err)
+	}
+
+	log := undolog.New("Praos validator", func(slot uint64) uint64 { return history.EraAt(slot).SecurityParam })
+	err = db.Update(func(tx *bolt.Tx) error {
+		if _, err := tx.CreateBucketIfNotExists(countersBucket); err != nil {
+			return err
+		}
+		return log.Init(tx)
+	})
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to initialize Praos validator %s: %w", path, err)
+	}
+
+	v := &Validator{db: db, history: history, params: params, log: log}
+	for _, e := range history.Eras {
+		if e.Name != "byron" {
+			v.firstEpoch = e.StartEpoch
+			break
+		}
+	}
+	return v, nil
+}
+
+func (v *Validator) Close() error {
+	return v.db.Close()
+}
+
+// Tip returns the point of the last applied block, nil for an empty
+// validator.
+func (v *Validator) Tip() (*common.Point, error) {
+	var point *common.Point
+	err := v.db.View(func(tx *bolt.Tx) error {
+		var err error
+		point, err = v.log.Tip(tx)
+		return err
+	})
+	return point, err
+}
+
+// ApplyBlock validates the header of block and applies it to the nonces
+// and counters. params are the protocol parameters the block carries, on
+// the first block of an epoch, for their extra entropy. A *HeaderError is
+// returned for an invalid header, the block being applied all the same.
+// Byron blocks are ignored.
+func (v *Validator) ApplyBlock(block ledger.Block, params *pbcardano.PParams) error {
+	h, err := headerOf(block.Header())
+	if err != nil || h == nil {
+		return err
+	}
+
+	var reasons []string
+	err = v.db.Update(func(tx *bolt.Tx) error {
+		tip, err := v.log.Tip(tx)
+		if err != nil {
+			return err
+		}
+		if tip != nil && h.slot < tip.Slot {
+			return fmt.Errorf("block at slot %d does not extend Praos validator tip at slot %d", h.slot, tip.Slot)
+		}
+		undo := &undoRecord{Issuer: h.issuer()}
+
+		meta := tx.Bucket(metaBucket)
+		epoch := v.history.SlotToEpoch(h.slot)
+		st := &state{}
+		if tip == nil {
+			st = &state{
+				Epoch:          epoch,
+				EpochNonce:     v.params.InitialNonce,
+				EvolvingNonce:  v.params.InitialNonce,
+				CandidateNonce: v.params.InitialNonce,
+				NoncesUnknown:  epoch != v.firstEpoch,
+			}
+		} else {
+			undo.State = bytes.Clone(meta.Get(stateKey))
+			if err := cbor.Unmarshal(undo.State, st); err != nil {
+				return fmt.Errorf("failed to decode Praos state: %w", err)
+			}
+
+			switch {
+			case epoch > st.Epoch:
+				st.EpochNonce = combine(combine(st.CandidateNonce, st.LastEpochBlockNonce), params.GetExtraEntropy())
+				st.EntropyUnknown = params == nil && slices.Contains(entropyEras, v.history.EraOfEpoch(epoch).Name)
+				st.LastEpochBlockNonce = st.LabNonce
+				st.Epoch = epoch
+			case epoch < st.Epoch:
+				return fmt.Errorf("block at slot %d is in epoch %d, before epoch %d of the Praos validator tip", h.slot, epoch, st.Epoch)
+			}
+		}
+
+		counters := tx.Bucket(countersBucket)
+		if data := counters.Get(undo.Issuer); data != nil {
+			counter := readUint64(data)
+			undo.Counter = &counter
+		}
+		epochNonce := st.EpochNonce
+		if st.NoncesUnknown || st.EntropyUnknown {
+			epochNonce = nil
+		}
+		reasons = h.check(v.params, undo.Counter, epochNonce)
+
+		window := v.params.StabilityWindow
+		if h.conway {
+			window = v.params.RandomnessStabilityWindow
+		}
+		st.EvolvingNonce = combine(st.EvolvingNonce, h.nonce())
+		if h.slot+window < v.history.EpochFirstSlot(epoch+1) {
+			st.CandidateNonce = st.EvolvingNonce
+		}
+		st.LabNonce = h.prevHash.Bytes()
+
+		if err := counters.Put(undo.Issuer, uint64Bytes(h.counter)); err != nil {
+			return err
+		}
+		data, err := cbor.Marshal(st)
+		if err != nil {
+			return fmt.Errorf("failed to encode Praos state: %w", err)
+		}
+		if err := meta.Put(stateKey, data); err != nil {
+			return err
+		}
+		data, err = cbor.Marshal(undo)
+		if err != nil {
+			return fmt.Errorf("failed to encode undo record: %w", err)
+		}
+		return v.log.Push(tx, common.NewPoint(h.slot, h.hash.Bytes()), data)
+	})
+	if err != nil {
+		return err
+	}
+	if len(reasons) > 0 {
+		return &HeaderError{Slot: h.slot, Hash: h.hash, Reasons: reasons}
+	}
+	return nil
+}
+
+// Rollback reverts the blocks applied after point.
+func (v *Validator) Rollback(point common.Point) error {
+	return v.db.Update(func(tx *bolt.Tx) error {
+		meta := tx.Bucket(metaBucket)
+		counters := tx.Bucket(countersBucket)
+		return v.log.Rollback(tx, point, func(data []byte) error {
+			record := &undoRecord{}
+			if err := cbor.Unmarshal(data, record); err != nil {
+				return fmt.Errorf("failed to decode undo record: %w", err)
+			}
+
+			var err error
+			if record.Counter == nil {
+				err = counters.Delete(record.Issuer)
+			} else {
+				err = counters.Put(record.Issuer, uint64Bytes(*record.Counter))
+			}
+			if err != nil {
+				return err
+			}
+
+			// Rolling back the first block followed empties the validator.
+			if record.State == nil {
+				return meta.Delete(stateKey)
+			}
+			return meta.Put(stateKey, record.State)
+		})
+	})
+}
+
+func uint64Bytes(v uint64) []byte {
+	return binary.BigEndian.AppendUint64(nil, v)
+}
+
+func readUint64(data []byte) uint64 {
+	if len(data) != 8 {
+		return 0
+	}
+	return binary.BigEndian.Uint64(data)
+}
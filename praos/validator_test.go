@@ -0,0 +1,155 @@
+package praos
+
+import (
+	"bytes"
+	"errors"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/blinklabs-io/gouroboros/protocol/common"
+	"github.com/fxamacker/cbor/v2"
+	"github.com/no-witness-labs/firehose-cardano/era"
+	"github.com/no-witness-labs/firehose-cardano/internal/nodetest"
+	pbcardano "github.com/no-witness-labs/firehose-cardano/types/pb/sf/cardano/type/v1"
+	bolt "go.etcd.io/bbolt"
+)
+
+// mainnetParams are the consensus parameters of the mainnet Shelley genesis.
+var mainnetParams = &Params{
+	SlotsPerKESPeriod:         129600,
+	MaxKESEvolutions:          62,
+	StabilityWindow:           129600,
+	RandomnessStabilityWindow: 172800,
+	InitialNonce:              bytes.Repeat([]byte{0x1a}, 32),
+}
+
+func openValidator(t *testing.T, history *era.History) *Validator {
+	t.Helper()
+	v, err := Open(filepath.Join(t.TempDir(), "praos.db"), history, mainnetParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { v.Close() })
+	return v
+}
+
+func mainnetHistory(t *testing.T) *era.History {
+	t.Helper()
+	history, err := era.ForNetwork("mainnet")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return history
+}
+
+func TestOperationalCertificate(t *testing.T) {
+	block := nodetest.ConwayBlock(t)
+	h, err := headerOf(block.Header())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Started past the first Shelley epoch, the nonces are unknown and only
+	// the KES signature and the operational certificate are checked.
+	v := openValidator(t, mainnetHistory(t))
+	if err := v.ApplyBlock(block, nil); err != nil {
+		t.Fatalf("genuine header: %v", err)
+	}
+
+	// The block again, after its issuer issued a newer certificate.
+	if err := v.Rollback(common.NewPoint(h.slot-1, nil)); err != nil {
+		t.Fatal(err)
+	}
+	if tip, err := v.Tip(); err != nil || tip != nil {
+		t.Fatalf("tip %v after rolling back the first block, %v", tip, err)
+	}
+	err = v.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(countersBucket).Put(h.issuer(), uint64Bytes(h.counter+2))
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = v.ApplyBlock(block, nil)
+	var invalid *HeaderError
+	if !errors.As(err, &invalid) || len(invalid.Reasons) != 1 || !strings.Contains(invalid.Reasons[0], "counter") {
+		t.Fatalf("stale counter: %v, expected a counter error only", err)
+	}
+
+	// Rolling the block back restores the counter it replaced.
+	if err := v.Rollback(common.NewPoint(h.slot-1, nil)); err != nil {
+		t.Fatal(err)
+	}
+	err = v.db.View(func(tx *bolt.Tx) error {
+		if counter := readUint64(tx.Bucket(countersBucket).Get(h.issuer())); counter != h.counter+2 {
+			t.Errorf("counter %d after rollback, expected %d", counter, h.counter+2)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestEpochBoundaryEntropy(t *testing.T) {
+	block := nodetest.ConwayBlock(t)
+	h, err := headerOf(block.Header())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		name    string
+		era     string
+		params  *pbcardano.PParams
+		checked bool // VRF proofs are checked
+	}{
+		{name: "alonzo without parameters", era: "alonzo"},
+		{name: "alonzo with parameters", era: "alonzo", params: &pbcardano.PParams{ExtraEntropy: []byte{0x01}}, checked: true},
+		{name: "babbage without parameters", era: "babbage", checked: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			// A single era from the first Shelley epoch on, named after the
+			// case, with the mainnet epoch layout.
+			history := &era.History{Network: "test", Eras: []era.Era{
+				{Name: tc.era, SlotLength: 1000, EpochLength: 432000, SecurityParam: 2160},
+			}}
+			v := openValidator(t, history)
+
+			// The validator followed the chain up to the previous epoch. The
+			// nonces are made up, the VRF proofs of the block cannot match
+			// them.
+			epoch := history.SlotToEpoch(h.slot)
+			st := &state{
+				Epoch:          epoch - 1,
+				EpochNonce:     mainnetParams.InitialNonce,
+				EvolvingNonce:  mainnetParams.InitialNonce,
+				CandidateNonce: mainnetParams.InitialNonce,
+			}
+			err := v.db.Update(func(tx *bolt.Tx) error {
+				data, err := cbor.Marshal(st)
+				if err != nil {
+					return err
+				}
+				if err := tx.Bucket(metaBucket).Put(stateKey, data); err != nil {
+					return err
+				}
+				return v.log.Push(tx, common.NewPoint(history.EpochFirstSlot(epoch-1), []byte{0x01}), nil)
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = v.ApplyBlock(block, tc.params)
+			var invalid *HeaderError
+			if errors.As(err, &invalid) {
+				if !tc.checked || !slices.Contains(invalid.Reasons, "invalid VRF proof") {
+					t.Fatalf("unexpected header error: %v", err)
+				}
+			} else if err != nil || tc.checked {
+				t.Fatalf("%v, expected the VRF proof to be checked: %t", err, tc.checked)
+			}
+		})
+	}
+}
@@ -0,0 +1,301 @@
+// Package submit forwards signed transactions to a node.
+//
+// Over a node-to-client socket, transactions go through LocalTxSubmission:
+// the node validates them against its ledger and either adds them to its
+// mempool or rejects them, the reasons of a rejection are mapped to a
+// Rejection. Over node-to-node, transactions are announced to the peer
+// through TxSubmission, which has no rejection: a transaction is accepted
+// once the peer fetched it, or acknowledged it without fetching it when it
+// already had it, and an invalid one is silently dropped.
+//
+// Submitted transactions can be tracked until a block of the reader node
+// includes them.
+package submit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	ouroboros "github.com/blinklabs-io/gouroboros"
+	"github.com/blinklabs-io/gouroboros/ledger"
+	"github.com/blinklabs-io/gouroboros/protocol/localtxsubmission"
+	"github.com/blinklabs-io/gouroboros/protocol/txsubmission"
+	"go.uber.org/zap"
+)
+
+// ErrUnavailable is returned when the node cannot be reached. The connection
+// is dialed again on the next submission.
+var ErrUnavailable = errors.New("node unavailable")
+
+// Config locates the node transactions are submitted to. SocketPath takes
+// precedence over Address.
+type Config struct {
+	Address      string // host:port of a node-to-node peer
+	SocketPath   string // Node-to-client socket of a local node
+	NetworkMagic uint32
+}
+
+// Node submits transactions to a node, connecting on the first submission.
+type Node struct {
+	cfg    Config
+	logger *zap.Logger
+
+	mu    sync.Mutex
+	conn  *ouroboros.Connection
+	queue *txQueue // Node-to-node connections only
+}
+
+func NewNode(cfg Config, logger *zap.Logger) *Node {
+	return &Node{cfg: cfg, logger: logger}
+}
+
+// Submit forwards tx to the node. It returns a *RejectedError when the node
+// rejects it.
+func (n *Node) Submit(ctx context.Context, tx ledger.Transaction) error {
+	if n.cfg.SocketPath != "" {
+		return n.submitLocal(ctx, tx)
+	}
+	return n.submitPeer(ctx, tx)
+}
+
+func (n *Node) submitLocal(ctx context.Context, tx ledger.Transaction) error {
+	n.mu.Lock()
+	conn, err := n.connect()
+	n.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	// The round trip goes on once ctx is done: a failure still drops the
+	// connection.
+	result := make(chan error, 1)
+	go func() {
+		err := conn.LocalTxSubmission().Client.SubmitTx(uint16(tx.Type()), tx.Cbor())
+		var rejected localtxsubmission.TransactionRejectedError
+		if err != nil && !errors.As(err, &rejected) {
+			n.disconnect(conn)
+		}
+		result <- err
+	}()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case err := <-result:
+		var rejected localtxsubmission.TransactionRejectedError
+		switch {
+		case err == nil:
+			return nil
+		case errors.As(err, &rejected):
+			return newRejectedError(rejected)
+		default:
+			return fmt.Errorf("%w: %w", ErrUnavailable, err)
+		}
+	}
+}
+
+func (n *Node) submitPeer(ctx context.Context, tx ledger.Transaction) error {
+	n.mu.Lock()
+	_, err := n.connect()
+	queue := n.queue
+	n.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	pending := &pendingTx{
+		id: txsubmission.TxId{
+			EraId: uint16(tx.Type()),
+			TxId:  tx.Hash(),
+		},
+		body: tx.Cbor(),
+		done: make(chan struct{}),
+	}
+	queue.push(pending)
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-queue.closed:
+		return fmt.Errorf("%w: connection closed before the peer fetched the transaction", ErrUnavailable)
+	case <-pending.done:
+		return nil
+	}
+}
+
+// connect returns the current connection, dialing a new one when there is
+// none. n.mu must be held.
+func (n *Node) connect() (*ouroboros.Connection, error) {
+	if n.conn != nil {
+		return n.conn, nil
+	}
+
+	protocol, address, isN2N := "unix", n.cfg.SocketPath, false
+	if address == "" {
+		protocol, address, isN2N = "tcp", n.cfg.Address, true
+	}
+	errorChan := make(chan error, 10)
+	opts := []ouroboros.ConnectionOptionFunc{
+		ouroboros.WithNetworkMagic(n.cfg.NetworkMagic),
+		ouroboros.WithErrorChan(errorChan),
+		ouroboros.WithNodeToNode(isN2N),
+		ouroboros.WithKeepAlive(true),
+	}
+	var queue *txQueue
+	if isN2N {
+		queue = newTxQueue()
+		opts = append(opts, ouroboros.WithTxSubmissionConfig(txsubmission.NewConfig(
+			txsubmission.WithRequestTxIdsFunc(queue.requestTxIds),
+			txsubmission.WithRequestTxsFunc(queue.requestTxs),
+		)))
+	}
+
+	conn, err := ouroboros.NewConnection(opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create connection: %w", err)
+	}
+	if err := conn.Dial(protocol, address); err != nil {
+		return nil, fmt.Errorf("%w: failed to dial [%s] %s: %w", ErrUnavailable, protocol, address, err)
+	}
+	if isN2N {
+		conn.TxSubmission().Client.Init()
+	}
+	n.logger.Info("connected to node for submission", zap.String("protocol", protocol), zap.String("address", address))
+
+	go func() {
+		for err := range errorChan {
+			n.logger.Warn("submission connection error", zap.Error(err))
+			n.disconnect(conn)
+		}
+	}()
+
+	n.conn, n.queue = conn, queue
+	return conn, nil
+}
+
+// disconnect closes conn if it is still the current connection. Closing
+// waits for the connection goroutines, which may be reporting an error to a
+// handler taking n.mu: it is done once n.mu is released.
+func (n *Node) disconnect(conn *ouroboros.Connection) {
+	n.mu.Lock()
+	if n.conn != conn {
+		n.mu.Unlock()
+		return
+	}
+	if n.queue != nil {
+		n.queue.close()
+	}
+	n.conn, n.queue = nil, nil
+	n.mu.Unlock()
+
+	conn.Close()
+}
+
+func (n *Node) Close() error {
+	n.mu.Lock()
+	conn := n.conn
+	n.mu.Unlock()
+	if conn != nil {
+		n.disconnect(conn)
+	}
+	return nil
+}
+
+// pendingTx is a transaction waiting for the peer to fetch it.
+type pendingTx struct {
+	id   txsubmission.TxId
+	body []byte
+	done chan struct{}
+	once sync.Once
+}
+
+func (p *pendingTx) finish() {
+	p.once.Do(func() { close(p.done) })
+}
+
+// txQueue serves the requests of a TxSubmission peer: transaction ids are
+// announced in submission order and acknowledged by the peer in the same
+// order.
+type txQueue struct {
+	mu        sync.Mutex
+	waiting   []*pendingTx // Not announced yet
+	announced []*pendingTx // Announced, not acknowledged yet
+	wake      chan struct{}
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+func newTxQueue() *txQueue {
+	return &txQueue{
+		wake:   make(chan struct{}, 1),
+		closed: make(chan struct{}),
+	}
+}
+
+func (q *txQueue) push(p *pendingTx) {
+	q.mu.Lock()
+	q.waiting = append(q.waiting, p)
+	q.mu.Unlock()
+
+	select {
+	case q.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (q *txQueue) close() {
+	q.closeOnce.Do(func() { close(q.closed) })
+}
+
+func (q *txQueue) requestTxIds(_ txsubmission.CallbackContext, blocking bool, ack, req uint16) ([]txsubmission.TxIdAndSize, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	// Acknowledged transactions are accepted, fetched or already known.
+	acked := min(int(ack), len(q.announced))
+	for _, p := range q.announced[:acked] {
+		p.finish()
+	}
+	q.announced = q.announced[acked:]
+
+	// A blocking request waits for a transaction to announce.
+	for blocking && len(q.waiting) == 0 {
+		q.mu.Unlock()
+		select {
+		case <-q.wake:
+		case <-q.closed:
+			q.mu.Lock()
+			return nil, txsubmission.ErrStopServerProcess
+		}
+		q.mu.Lock()
+	}
+
+	count := min(int(req), len(q.waiting))
+	ids := make([]txsubmission.TxIdAndSize, 0, count)
+	for _, p := range q.waiting[:count] {
+		ids = append(ids, txsubmission.TxIdAndSize{TxId: p.id, Size: uint32(len(p.body))})
+	}
+	q.announced = append(q.announced, q.waiting[:count]...)
+	q.waiting = slices.Clone(q.waiting[count:])
+	return ids, nil
+}
+
+func (q *txQueue) requestTxs(_ txsubmission.CallbackContext, ids []txsubmission.TxId) ([]txsubmission.TxBody, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	bodies := make([]txsubmission.TxBody, 0, len(ids))
+	for _, id := range ids {
+		i := slices.IndexFunc(q.announced, func(p *pendingTx) bool { return p.id.TxId == id.TxId })
+		if i < 0 {
+			return nil, fmt.Errorf("peer requested unannounced transaction %x", id.TxId)
+		}
+		p := q.announced[i]
+		bodies = append(bodies, txsubmission.TxBody{EraId: p.id.EraId, TxBody: p.body})
+		p.finish()
+	}
+	return bodies, nil
+}
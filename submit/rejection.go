@@ -0,0 +1,195 @@
+package submit
+
+import (
+	"github.com/blinklabs-io/gouroboros/cbor"
+	"github.com/blinklabs-io/gouroboros/ledger"
+	"github.com/blinklabs-io/gouroboros/protocol/localtxsubmission"
+	pbsubmit "github.com/no-witness-labs/firehose-cardano/types/pb/sf/cardano/submit/v1"
+)
+
+// RejectedError is returned when the node rejects a transaction.
+type RejectedError struct {
+	Rejection *pbsubmit.Rejection
+	err       localtxsubmission.TransactionRejectedError
+}
+
+func (e *RejectedError) Error() string {
+	return "transaction rejected: " + e.err.Error()
+}
+
+func newRejectedError(err localtxsubmission.TransactionRejectedError) *RejectedError {
+	return &RejectedError{
+		Rejection: &pbsubmit.Rejection{
+			Failures:   failures(err.Reason, err.ReasonCbor),
+			ReasonCbor: err.ReasonCbor,
+		},
+		err: err,
+	}
+}
+
+// failures flattens the failures of a rejection reason as gouroboros
+// decodes it. gouroboros only knows the pre-Conway layout of the failures,
+// the UTxO failures of Conway are mapped from reasonCbor. Other failures are
+// kept as unspecified ones.
+func failures(reason error, reasonCbor []byte) []*pbsubmit.Failure {
+	if reason == nil {
+		return []*pbsubmit.Failure{{Reason: pbsubmit.Failure_REASON_UNSPECIFIED, Message: "undecodable rejection"}}
+	}
+	validation, ok := reason.(*ledger.ShelleyTxValidationError)
+	if !ok {
+		return []*pbsubmit.Failure{failure(reason)}
+	}
+	var conway []pbsubmit.Failure_Reason
+	if validation.Era >= ledger.EraIdConway {
+		conway = conwayReasons(reasonCbor)
+	}
+	out := make([]*pbsubmit.Failure, 0, len(validation.Err.Failures))
+	for i, f := range validation.Err.Failures {
+		out = append(out, failure(f))
+		if i < len(conway) {
+			out[i].Reason = conway[i]
+		}
+	}
+	return out
+}
+
+func failure(err error) *pbsubmit.Failure {
+	cause := err
+	if utxow, ok := cause.(*ledger.UtxowFailure); ok {
+		cause = utxow.Err
+	}
+	if utxo, ok := cause.(*ledger.UtxoFailure); ok {
+		cause = utxo.Err
+	}
+	return &pbsubmit.Failure{Reason: failureReason(cause), Message: err.Error()}
+}
+
+func failureReason(err error) pbsubmit.Failure_Reason {
+	switch err.(type) {
+	case *ledger.EraMismatch:
+		return pbsubmit.Failure_REASON_ERA_MISMATCH
+	case *ledger.BadInputsUtxo:
+		return pbsubmit.Failure_REASON_BAD_INPUTS
+	case *ledger.OutsideValidityIntervalUtxo:
+		return pbsubmit.Failure_REASON_OUTSIDE_VALIDITY_INTERVAL
+	case *ledger.MaxTxSizeUtxo:
+		return pbsubmit.Failure_REASON_MAX_TX_SIZE
+	case *ledger.InputSetEmptyUtxo:
+		return pbsubmit.Failure_REASON_INPUT_SET_EMPTY
+	case *ledger.FeeTooSmallUtxo:
+		return pbsubmit.Failure_REASON_FEE_TOO_SMALL
+	case *ledger.ValueNotConservedUtxo:
+		return pbsubmit.Failure_REASON_VALUE_NOT_CONSERVED
+	case *ledger.OutputTooSmallUtxo:
+		return pbsubmit.Failure_REASON_OUTPUT_TOO_SMALL
+	case *ledger.UtxosFailure:
+		return pbsubmit.Failure_REASON_SCRIPT_FAILURE
+	case *ledger.WrongNetwork:
+		return pbsubmit.Failure_REASON_WRONG_NETWORK
+	case *ledger.WrongNetworkWithdrawal:
+		return pbsubmit.Failure_REASON_WRONG_NETWORK_WITHDRAWAL
+	case *ledger.OutputBootAddrAttrsTooBig:
+		return pbsubmit.Failure_REASON_OUTPUT_BOOT_ADDR_ATTRS_TOO_BIG
+	case *ledger.TriesToForgeADA:
+		return pbsubmit.Failure_REASON_TRIES_TO_FORGE_ADA
+	case *ledger.OutputTooBigUtxo:
+		return pbsubmit.Failure_REASON_OUTPUT_TOO_BIG
+	case *ledger.InsufficientCollateral:
+		return pbsubmit.Failure_REASON_INSUFFICIENT_COLLATERAL
+	case *ledger.ScriptsNotPaidUtxo:
+		return pbsubmit.Failure_REASON_SCRIPTS_NOT_PAID
+	case *ledger.ExUnitsTooBigUtxo:
+		return pbsubmit.Failure_REASON_EX_UNITS_TOO_BIG
+	case *ledger.CollateralContainsNonADA:
+		return pbsubmit.Failure_REASON_COLLATERAL_CONTAINS_NON_ADA
+	case *ledger.WrongNetworkInTxBody:
+		return pbsubmit.Failure_REASON_WRONG_NETWORK_IN_TX_BODY
+	case *ledger.OutsideForecast:
+		return pbsubmit.Failure_REASON_OUTSIDE_FORECAST
+	case *ledger.TooManyCollateralInputs:
+		return pbsubmit.Failure_REASON_TOO_MANY_COLLATERAL_INPUTS
+	case *ledger.NoCollateralInputs:
+		return pbsubmit.Failure_REASON_NO_COLLATERAL_INPUTS
+	default:
+		return pbsubmit.Failure_REASON_UNSPECIFIED
+	}
+}
+
+// Tags of the Conway ledger failures, ConwayLedgerPredFailure wrapping a
+// ConwayUtxowPredFailure wrapping a ConwayUtxoPredFailure.
+const (
+	conwayLedgerUtxowFailure = 1
+	conwayUtxowUtxoFailure   = 0
+)
+
+// conwayUtxoReasons maps the tags of ConwayUtxoPredFailure.
+var conwayUtxoReasons = map[uint64]pbsubmit.Failure_Reason{
+	0:  pbsubmit.Failure_REASON_SCRIPT_FAILURE,
+	1:  pbsubmit.Failure_REASON_BAD_INPUTS,
+	2:  pbsubmit.Failure_REASON_OUTSIDE_VALIDITY_INTERVAL,
+	3:  pbsubmit.Failure_REASON_MAX_TX_SIZE,
+	4:  pbsubmit.Failure_REASON_INPUT_SET_EMPTY,
+	5:  pbsubmit.Failure_REASON_FEE_TOO_SMALL,
+	6:  pbsubmit.Failure_REASON_VALUE_NOT_CONSERVED,
+	7:  pbsubmit.Failure_REASON_WRONG_NETWORK,
+	8:  pbsubmit.Failure_REASON_WRONG_NETWORK_WITHDRAWAL,
+	9:  pbsubmit.Failure_REASON_OUTPUT_TOO_SMALL,
+	10: pbsubmit.Failure_REASON_OUTPUT_BOOT_ADDR_ATTRS_TOO_BIG,
+	11: pbsubmit.Failure_REASON_OUTPUT_TOO_BIG,
+	12: pbsubmit.Failure_REASON_INSUFFICIENT_COLLATERAL,
+	13: pbsubmit.Failure_REASON_SCRIPTS_NOT_PAID,
+	14: pbsubmit.Failure_REASON_EX_UNITS_TOO_BIG,
+	15: pbsubmit.Failure_REASON_COLLATERAL_CONTAINS_NON_ADA,
+	16: pbsubmit.Failure_REASON_WRONG_NETWORK_IN_TX_BODY,
+	17: pbsubmit.Failure_REASON_OUTSIDE_FORECAST,
+	18: pbsubmit.Failure_REASON_TOO_MANY_COLLATERAL_INPUTS,
+	19: pbsubmit.Failure_REASON_NO_COLLATERAL_INPUTS,
+	21: pbsubmit.Failure_REASON_OUTPUT_TOO_SMALL, // BabbageOutputTooSmallUTxO
+}
+
+// conwayReasons maps the failures of a Conway rejection, in order. The
+// rejection is [[era, [failure...]]].
+func conwayReasons(reasonCbor []byte) []pbsubmit.Failure_Reason {
+	var reason []any
+	if _, err := cbor.Decode(reasonCbor, &reason); err != nil || len(reason) != 1 {
+		return nil
+	}
+	validation, ok := reason[0].([]any)
+	if !ok || len(validation) != 2 {
+		return nil
+	}
+	failures, ok := validation[1].([]any)
+	if !ok {
+		return nil
+	}
+
+	out := make([]pbsubmit.Failure_Reason, len(failures))
+	for i, f := range failures {
+		utxow, ok := taggedFailure(f, conwayLedgerUtxowFailure)
+		if !ok {
+			continue
+		}
+		utxo, ok := taggedFailure(utxow, conwayUtxowUtxoFailure)
+		if !ok {
+			continue
+		}
+		if fields, ok := utxo.([]any); ok && len(fields) > 0 {
+			if tag, ok := fields[0].(uint64); ok {
+				out[i] = conwayUtxoReasons[tag]
+			}
+		}
+	}
+	return out
+}
+
+// taggedFailure returns the failure wrapped in a [tag, failure] sum.
+func taggedFailure(v any, tag uint64) (any, bool) {
+	fields, ok := v.([]any)
+	if !ok || len(fields) != 2 {
+		return nil, false
+	}
+	if t, ok := fields[0].(uint64); !ok || t != tag {
+		return nil, false
+	}
+	return fields[1], true
+}
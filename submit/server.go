@@ -0,0 +1,68 @@
+package submit
+
+import (
+	"context"
+	"errors"
+
+	"github.com/no-witness-labs/firehose-cardano/convert"
+	pbsubmit "github.com/no-witness-labs/firehose-cardano/types/pb/sf/cardano/submit/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Server implements the sf.cardano.submit.v1.Submit service.
+type Server struct {
+	pbsubmit.UnimplementedSubmitServer
+	node    *Node
+	tracker *Tracker
+}
+
+func NewServer(node *Node, tracker *Tracker) *Server {
+	return &Server{node: node, tracker: tracker}
+}
+
+func (s *Server) SubmitTx(ctx context.Context, req *pbsubmit.SubmitTxRequest) (*pbsubmit.SubmitTxResponse, error) {
+	tx, err := convert.DecodeTx(req.Tx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	hash := tx.Hash().Bytes()
+
+	// Tracking starts first, the transaction may be included before the
+	// node answers.
+	if req.Track {
+		s.tracker.Track(hash)
+	}
+	if err := s.node.Submit(ctx, tx); err != nil {
+		if req.Track {
+			s.tracker.Untrack(hash)
+		}
+		var rejected *RejectedError
+		switch {
+		case errors.As(err, &rejected):
+			st, detailsErr := status.New(codes.FailedPrecondition, rejected.Error()).WithDetails(rejected.Rejection)
+			if detailsErr != nil {
+				return nil, status.Error(codes.FailedPrecondition, rejected.Error())
+			}
+			return nil, st.Err()
+		case errors.Is(err, ErrUnavailable):
+			return nil, status.Error(codes.Unavailable, err.Error())
+		case ctx.Err() != nil:
+			return nil, status.FromContextError(ctx.Err()).Err()
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+	return &pbsubmit.SubmitTxResponse{TxHash: hash}, nil
+}
+
+func (s *Server) WaitForTx(ctx context.Context, req *pbsubmit.WaitForTxRequest) (*pbsubmit.WaitForTxResponse, error) {
+	inclusion, err := s.tracker.Wait(ctx, req.TxHash)
+	switch {
+	case errors.Is(err, ErrNotTracked):
+		return nil, status.Errorf(codes.NotFound, "transaction %x is not tracked", req.TxHash)
+	case err != nil:
+		return nil, status.FromContextError(err).Err()
+	}
+	return inclusion, nil
+}
@@ -0,0 +1,120 @@
+package submit
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	pbsubmit "github.com/no-witness-labs/firehose-cardano/types/pb/sf/cardano/submit/v1"
+	pbcardano "github.com/no-witness-labs/firehose-cardano/types/pb/sf/cardano/type/v1"
+)
+
+// ErrNotTracked is returned when waiting for a transaction that was not
+// submitted with tracking, or whose tracking expired.
+var ErrNotTracked = errors.New("transaction is not tracked")
+
+// Tracker watches the blocks of the reader node for submitted transactions.
+type Tracker struct {
+	retention time.Duration
+
+	mu  sync.Mutex
+	txs map[string]*trackedTx
+}
+
+type trackedTx struct {
+	submitted time.Time
+	inclusion *pbsubmit.WaitForTxResponse // nil until included
+	included  chan struct{}               // Closed when included
+}
+
+// NewTracker returns a tracker forgetting transactions retention after
+// their submission.
+func NewTracker(retention time.Duration) *Tracker {
+	return &Tracker{retention: retention, txs: map[string]*trackedTx{}}
+}
+
+// Track starts watching the blocks for a transaction.
+func (t *Tracker) Track(hash []byte) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := time.Now()
+	for key, tx := range t.txs {
+		if now.Sub(tx.submitted) > t.retention {
+			delete(t.txs, key)
+		}
+	}
+	if _, ok := t.txs[string(hash)]; !ok {
+		t.txs[string(hash)] = &trackedTx{submitted: now, included: make(chan struct{})}
+	}
+}
+
+// Untrack stops watching the blocks for a transaction.
+func (t *Tracker) Untrack(hash []byte) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.txs, string(hash))
+}
+
+// Tracking tells whether any transaction is tracked, blocks need not be
+// decoded otherwise.
+func (t *Tracker) Tracking() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return len(t.txs) > 0
+}
+
+// ApplyBlock records the inclusion of the tracked transactions of block. A
+// block at or below the height of a recorded inclusion replaces the fork it
+// was on, such inclusions are undone.
+func (t *Tracker) ApplyBlock(block *pbcardano.Block) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	header := block.GetHeader()
+	for _, tx := range t.txs {
+		if tx.inclusion != nil && tx.inclusion.BlockHeight >= header.GetHeight() {
+			tx.inclusion, tx.included = nil, make(chan struct{})
+		}
+	}
+	for i, tx := range block.GetBody().GetTx() {
+		tracked, ok := t.txs[string(tx.Hash)]
+		if !ok || tracked.inclusion != nil {
+			continue
+		}
+		tracked.inclusion = &pbsubmit.WaitForTxResponse{
+			TxHash:      bytes.Clone(tx.Hash),
+			BlockHeight: header.GetHeight(),
+			BlockHash:   bytes.Clone(header.GetHash()),
+			BlockSlot:   header.GetSlot(),
+			TxIndex:     uint32(i),
+		}
+		close(tracked.included)
+	}
+}
+
+// Wait returns the inclusion of a tracked transaction, waiting for it until
+// ctx is done.
+func (t *Tracker) Wait(ctx context.Context, hash []byte) (*pbsubmit.WaitForTxResponse, error) {
+	for {
+		t.mu.Lock()
+		tracked, ok := t.txs[string(hash)]
+		if !ok {
+			t.mu.Unlock()
+			return nil, ErrNotTracked
+		}
+		inclusion, included := tracked.inclusion, tracked.included
+		t.mu.Unlock()
+		if inclusion != nil {
+			return inclusion, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-included:
+		}
+	}
+}
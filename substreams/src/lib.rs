@@ -21,17 +21,34 @@ fn map_blocks(block: Block) -> Result<Block, substreams::errors::Error> {
         if let Some(body) = &block.body {
             log::info!("Block contains {} transactions", body.tx.len());
             
-            // Process each transaction
+            // Process each transaction, following its ledger effect: a
+            // transaction whose scripts failed only spends its collateral and
+            // creates its collateral return.
             for (i, tx) in body.tx.iter().enumerate() {
-                log::info!("Transaction {}: {} inputs, {} outputs, fee: {} lovelace", 
+                let effect = tx.effect.as_ref();
+                let applied = effect.map_or(true, |e| e.body_applied);
+                log::info!("Transaction {}: {} inputs, {} outputs, fee: {}{}", 
                     i,
                     tx.inputs.len(),
                     tx.outputs.len(),
-                    tx.fee
+                    effect.map_or(Some(tx.fee), |e| e.fee)
+                        .map_or("unknown".to_string(), |fee| format!("{} lovelace", fee)),
+                    if tx.successful || effect.is_none() { "" } else { " (scripts failed)" }
                 );
                 
-                // Process outputs with ADA amounts
-                for (j, output) in tx.outputs.iter().enumerate() {
+                // Process created outputs with ADA amounts
+                let created: Vec<(u32, &pb::TxOutput)> = match effect {
+                    Some(effect) => effect.created.iter().filter_map(|r| {
+                        let output = if r.collateral_return {
+                            tx.collateral.as_ref().and_then(|c| c.collateral_return.as_ref())
+                        } else {
+                            tx.outputs.get(r.index as usize)
+                        };
+                        output.map(|o| (r.index, o))
+                    }).collect(),
+                    None => tx.outputs.iter().enumerate().map(|(j, o)| (j as u32, o)).collect(),
+                };
+                for (j, output) in created {
                     log::info!("  Output {}: {} lovelace", 
                         j,
                         output.coin
@@ -39,6 +56,9 @@ fn map_blocks(block: Block) -> Result<Block, substreams::errors::Error> {
                 }
                 
                 // Process minted/burned assets
+                if !applied {
+                    continue;
+                }
                 for mint_group in &tx.mint {
                     log::info!("  Mint/Burn Policy: {}", hex::encode(&mint_group.policy_id));
                     for asset in &mint_group.assets {
@@ -0,0 +1,51 @@
+// Package transform holds the Firehose block transforms of Cardano blocks.
+package transform
+
+import (
+	"fmt"
+
+	pbtransform "github.com/no-witness-labs/firehose-cardano/types/pb/sf/cardano/transform/v1"
+	pbcardano "github.com/no-witness-labs/firehose-cardano/types/pb/sf/cardano/type/v1"
+	pbbstream "github.com/streamingfast/bstream/pb/sf/bstream/v1"
+	"github.com/streamingfast/bstream/transform"
+	"github.com/streamingfast/dstore"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// StripRawCBORFactory registers the sf.cardano.transform.v1.StripRawCBOR
+// transform, which removes the original CBOR from blocks stored by fetchers
+// running with raw CBOR preservation.
+func StripRawCBORFactory(indexStore dstore.Store, indexPossibleSizes []uint64) (*transform.Factory, error) {
+	return &transform.Factory{
+		Obj: &pbtransform.StripRawCBOR{},
+		NewFunc: func(message *anypb.Any) (transform.Transform, error) {
+			filter := &pbtransform.StripRawCBOR{}
+			if err := message.UnmarshalTo(filter); err != nil {
+				return nil, fmt.Errorf("unexpected unmarshal error: %w", err)
+			}
+			return &StripRawCBOR{}, nil
+		},
+	}, nil
+}
+
+// StripRawCBOR is the transform removing the original CBOR from blocks.
+type StripRawCBOR struct{}
+
+func (t *StripRawCBOR) String() string {
+	return "strip raw CBOR"
+}
+
+func (t *StripRawCBOR) Transform(readOnlyBlk *pbbstream.Block, in transform.Input) (transform.Output, error) {
+	// A previous transform may already have produced the block, work on a
+	// copy of it rather than decoding the payload again.
+	block := &pbcardano.Block{}
+	if prev, ok := in.Obj().(*pbcardano.Block); ok {
+		block = proto.Clone(prev).(*pbcardano.Block)
+	} else if err := readOnlyBlk.Payload.UnmarshalTo(block); err != nil {
+		return nil, fmt.Errorf("unable to decode block %s: %w", readOnlyBlk.AsRef(), err)
+	}
+
+	block.StripRawCBOR()
+	return block, nil
+}
@@ -0,0 +1,257 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: sf/cardano/mempool/v1/mempool.proto
+
+package pbmempool
+
+import (
+	v1 "github.com/no-witness-labs/firehose-cardano/types/pb/sf/cardano/type/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Event_Type int32
+
+const (
+	Event_TYPE_UNSPECIFIED Event_Type = 0
+	Event_TYPE_SEEN        Event_Type = 1 // The transaction entered the mempool
+	Event_TYPE_DROPPED     Event_Type = 2 // The transaction left the mempool, included in a
+	// block or evicted
+	Event_TYPE_RESET Event_Type = 3 // The fetcher started monitoring and reports every
+)
+
+// Enum value maps for Event_Type.
+var (
+	Event_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "TYPE_SEEN",
+		2: "TYPE_DROPPED",
+		3: "TYPE_RESET",
+	}
+	Event_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"TYPE_SEEN":        1,
+		"TYPE_DROPPED":     2,
+		"TYPE_RESET":       3,
+	}
+)
+
+func (x Event_Type) Enum() *Event_Type {
+	p := new(Event_Type)
+	*p = x
+	return p
+}
+
+func (x Event_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Event_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_sf_cardano_mempool_v1_mempool_proto_enumTypes[0].Descriptor()
+}
+
+func (Event_Type) Type() protoreflect.EnumType {
+	return &file_sf_cardano_mempool_v1_mempool_proto_enumTypes[0]
+}
+
+func (x Event_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Event_Type.Descriptor instead.
+func (Event_Type) EnumDescriptor() ([]byte, []int) {
+	return file_sf_cardano_mempool_v1_mempool_proto_rawDescGZIP(), []int{1, 0}
+}
+
+type StreamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamRequest) Reset() {
+	*x = StreamRequest{}
+	mi := &file_sf_cardano_mempool_v1_mempool_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamRequest) ProtoMessage() {}
+
+func (x *StreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_mempool_v1_mempool_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamRequest.ProtoReflect.Descriptor instead.
+func (*StreamRequest) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_mempool_v1_mempool_proto_rawDescGZIP(), []int{0}
+}
+
+type Event struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          Event_Type             `protobuf:"varint,1,opt,name=type,proto3,enum=sf.cardano.mempool.v1.Event_Type" json:"type,omitempty"`
+	TxHash        []byte                 `protobuf:"bytes,2,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	Tx            *v1.Tx                 `protobuf:"bytes,3,opt,name=tx,proto3" json:"tx,omitempty"`                // SEEN events only
+	Timestamp     uint64                 `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // Unix ms time the mempool was polled
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	mi := &file_sf_cardano_mempool_v1_mempool_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_mempool_v1_mempool_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_mempool_v1_mempool_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Event) GetType() Event_Type {
+	if x != nil {
+		return x.Type
+	}
+	return Event_TYPE_UNSPECIFIED
+}
+
+func (x *Event) GetTxHash() []byte {
+	if x != nil {
+		return x.TxHash
+	}
+	return nil
+}
+
+func (x *Event) GetTx() *v1.Tx {
+	if x != nil {
+		return x.Tx
+	}
+	return nil
+}
+
+func (x *Event) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+var File_sf_cardano_mempool_v1_mempool_proto protoreflect.FileDescriptor
+
+const file_sf_cardano_mempool_v1_mempool_proto_rawDesc = "" +
+	"\n" +
+	"#sf/cardano/mempool/v1/mempool.proto\x12\x15sf.cardano.mempool.v1\x1a\x1dsf/cardano/type/v1/type.proto\"\x0f\n" +
+	"\rStreamRequest\"\xec\x01\n" +
+	"\x05Event\x125\n" +
+	"\x04type\x18\x01 \x01(\x0e2!.sf.cardano.mempool.v1.Event.TypeR\x04type\x12\x17\n" +
+	"\atx_hash\x18\x02 \x01(\fR\x06txHash\x12&\n" +
+	"\x02tx\x18\x03 \x01(\v2\x16.sf.cardano.type.v1.TxR\x02tx\x12\x1c\n" +
+	"\ttimestamp\x18\x04 \x01(\x04R\ttimestamp\"M\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\r\n" +
+	"\tTYPE_SEEN\x10\x01\x12\x10\n" +
+	"\fTYPE_DROPPED\x10\x02\x12\x0e\n" +
+	"\n" +
+	"TYPE_RESET\x10\x032Y\n" +
+	"\aMempool\x12N\n" +
+	"\x06Stream\x12$.sf.cardano.mempool.v1.StreamRequest\x1a\x1c.sf.cardano.mempool.v1.Event0\x01BVZTgithub.com/no-witness-labs/firehose-cardano/types/pb/sf/cardano/mempool/v1;pbmempoolb\x06proto3"
+
+var (
+	file_sf_cardano_mempool_v1_mempool_proto_rawDescOnce sync.Once
+	file_sf_cardano_mempool_v1_mempool_proto_rawDescData []byte
+)
+
+func file_sf_cardano_mempool_v1_mempool_proto_rawDescGZIP() []byte {
+	file_sf_cardano_mempool_v1_mempool_proto_rawDescOnce.Do(func() {
+		file_sf_cardano_mempool_v1_mempool_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_sf_cardano_mempool_v1_mempool_proto_rawDesc), len(file_sf_cardano_mempool_v1_mempool_proto_rawDesc)))
+	})
+	return file_sf_cardano_mempool_v1_mempool_proto_rawDescData
+}
+
+var file_sf_cardano_mempool_v1_mempool_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_sf_cardano_mempool_v1_mempool_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_sf_cardano_mempool_v1_mempool_proto_goTypes = []any{
+	(Event_Type)(0),       // 0: sf.cardano.mempool.v1.Event.Type
+	(*StreamRequest)(nil), // 1: sf.cardano.mempool.v1.StreamRequest
+	(*Event)(nil),         // 2: sf.cardano.mempool.v1.Event
+	(*v1.Tx)(nil),         // 3: sf.cardano.type.v1.Tx
+}
+var file_sf_cardano_mempool_v1_mempool_proto_depIdxs = []int32{
+	0, // 0: sf.cardano.mempool.v1.Event.type:type_name -> sf.cardano.mempool.v1.Event.Type
+	3, // 1: sf.cardano.mempool.v1.Event.tx:type_name -> sf.cardano.type.v1.Tx
+	1, // 2: sf.cardano.mempool.v1.Mempool.Stream:input_type -> sf.cardano.mempool.v1.StreamRequest
+	2, // 3: sf.cardano.mempool.v1.Mempool.Stream:output_type -> sf.cardano.mempool.v1.Event
+	3, // [3:4] is the sub-list for method output_type
+	2, // [2:3] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_sf_cardano_mempool_v1_mempool_proto_init() }
+func file_sf_cardano_mempool_v1_mempool_proto_init() {
+	if File_sf_cardano_mempool_v1_mempool_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_sf_cardano_mempool_v1_mempool_proto_rawDesc), len(file_sf_cardano_mempool_v1_mempool_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_sf_cardano_mempool_v1_mempool_proto_goTypes,
+		DependencyIndexes: file_sf_cardano_mempool_v1_mempool_proto_depIdxs,
+		EnumInfos:         file_sf_cardano_mempool_v1_mempool_proto_enumTypes,
+		MessageInfos:      file_sf_cardano_mempool_v1_mempool_proto_msgTypes,
+	}.Build()
+	File_sf_cardano_mempool_v1_mempool_proto = out.File
+	file_sf_cardano_mempool_v1_mempool_proto_goTypes = nil
+	file_sf_cardano_mempool_v1_mempool_proto_depIdxs = nil
+}
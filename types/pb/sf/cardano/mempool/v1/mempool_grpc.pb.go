@@ -0,0 +1,132 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: sf/cardano/mempool/v1/mempool.proto
+
+package pbmempool
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Mempool_Stream_FullMethodName = "/sf.cardano.mempool.v1.Mempool/Stream"
+)
+
+// MempoolClient is the client API for Mempool service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Streams the pending transactions of the node a fetcher monitors.
+type MempoolClient interface {
+	// Sends a SEEN event for each transaction pending when the stream starts,
+	// then the events as they happen.
+	Stream(ctx context.Context, in *StreamRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Event], error)
+}
+
+type mempoolClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMempoolClient(cc grpc.ClientConnInterface) MempoolClient {
+	return &mempoolClient{cc}
+}
+
+func (c *mempoolClient) Stream(ctx context.Context, in *StreamRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Event], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Mempool_ServiceDesc.Streams[0], Mempool_Stream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamRequest, Event]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Mempool_StreamClient = grpc.ServerStreamingClient[Event]
+
+// MempoolServer is the server API for Mempool service.
+// All implementations must embed UnimplementedMempoolServer
+// for forward compatibility.
+//
+// Streams the pending transactions of the node a fetcher monitors.
+type MempoolServer interface {
+	// Sends a SEEN event for each transaction pending when the stream starts,
+	// then the events as they happen.
+	Stream(*StreamRequest, grpc.ServerStreamingServer[Event]) error
+	mustEmbedUnimplementedMempoolServer()
+}
+
+// UnimplementedMempoolServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMempoolServer struct{}
+
+func (UnimplementedMempoolServer) Stream(*StreamRequest, grpc.ServerStreamingServer[Event]) error {
+	return status.Errorf(codes.Unimplemented, "method Stream not implemented")
+}
+func (UnimplementedMempoolServer) mustEmbedUnimplementedMempoolServer() {}
+func (UnimplementedMempoolServer) testEmbeddedByValue()                 {}
+
+// UnsafeMempoolServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MempoolServer will
+// result in compilation errors.
+type UnsafeMempoolServer interface {
+	mustEmbedUnimplementedMempoolServer()
+}
+
+func RegisterMempoolServer(s grpc.ServiceRegistrar, srv MempoolServer) {
+	// If the following call pancis, it indicates UnimplementedMempoolServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Mempool_ServiceDesc, srv)
+}
+
+func _Mempool_Stream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MempoolServer).Stream(m, &grpc.GenericServerStream[StreamRequest, Event]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Mempool_StreamServer = grpc.ServerStreamingServer[Event]
+
+// Mempool_ServiceDesc is the grpc.ServiceDesc for Mempool service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Mempool_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "sf.cardano.mempool.v1.Mempool",
+	HandlerType: (*MempoolServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Stream",
+			Handler:       _Mempool_Stream_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "sf/cardano/mempool/v1/mempool.proto",
+}
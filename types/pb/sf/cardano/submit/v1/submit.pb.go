@@ -0,0 +1,570 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: sf/cardano/submit/v1/submit.proto
+
+package pbsubmit
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Failure_Reason int32
+
+const (
+	Failure_REASON_UNSPECIFIED                    Failure_Reason = 0 // Not mapped, see the message
+	Failure_REASON_ERA_MISMATCH                   Failure_Reason = 1
+	Failure_REASON_BAD_INPUTS                     Failure_Reason = 2
+	Failure_REASON_OUTSIDE_VALIDITY_INTERVAL      Failure_Reason = 3
+	Failure_REASON_MAX_TX_SIZE                    Failure_Reason = 4
+	Failure_REASON_INPUT_SET_EMPTY                Failure_Reason = 5
+	Failure_REASON_FEE_TOO_SMALL                  Failure_Reason = 6
+	Failure_REASON_VALUE_NOT_CONSERVED            Failure_Reason = 7
+	Failure_REASON_OUTPUT_TOO_SMALL               Failure_Reason = 8
+	Failure_REASON_SCRIPT_FAILURE                 Failure_Reason = 9
+	Failure_REASON_WRONG_NETWORK                  Failure_Reason = 10
+	Failure_REASON_WRONG_NETWORK_WITHDRAWAL       Failure_Reason = 11
+	Failure_REASON_OUTPUT_BOOT_ADDR_ATTRS_TOO_BIG Failure_Reason = 12
+	Failure_REASON_TRIES_TO_FORGE_ADA             Failure_Reason = 13
+	Failure_REASON_OUTPUT_TOO_BIG                 Failure_Reason = 14
+	Failure_REASON_INSUFFICIENT_COLLATERAL        Failure_Reason = 15
+	Failure_REASON_SCRIPTS_NOT_PAID               Failure_Reason = 16
+	Failure_REASON_EX_UNITS_TOO_BIG               Failure_Reason = 17
+	Failure_REASON_COLLATERAL_CONTAINS_NON_ADA    Failure_Reason = 18
+	Failure_REASON_WRONG_NETWORK_IN_TX_BODY       Failure_Reason = 19
+	Failure_REASON_OUTSIDE_FORECAST               Failure_Reason = 20
+	Failure_REASON_TOO_MANY_COLLATERAL_INPUTS     Failure_Reason = 21
+	Failure_REASON_NO_COLLATERAL_INPUTS           Failure_Reason = 22
+)
+
+// Enum value maps for Failure_Reason.
+var (
+	Failure_Reason_name = map[int32]string{
+		0:  "REASON_UNSPECIFIED",
+		1:  "REASON_ERA_MISMATCH",
+		2:  "REASON_BAD_INPUTS",
+		3:  "REASON_OUTSIDE_VALIDITY_INTERVAL",
+		4:  "REASON_MAX_TX_SIZE",
+		5:  "REASON_INPUT_SET_EMPTY",
+		6:  "REASON_FEE_TOO_SMALL",
+		7:  "REASON_VALUE_NOT_CONSERVED",
+		8:  "REASON_OUTPUT_TOO_SMALL",
+		9:  "REASON_SCRIPT_FAILURE",
+		10: "REASON_WRONG_NETWORK",
+		11: "REASON_WRONG_NETWORK_WITHDRAWAL",
+		12: "REASON_OUTPUT_BOOT_ADDR_ATTRS_TOO_BIG",
+		13: "REASON_TRIES_TO_FORGE_ADA",
+		14: "REASON_OUTPUT_TOO_BIG",
+		15: "REASON_INSUFFICIENT_COLLATERAL",
+		16: "REASON_SCRIPTS_NOT_PAID",
+		17: "REASON_EX_UNITS_TOO_BIG",
+		18: "REASON_COLLATERAL_CONTAINS_NON_ADA",
+		19: "REASON_WRONG_NETWORK_IN_TX_BODY",
+		20: "REASON_OUTSIDE_FORECAST",
+		21: "REASON_TOO_MANY_COLLATERAL_INPUTS",
+		22: "REASON_NO_COLLATERAL_INPUTS",
+	}
+	Failure_Reason_value = map[string]int32{
+		"REASON_UNSPECIFIED":                    0,
+		"REASON_ERA_MISMATCH":                   1,
+		"REASON_BAD_INPUTS":                     2,
+		"REASON_OUTSIDE_VALIDITY_INTERVAL":      3,
+		"REASON_MAX_TX_SIZE":                    4,
+		"REASON_INPUT_SET_EMPTY":                5,
+		"REASON_FEE_TOO_SMALL":                  6,
+		"REASON_VALUE_NOT_CONSERVED":            7,
+		"REASON_OUTPUT_TOO_SMALL":               8,
+		"REASON_SCRIPT_FAILURE":                 9,
+		"REASON_WRONG_NETWORK":                  10,
+		"REASON_WRONG_NETWORK_WITHDRAWAL":       11,
+		"REASON_OUTPUT_BOOT_ADDR_ATTRS_TOO_BIG": 12,
+		"REASON_TRIES_TO_FORGE_ADA":             13,
+		"REASON_OUTPUT_TOO_BIG":                 14,
+		"REASON_INSUFFICIENT_COLLATERAL":        15,
+		"REASON_SCRIPTS_NOT_PAID":               16,
+		"REASON_EX_UNITS_TOO_BIG":               17,
+		"REASON_COLLATERAL_CONTAINS_NON_ADA":    18,
+		"REASON_WRONG_NETWORK_IN_TX_BODY":       19,
+		"REASON_OUTSIDE_FORECAST":               20,
+		"REASON_TOO_MANY_COLLATERAL_INPUTS":     21,
+		"REASON_NO_COLLATERAL_INPUTS":           22,
+	}
+)
+
+func (x Failure_Reason) Enum() *Failure_Reason {
+	p := new(Failure_Reason)
+	*p = x
+	return p
+}
+
+func (x Failure_Reason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Failure_Reason) Descriptor() protoreflect.EnumDescriptor {
+	return file_sf_cardano_submit_v1_submit_proto_enumTypes[0].Descriptor()
+}
+
+func (Failure_Reason) Type() protoreflect.EnumType {
+	return &file_sf_cardano_submit_v1_submit_proto_enumTypes[0]
+}
+
+func (x Failure_Reason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Failure_Reason.Descriptor instead.
+func (Failure_Reason) EnumDescriptor() ([]byte, []int) {
+	return file_sf_cardano_submit_v1_submit_proto_rawDescGZIP(), []int{5, 0}
+}
+
+type SubmitTxRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tx            []byte                 `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`        // CBOR of the signed transaction
+	Track         bool                   `protobuf:"varint,2,opt,name=track,proto3" json:"track,omitempty"` // Watch the blocks for the transaction, see WaitForTx
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitTxRequest) Reset() {
+	*x = SubmitTxRequest{}
+	mi := &file_sf_cardano_submit_v1_submit_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitTxRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitTxRequest) ProtoMessage() {}
+
+func (x *SubmitTxRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_submit_v1_submit_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitTxRequest.ProtoReflect.Descriptor instead.
+func (*SubmitTxRequest) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_submit_v1_submit_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SubmitTxRequest) GetTx() []byte {
+	if x != nil {
+		return x.Tx
+	}
+	return nil
+}
+
+func (x *SubmitTxRequest) GetTrack() bool {
+	if x != nil {
+		return x.Track
+	}
+	return false
+}
+
+type SubmitTxResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TxHash        []byte                 `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitTxResponse) Reset() {
+	*x = SubmitTxResponse{}
+	mi := &file_sf_cardano_submit_v1_submit_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitTxResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitTxResponse) ProtoMessage() {}
+
+func (x *SubmitTxResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_submit_v1_submit_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitTxResponse.ProtoReflect.Descriptor instead.
+func (*SubmitTxResponse) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_submit_v1_submit_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SubmitTxResponse) GetTxHash() []byte {
+	if x != nil {
+		return x.TxHash
+	}
+	return nil
+}
+
+type WaitForTxRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TxHash        []byte                 `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WaitForTxRequest) Reset() {
+	*x = WaitForTxRequest{}
+	mi := &file_sf_cardano_submit_v1_submit_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WaitForTxRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitForTxRequest) ProtoMessage() {}
+
+func (x *WaitForTxRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_submit_v1_submit_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitForTxRequest.ProtoReflect.Descriptor instead.
+func (*WaitForTxRequest) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_submit_v1_submit_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *WaitForTxRequest) GetTxHash() []byte {
+	if x != nil {
+		return x.TxHash
+	}
+	return nil
+}
+
+type WaitForTxResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TxHash        []byte                 `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	BlockHeight   uint64                 `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	BlockHash     []byte                 `protobuf:"bytes,3,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	BlockSlot     uint64                 `protobuf:"varint,4,opt,name=block_slot,json=blockSlot,proto3" json:"block_slot,omitempty"`
+	TxIndex       uint32                 `protobuf:"varint,5,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WaitForTxResponse) Reset() {
+	*x = WaitForTxResponse{}
+	mi := &file_sf_cardano_submit_v1_submit_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WaitForTxResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitForTxResponse) ProtoMessage() {}
+
+func (x *WaitForTxResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_submit_v1_submit_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitForTxResponse.ProtoReflect.Descriptor instead.
+func (*WaitForTxResponse) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_submit_v1_submit_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *WaitForTxResponse) GetTxHash() []byte {
+	if x != nil {
+		return x.TxHash
+	}
+	return nil
+}
+
+func (x *WaitForTxResponse) GetBlockHeight() uint64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *WaitForTxResponse) GetBlockHash() []byte {
+	if x != nil {
+		return x.BlockHash
+	}
+	return nil
+}
+
+func (x *WaitForTxResponse) GetBlockSlot() uint64 {
+	if x != nil {
+		return x.BlockSlot
+	}
+	return 0
+}
+
+func (x *WaitForTxResponse) GetTxIndex() uint32 {
+	if x != nil {
+		return x.TxIndex
+	}
+	return 0
+}
+
+// Why the node rejected a transaction.
+type Rejection struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Failures      []*Failure             `protobuf:"bytes,1,rep,name=failures,proto3" json:"failures,omitempty"`
+	ReasonCbor    []byte                 `protobuf:"bytes,2,opt,name=reason_cbor,json=reasonCbor,proto3" json:"reason_cbor,omitempty"` // Rejection as the node sent it
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Rejection) Reset() {
+	*x = Rejection{}
+	mi := &file_sf_cardano_submit_v1_submit_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Rejection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rejection) ProtoMessage() {}
+
+func (x *Rejection) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_submit_v1_submit_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rejection.ProtoReflect.Descriptor instead.
+func (*Rejection) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_submit_v1_submit_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Rejection) GetFailures() []*Failure {
+	if x != nil {
+		return x.Failures
+	}
+	return nil
+}
+
+func (x *Rejection) GetReasonCbor() []byte {
+	if x != nil {
+		return x.ReasonCbor
+	}
+	return nil
+}
+
+type Failure struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reason        Failure_Reason         `protobuf:"varint,1,opt,name=reason,proto3,enum=sf.cardano.submit.v1.Failure_Reason" json:"reason,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // Failure as described by the node
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Failure) Reset() {
+	*x = Failure{}
+	mi := &file_sf_cardano_submit_v1_submit_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Failure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Failure) ProtoMessage() {}
+
+func (x *Failure) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_submit_v1_submit_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Failure.ProtoReflect.Descriptor instead.
+func (*Failure) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_submit_v1_submit_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Failure) GetReason() Failure_Reason {
+	if x != nil {
+		return x.Reason
+	}
+	return Failure_REASON_UNSPECIFIED
+}
+
+func (x *Failure) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+var File_sf_cardano_submit_v1_submit_proto protoreflect.FileDescriptor
+
+const file_sf_cardano_submit_v1_submit_proto_rawDesc = "" +
+	"\n" +
+	"!sf/cardano/submit/v1/submit.proto\x12\x14sf.cardano.submit.v1\"7\n" +
+	"\x0fSubmitTxRequest\x12\x0e\n" +
+	"\x02tx\x18\x01 \x01(\fR\x02tx\x12\x14\n" +
+	"\x05track\x18\x02 \x01(\bR\x05track\"+\n" +
+	"\x10SubmitTxResponse\x12\x17\n" +
+	"\atx_hash\x18\x01 \x01(\fR\x06txHash\"+\n" +
+	"\x10WaitForTxRequest\x12\x17\n" +
+	"\atx_hash\x18\x01 \x01(\fR\x06txHash\"\xa8\x01\n" +
+	"\x11WaitForTxResponse\x12\x17\n" +
+	"\atx_hash\x18\x01 \x01(\fR\x06txHash\x12!\n" +
+	"\fblock_height\x18\x02 \x01(\x04R\vblockHeight\x12\x1d\n" +
+	"\n" +
+	"block_hash\x18\x03 \x01(\fR\tblockHash\x12\x1d\n" +
+	"\n" +
+	"block_slot\x18\x04 \x01(\x04R\tblockSlot\x12\x19\n" +
+	"\btx_index\x18\x05 \x01(\rR\atxIndex\"g\n" +
+	"\tRejection\x129\n" +
+	"\bfailures\x18\x01 \x03(\v2\x1d.sf.cardano.submit.v1.FailureR\bfailures\x12\x1f\n" +
+	"\vreason_cbor\x18\x02 \x01(\fR\n" +
+	"reasonCbor\"\xb4\x06\n" +
+	"\aFailure\x12<\n" +
+	"\x06reason\x18\x01 \x01(\x0e2$.sf.cardano.submit.v1.Failure.ReasonR\x06reason\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xd0\x05\n" +
+	"\x06Reason\x12\x16\n" +
+	"\x12REASON_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13REASON_ERA_MISMATCH\x10\x01\x12\x15\n" +
+	"\x11REASON_BAD_INPUTS\x10\x02\x12$\n" +
+	" REASON_OUTSIDE_VALIDITY_INTERVAL\x10\x03\x12\x16\n" +
+	"\x12REASON_MAX_TX_SIZE\x10\x04\x12\x1a\n" +
+	"\x16REASON_INPUT_SET_EMPTY\x10\x05\x12\x18\n" +
+	"\x14REASON_FEE_TOO_SMALL\x10\x06\x12\x1e\n" +
+	"\x1aREASON_VALUE_NOT_CONSERVED\x10\a\x12\x1b\n" +
+	"\x17REASON_OUTPUT_TOO_SMALL\x10\b\x12\x19\n" +
+	"\x15REASON_SCRIPT_FAILURE\x10\t\x12\x18\n" +
+	"\x14REASON_WRONG_NETWORK\x10\n" +
+	"\x12#\n" +
+	"\x1fREASON_WRONG_NETWORK_WITHDRAWAL\x10\v\x12)\n" +
+	"%REASON_OUTPUT_BOOT_ADDR_ATTRS_TOO_BIG\x10\f\x12\x1d\n" +
+	"\x19REASON_TRIES_TO_FORGE_ADA\x10\r\x12\x19\n" +
+	"\x15REASON_OUTPUT_TOO_BIG\x10\x0e\x12\"\n" +
+	"\x1eREASON_INSUFFICIENT_COLLATERAL\x10\x0f\x12\x1b\n" +
+	"\x17REASON_SCRIPTS_NOT_PAID\x10\x10\x12\x1b\n" +
+	"\x17REASON_EX_UNITS_TOO_BIG\x10\x11\x12&\n" +
+	"\"REASON_COLLATERAL_CONTAINS_NON_ADA\x10\x12\x12#\n" +
+	"\x1fREASON_WRONG_NETWORK_IN_TX_BODY\x10\x13\x12\x1b\n" +
+	"\x17REASON_OUTSIDE_FORECAST\x10\x14\x12%\n" +
+	"!REASON_TOO_MANY_COLLATERAL_INPUTS\x10\x15\x12\x1f\n" +
+	"\x1bREASON_NO_COLLATERAL_INPUTS\x10\x162\xc1\x01\n" +
+	"\x06Submit\x12Y\n" +
+	"\bSubmitTx\x12%.sf.cardano.submit.v1.SubmitTxRequest\x1a&.sf.cardano.submit.v1.SubmitTxResponse\x12\\\n" +
+	"\tWaitForTx\x12&.sf.cardano.submit.v1.WaitForTxRequest\x1a'.sf.cardano.submit.v1.WaitForTxResponseBTZRgithub.com/no-witness-labs/firehose-cardano/types/pb/sf/cardano/submit/v1;pbsubmitb\x06proto3"
+
+var (
+	file_sf_cardano_submit_v1_submit_proto_rawDescOnce sync.Once
+	file_sf_cardano_submit_v1_submit_proto_rawDescData []byte
+)
+
+func file_sf_cardano_submit_v1_submit_proto_rawDescGZIP() []byte {
+	file_sf_cardano_submit_v1_submit_proto_rawDescOnce.Do(func() {
+		file_sf_cardano_submit_v1_submit_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_sf_cardano_submit_v1_submit_proto_rawDesc), len(file_sf_cardano_submit_v1_submit_proto_rawDesc)))
+	})
+	return file_sf_cardano_submit_v1_submit_proto_rawDescData
+}
+
+var file_sf_cardano_submit_v1_submit_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_sf_cardano_submit_v1_submit_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_sf_cardano_submit_v1_submit_proto_goTypes = []any{
+	(Failure_Reason)(0),       // 0: sf.cardano.submit.v1.Failure.Reason
+	(*SubmitTxRequest)(nil),   // 1: sf.cardano.submit.v1.SubmitTxRequest
+	(*SubmitTxResponse)(nil),  // 2: sf.cardano.submit.v1.SubmitTxResponse
+	(*WaitForTxRequest)(nil),  // 3: sf.cardano.submit.v1.WaitForTxRequest
+	(*WaitForTxResponse)(nil), // 4: sf.cardano.submit.v1.WaitForTxResponse
+	(*Rejection)(nil),         // 5: sf.cardano.submit.v1.Rejection
+	(*Failure)(nil),           // 6: sf.cardano.submit.v1.Failure
+}
+var file_sf_cardano_submit_v1_submit_proto_depIdxs = []int32{
+	6, // 0: sf.cardano.submit.v1.Rejection.failures:type_name -> sf.cardano.submit.v1.Failure
+	0, // 1: sf.cardano.submit.v1.Failure.reason:type_name -> sf.cardano.submit.v1.Failure.Reason
+	1, // 2: sf.cardano.submit.v1.Submit.SubmitTx:input_type -> sf.cardano.submit.v1.SubmitTxRequest
+	3, // 3: sf.cardano.submit.v1.Submit.WaitForTx:input_type -> sf.cardano.submit.v1.WaitForTxRequest
+	2, // 4: sf.cardano.submit.v1.Submit.SubmitTx:output_type -> sf.cardano.submit.v1.SubmitTxResponse
+	4, // 5: sf.cardano.submit.v1.Submit.WaitForTx:output_type -> sf.cardano.submit.v1.WaitForTxResponse
+	4, // [4:6] is the sub-list for method output_type
+	2, // [2:4] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_sf_cardano_submit_v1_submit_proto_init() }
+func file_sf_cardano_submit_v1_submit_proto_init() {
+	if File_sf_cardano_submit_v1_submit_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_sf_cardano_submit_v1_submit_proto_rawDesc), len(file_sf_cardano_submit_v1_submit_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_sf_cardano_submit_v1_submit_proto_goTypes,
+		DependencyIndexes: file_sf_cardano_submit_v1_submit_proto_depIdxs,
+		EnumInfos:         file_sf_cardano_submit_v1_submit_proto_enumTypes,
+		MessageInfos:      file_sf_cardano_submit_v1_submit_proto_msgTypes,
+	}.Build()
+	File_sf_cardano_submit_v1_submit_proto = out.File
+	file_sf_cardano_submit_v1_submit_proto_goTypes = nil
+	file_sf_cardano_submit_v1_submit_proto_depIdxs = nil
+}
@@ -0,0 +1,171 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: sf/cardano/submit/v1/submit.proto
+
+package pbsubmit
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Submit_SubmitTx_FullMethodName  = "/sf.cardano.submit.v1.Submit/SubmitTx"
+	Submit_WaitForTx_FullMethodName = "/sf.cardano.submit.v1.Submit/WaitForTx"
+)
+
+// SubmitClient is the client API for Submit service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Submits signed transactions to a node.
+type SubmitClient interface {
+	// Forwards a transaction to the node. A transaction the node rejects fails
+	// the call with FAILED_PRECONDITION, a Rejection in the status details.
+	SubmitTx(ctx context.Context, in *SubmitTxRequest, opts ...grpc.CallOption) (*SubmitTxResponse, error)
+	// Waits for a transaction submitted with track to be included in a block
+	// of the reader node, or for the deadline of the call.
+	WaitForTx(ctx context.Context, in *WaitForTxRequest, opts ...grpc.CallOption) (*WaitForTxResponse, error)
+}
+
+type submitClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSubmitClient(cc grpc.ClientConnInterface) SubmitClient {
+	return &submitClient{cc}
+}
+
+func (c *submitClient) SubmitTx(ctx context.Context, in *SubmitTxRequest, opts ...grpc.CallOption) (*SubmitTxResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitTxResponse)
+	err := c.cc.Invoke(ctx, Submit_SubmitTx_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *submitClient) WaitForTx(ctx context.Context, in *WaitForTxRequest, opts ...grpc.CallOption) (*WaitForTxResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WaitForTxResponse)
+	err := c.cc.Invoke(ctx, Submit_WaitForTx_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SubmitServer is the server API for Submit service.
+// All implementations must embed UnimplementedSubmitServer
+// for forward compatibility.
+//
+// Submits signed transactions to a node.
+type SubmitServer interface {
+	// Forwards a transaction to the node. A transaction the node rejects fails
+	// the call with FAILED_PRECONDITION, a Rejection in the status details.
+	SubmitTx(context.Context, *SubmitTxRequest) (*SubmitTxResponse, error)
+	// Waits for a transaction submitted with track to be included in a block
+	// of the reader node, or for the deadline of the call.
+	WaitForTx(context.Context, *WaitForTxRequest) (*WaitForTxResponse, error)
+	mustEmbedUnimplementedSubmitServer()
+}
+
+// UnimplementedSubmitServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSubmitServer struct{}
+
+func (UnimplementedSubmitServer) SubmitTx(context.Context, *SubmitTxRequest) (*SubmitTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitTx not implemented")
+}
+func (UnimplementedSubmitServer) WaitForTx(context.Context, *WaitForTxRequest) (*WaitForTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WaitForTx not implemented")
+}
+func (UnimplementedSubmitServer) mustEmbedUnimplementedSubmitServer() {}
+func (UnimplementedSubmitServer) testEmbeddedByValue()                {}
+
+// UnsafeSubmitServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SubmitServer will
+// result in compilation errors.
+type UnsafeSubmitServer interface {
+	mustEmbedUnimplementedSubmitServer()
+}
+
+func RegisterSubmitServer(s grpc.ServiceRegistrar, srv SubmitServer) {
+	// If the following call pancis, it indicates UnimplementedSubmitServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Submit_ServiceDesc, srv)
+}
+
+func _Submit_SubmitTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubmitServer).SubmitTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Submit_SubmitTx_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).SubmitTx(ctx, req.(*SubmitTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Submit_WaitForTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WaitForTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubmitServer).WaitForTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Submit_WaitForTx_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmitServer).WaitForTx(ctx, req.(*WaitForTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Submit_ServiceDesc is the grpc.ServiceDesc for Submit service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Submit_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "sf.cardano.submit.v1.Submit",
+	HandlerType: (*SubmitServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SubmitTx",
+			Handler:    _Submit_SubmitTx_Handler,
+		},
+		{
+			MethodName: "WaitForTx",
+			Handler:    _Submit_WaitForTx_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sf/cardano/submit/v1/submit.proto",
+}
@@ -0,0 +1,115 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: sf/cardano/transform/v1/transform.proto
+
+package pbtransform
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Removes the original CBOR embedded in blocks and transactions by fetchers
+// running with raw CBOR preservation.
+type StripRawCBOR struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StripRawCBOR) Reset() {
+	*x = StripRawCBOR{}
+	mi := &file_sf_cardano_transform_v1_transform_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StripRawCBOR) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StripRawCBOR) ProtoMessage() {}
+
+func (x *StripRawCBOR) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_transform_v1_transform_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StripRawCBOR.ProtoReflect.Descriptor instead.
+func (*StripRawCBOR) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_transform_v1_transform_proto_rawDescGZIP(), []int{0}
+}
+
+var File_sf_cardano_transform_v1_transform_proto protoreflect.FileDescriptor
+
+const file_sf_cardano_transform_v1_transform_proto_rawDesc = "" +
+	"\n" +
+	"'sf/cardano/transform/v1/transform.proto\x12\x17sf.cardano.transform.v1\"\x0e\n" +
+	"\fStripRawCBORBZZXgithub.com/no-witness-labs/firehose-cardano/types/pb/sf/cardano/transform/v1;pbtransformb\x06proto3"
+
+var (
+	file_sf_cardano_transform_v1_transform_proto_rawDescOnce sync.Once
+	file_sf_cardano_transform_v1_transform_proto_rawDescData []byte
+)
+
+func file_sf_cardano_transform_v1_transform_proto_rawDescGZIP() []byte {
+	file_sf_cardano_transform_v1_transform_proto_rawDescOnce.Do(func() {
+		file_sf_cardano_transform_v1_transform_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_sf_cardano_transform_v1_transform_proto_rawDesc), len(file_sf_cardano_transform_v1_transform_proto_rawDesc)))
+	})
+	return file_sf_cardano_transform_v1_transform_proto_rawDescData
+}
+
+var file_sf_cardano_transform_v1_transform_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_sf_cardano_transform_v1_transform_proto_goTypes = []any{
+	(*StripRawCBOR)(nil), // 0: sf.cardano.transform.v1.StripRawCBOR
+}
+var file_sf_cardano_transform_v1_transform_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_sf_cardano_transform_v1_transform_proto_init() }
+func file_sf_cardano_transform_v1_transform_proto_init() {
+	if File_sf_cardano_transform_v1_transform_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_sf_cardano_transform_v1_transform_proto_rawDesc), len(file_sf_cardano_transform_v1_transform_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_sf_cardano_transform_v1_transform_proto_goTypes,
+		DependencyIndexes: file_sf_cardano_transform_v1_transform_proto_depIdxs,
+		MessageInfos:      file_sf_cardano_transform_v1_transform_proto_msgTypes,
+	}.Build()
+	File_sf_cardano_transform_v1_transform_proto = out.File
+	file_sf_cardano_transform_v1_transform_proto_goTypes = nil
+	file_sf_cardano_transform_v1_transform_proto_depIdxs = nil
+}
@@ -0,0 +1,508 @@
+package pbcardano
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcutil/base58"
+	"github.com/btcsuite/btcd/btcutil/bech32"
+	"github.com/fxamacker/cbor/v2"
+	"golang.org/x/crypto/blake2b"
+)
+
+// Helpers to decode the raw address and key hash bytes carried by the
+// schema (TxOutput.address, Withdrawal.reward_account, certificate key
+// hashes) and render them the way Cardano tooling does.
+
+const (
+	NetworkIDTestnet uint8 = 0
+	NetworkIDMainnet uint8 = 1
+
+	KeyHashLength    = 28
+	ScriptHashLength = 28
+	TxHashLength     = 32
+)
+
+// AddressType is the kind of a Cardano address as encoded in its header byte.
+type AddressType uint8
+
+const (
+	AddressTypeUnknown AddressType = iota
+	AddressTypeBase
+	AddressTypePointer
+	AddressTypeEnterprise
+	AddressTypeReward
+	AddressTypeByron
+)
+
+func (t AddressType) String() string {
+	switch t {
+	case AddressTypeBase:
+		return "base"
+	case AddressTypePointer:
+		return "pointer"
+	case AddressTypeEnterprise:
+		return "enterprise"
+	case AddressTypeReward:
+		return "reward"
+	case AddressTypeByron:
+		return "byron"
+	default:
+		return "unknown"
+	}
+}
+
+// CredentialType tells whether a credential is a key hash or a script hash.
+type CredentialType uint8
+
+const (
+	CredentialTypeKeyHash CredentialType = iota
+	CredentialTypeScriptHash
+)
+
+func (t CredentialType) String() string {
+	if t == CredentialTypeScriptHash {
+		return "script"
+	}
+	return "key"
+}
+
+// Credential is a payment or delegation credential of a Shelley address.
+type Credential struct {
+	Type CredentialType
+	Hash []byte
+}
+
+// StakeCredential returns the credential as its schema counterpart.
+func (c *Credential) StakeCredential() *StakeCredential {
+	if c == nil {
+		return nil
+	}
+	if c.Type == CredentialTypeScriptHash {
+		return &StakeCredential{StakeCredential: &StakeCredential_ScriptHash{ScriptHash: c.Hash}}
+	}
+	return &StakeCredential{StakeCredential: &StakeCredential_AddrKeyHash{AddrKeyHash: c.Hash}}
+}
+
+// CredentialFromStake converts a schema stake credential, returning nil when
+// none of its variants is set.
+func CredentialFromStake(sc *StakeCredential) *Credential {
+	switch v := sc.GetStakeCredential().(type) {
+	case *StakeCredential_AddrKeyHash:
+		return &Credential{Type: CredentialTypeKeyHash, Hash: v.AddrKeyHash}
+	case *StakeCredential_ScriptHash:
+		return &Credential{Type: CredentialTypeScriptHash, Hash: v.ScriptHash}
+	}
+	return nil
+}
+
+// Pointer locates the stake registration certificate referenced by a
+// pointer address.
+type Pointer struct {
+	Slot      uint64
+	TxIndex   uint64
+	CertIndex uint64
+}
+
+// ByronAttributes are the optional attributes carried by a Byron address.
+type ByronAttributes struct {
+	DerivationPath []byte  // Encrypted HD derivation path, if any
+	NetworkMagic   *uint32 // Protocol magic, absent on mainnet
+}
+
+// Address is a decoded Cardano address.
+type Address struct {
+	Type       AddressType
+	Header     byte
+	NetworkID  uint8
+	Payment    *Credential // Nil for reward and Byron addresses
+	Delegation *Credential // Set for base and reward addresses
+	Pointer    *Pointer    // Set for pointer addresses
+
+	// Byron only
+	ByronRoot       []byte
+	ByronType       uint64
+	ByronAttributes *ByronAttributes
+
+	raw []byte
+}
+
+// DecodeAddress decodes raw address bytes as found on-chain.
+func DecodeAddress(raw []byte) (*Address, error) {
+	if len(raw) == 0 {
+		return nil, errors.New("empty address")
+	}
+
+	header := raw[0]
+	addr := &Address{
+		Header:    header,
+		NetworkID: header & 0x0f,
+		raw:       raw,
+	}
+
+	credAt := func(bit byte, offset int) (*Credential, error) {
+		if len(raw) < offset+KeyHashLength {
+			return nil, fmt.Errorf("address too short: %d bytes", len(raw))
+		}
+		cred := &Credential{Type: CredentialTypeKeyHash, Hash: raw[offset : offset+KeyHashLength]}
+		if header&bit != 0 {
+			cred.Type = CredentialTypeScriptHash
+		}
+		return cred, nil
+	}
+
+	var err error
+	switch kind := header >> 4; kind {
+	case 0b0000, 0b0001, 0b0010, 0b0011:
+		addr.Type = AddressTypeBase
+		if len(raw) != 1+2*KeyHashLength {
+			return nil, fmt.Errorf("invalid base address length: %d", len(raw))
+		}
+		if addr.Payment, err = credAt(0x10, 1); err != nil {
+			return nil, err
+		}
+		if addr.Delegation, err = credAt(0x20, 1+KeyHashLength); err != nil {
+			return nil, err
+		}
+	case 0b0100, 0b0101:
+		addr.Type = AddressTypePointer
+		if addr.Payment, err = credAt(0x10, 1); err != nil {
+			return nil, err
+		}
+		pointer, err := decodePointer(raw[1+KeyHashLength:])
+		if err != nil {
+			return nil, err
+		}
+		addr.Pointer = pointer
+	case 0b0110, 0b0111:
+		addr.Type = AddressTypeEnterprise
+		if len(raw) != 1+KeyHashLength {
+			return nil, fmt.Errorf("invalid enterprise address length: %d", len(raw))
+		}
+		if addr.Payment, err = credAt(0x10, 1); err != nil {
+			return nil, err
+		}
+	case 0b1110, 0b1111:
+		addr.Type = AddressTypeReward
+		if len(raw) != 1+KeyHashLength {
+			return nil, fmt.Errorf("invalid reward address length: %d", len(raw))
+		}
+		if addr.Delegation, err = credAt(0x10, 1); err != nil {
+			return nil, err
+		}
+	case 0b1000:
+		if err := addr.decodeByron(); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported address header: 0x%02x", header)
+	}
+
+	return addr, nil
+}
+
+// Bytes returns the raw address bytes.
+func (a *Address) Bytes() []byte {
+	return a.raw
+}
+
+// IsMainnet reports whether the address belongs to mainnet.
+func (a *Address) IsMainnet() bool {
+	return a.NetworkID == NetworkIDMainnet
+}
+
+// HRP returns the bech32 human readable prefix for the address, or an empty
+// string for Byron addresses which are base58 encoded.
+func (a *Address) HRP() string {
+	switch a.Type {
+	case AddressTypeByron, AddressTypeUnknown:
+		return ""
+	case AddressTypeReward:
+		if a.IsMainnet() {
+			return "stake"
+		}
+		return "stake_test"
+	default:
+		if a.IsMainnet() {
+			return "addr"
+		}
+		return "addr_test"
+	}
+}
+
+// Encode renders the address in its canonical textual form: bech32 for
+// Shelley addresses and base58 for Byron addresses.
+func (a *Address) Encode() (string, error) {
+	if a.Type == AddressTypeByron {
+		return base58.Encode(a.raw), nil
+	}
+	return encodeBech32(a.HRP(), a.raw)
+}
+
+// String returns the canonical textual form of the address, falling back to
+// hex when it cannot be encoded.
+func (a *Address) String() string {
+	s, err := a.Encode()
+	if err != nil {
+		return fmt.Sprintf("%x", a.raw)
+	}
+	return s
+}
+
+// StakeAddress returns the reward address sharing this address' delegation
+// credential, or nil when the address has none.
+func (a *Address) StakeAddress() *Address {
+	if a.Delegation == nil {
+		return nil
+	}
+	if a.Type == AddressTypeReward {
+		return a
+	}
+	return NewRewardAddress(a.NetworkID, a.Delegation)
+}
+
+// NewRewardAddress builds the reward address of a stake credential.
+func NewRewardAddress(networkID uint8, cred *Credential) *Address {
+	header := 0xe0 | networkID&0x0f
+	if cred.Type == CredentialTypeScriptHash {
+		header |= 0x10
+	}
+	raw := append([]byte{header}, cred.Hash...)
+	return &Address{
+		Type:       AddressTypeReward,
+		Header:     header,
+		NetworkID:  networkID,
+		Delegation: cred,
+		raw:        raw,
+	}
+}
+
+// DecodeAddressString parses a bech32 or base58 encoded address.
+func DecodeAddressString(s string) (*Address, error) {
+	if _, data, err := bech32.DecodeNoLimit(s); err == nil {
+		raw, err := bech32.ConvertBits(data, 5, 8, false)
+		if err != nil {
+			return nil, fmt.Errorf("invalid bech32 payload: %w", err)
+		}
+		return DecodeAddress(raw)
+	}
+	raw := base58.Decode(s)
+	if len(raw) == 0 {
+		return nil, fmt.Errorf("address %q is neither bech32 nor base58", s)
+	}
+	return DecodeAddress(raw)
+}
+
+func decodePointer(data []byte) (*Pointer, error) {
+	var values [3]uint64
+	for i := range values {
+		var v uint64
+		for n := 0; ; n++ {
+			if len(data) == 0 {
+				return nil, errors.New("truncated pointer")
+			}
+			if n >= 10 {
+				return nil, errors.New("pointer value overflows uint64")
+			}
+			b := data[0]
+			data = data[1:]
+			v = v<<7 | uint64(b&0x7f)
+			if b&0x80 == 0 {
+				break
+			}
+		}
+		values[i] = v
+	}
+	if len(data) != 0 {
+		return nil, fmt.Errorf("trailing %d bytes after pointer", len(data))
+	}
+	return &Pointer{Slot: values[0], TxIndex: values[1], CertIndex: values[2]}, nil
+}
+
+type byronAddressEnvelope struct {
+	_        struct{} `cbor:",toarray"`
+	Payload  cbor.Tag
+	Checksum uint32
+}
+
+type byronAddressPayload struct {
+	_          struct{} `cbor:",toarray"`
+	Root       []byte
+	Attributes map[uint64]cbor.RawMessage
+	Type       uint64
+}
+
+func (a *Address) decodeByron() error {
+	var envelope byronAddressEnvelope
+	if err := cbor.Unmarshal(a.raw, &envelope); err != nil {
+		return fmt.Errorf("invalid byron address: %w", err)
+	}
+	content, ok := envelope.Payload.Content.([]byte)
+	if envelope.Payload.Number != 24 || !ok {
+		return errors.New("invalid byron address: payload is not an embedded cbor item")
+	}
+
+	var payload byronAddressPayload
+	if err := cbor.Unmarshal(content, &payload); err != nil {
+		return fmt.Errorf("invalid byron address payload: %w", err)
+	}
+
+	a.Type = AddressTypeByron
+	a.ByronRoot = payload.Root
+	a.ByronType = payload.Type
+	a.ByronAttributes = &ByronAttributes{}
+	a.NetworkID = NetworkIDMainnet
+
+	if raw, ok := payload.Attributes[1]; ok {
+		var encoded []byte
+		if err := cbor.Unmarshal(raw, &encoded); err != nil {
+			return fmt.Errorf("invalid byron derivation path attribute: %w", err)
+		}
+		if err := cbor.Unmarshal(encoded, &a.ByronAttributes.DerivationPath); err != nil {
+			return fmt.Errorf("invalid byron derivation path attribute: %w", err)
+		}
+	}
+	if raw, ok := payload.Attributes[2]; ok {
+		var encoded []byte
+		if err := cbor.Unmarshal(raw, &encoded); err != nil {
+			return fmt.Errorf("invalid byron network magic attribute: %w", err)
+		}
+		var magic uint32
+		if err := cbor.Unmarshal(encoded, &magic); err != nil {
+			return fmt.Errorf("invalid byron network magic attribute: %w", err)
+		}
+		a.ByronAttributes.NetworkMagic = &magic
+		a.NetworkID = NetworkIDTestnet
+	}
+
+	return nil
+}
+
+// DecodeAddress decodes the output's address.
+func (o *TxOutput) DecodeAddress() (*Address, error) {
+	return DecodeAddress(o.GetAddress())
+}
+
+// AddressString returns the output's address in bech32 or base58 form.
+func (o *TxOutput) AddressString() (string, error) {
+	addr, err := o.DecodeAddress()
+	if err != nil {
+		return "", err
+	}
+	return addr.Encode()
+}
+
+// DecodeRewardAccount decodes the withdrawal's reward account.
+func (w *Withdrawal) DecodeRewardAccount() (*Address, error) {
+	return DecodeAddress(w.GetRewardAccount())
+}
+
+// RewardAccountString returns the withdrawal's reward account as a stake
+// address.
+func (w *Withdrawal) RewardAccountString() (string, error) {
+	addr, err := w.DecodeRewardAccount()
+	if err != nil {
+		return "", err
+	}
+	return addr.Encode()
+}
+
+// AssetFingerprint computes the CIP-14 fingerprint of a native asset.
+func AssetFingerprint(policyID, assetName []byte) (string, error) {
+	h, err := blake2b.New(20, nil)
+	if err != nil {
+		return "", err
+	}
+	h.Write(policyID)
+	h.Write(assetName)
+	return encodeBech32("asset", h.Sum(nil))
+}
+
+// PoolID renders a pool key hash as a bech32 pool id.
+func PoolID(poolKeyHash []byte) (string, error) {
+	if len(poolKeyHash) != KeyHashLength {
+		return "", fmt.Errorf("invalid pool key hash length: %d", len(poolKeyHash))
+	}
+	return encodeBech32("pool", poolKeyHash)
+}
+
+// CIP-129 governance credential key types, stored in the upper nibble of
+// the identifier header byte.
+const (
+	cip129CommitteeHot  byte = 0b0000
+	cip129CommitteeCold byte = 0b0001
+	cip129DRep          byte = 0b0010
+)
+
+func cip129Credential(hrp string, keyType byte, cred *Credential) (string, error) {
+	if cred == nil {
+		return "", errors.New("missing credential")
+	}
+	if len(cred.Hash) != KeyHashLength {
+		return "", fmt.Errorf("invalid credential hash length: %d", len(cred.Hash))
+	}
+	header := keyType<<4 | 0b0010
+	if cred.Type == CredentialTypeScriptHash {
+		header = keyType<<4 | 0b0011
+	}
+	return encodeBech32(hrp, append([]byte{header}, cred.Hash...))
+}
+
+// DRepID renders a DRep credential as a CIP-129 drep1 identifier.
+func DRepID(cred *StakeCredential) (string, error) {
+	return cip129Credential("drep", cip129DRep, CredentialFromStake(cred))
+}
+
+// CommitteeHotID renders a constitutional committee hot credential as a
+// CIP-129 cc_hot1 identifier.
+func CommitteeHotID(cred *StakeCredential) (string, error) {
+	return cip129Credential("cc_hot", cip129CommitteeHot, CredentialFromStake(cred))
+}
+
+// CommitteeColdID renders a constitutional committee cold credential as a
+// CIP-129 cc_cold1 identifier.
+func CommitteeColdID(cred *StakeCredential) (string, error) {
+	return cip129Credential("cc_cold", cip129CommitteeCold, CredentialFromStake(cred))
+}
+
+// GovActionID renders a governance action id as a CIP-129 gov_action1
+// identifier.
+func GovActionID(txHash []byte, index uint32) (string, error) {
+	if len(txHash) != TxHashLength {
+		return "", fmt.Errorf("invalid transaction hash length: %d", len(txHash))
+	}
+	data := append([]byte{}, txHash...)
+	var idx []byte
+	for v := index; v > 0; v >>= 8 {
+		idx = append([]byte{byte(v)}, idx...)
+	}
+	if len(idx) == 0 {
+		idx = []byte{0}
+	}
+	return encodeBech32("gov_action", append(data, idx...))
+}
+
+// Bech32 returns the CIP-129 identifier of the governance action id.
+func (id *GovernanceActionId) Bech32() (string, error) {
+	return GovActionID(id.GetTransactionId(), id.GetGovernanceActionIndex())
+}
+
+// Bech32 returns the CIP-129 identifier of a credential-based DRep. Abstain
+// and no-confidence DReps have no identifier.
+func (d *DRep) Bech32() (string, error) {
+	switch v := d.GetDrep().(type) {
+	case *DRep_AddrKeyHash:
+		return cip129Credential("drep", cip129DRep, &Credential{Type: CredentialTypeKeyHash, Hash: v.AddrKeyHash})
+	case *DRep_ScriptHash:
+		return cip129Credential("drep", cip129DRep, &Credential{Type: CredentialTypeScriptHash, Hash: v.ScriptHash})
+	}
+	return "", errors.New("drep has no credential")
+}
+
+func encodeBech32(hrp string, data []byte) (string, error) {
+	conv, err := bech32.ConvertBits(data, 8, 5, true)
+	if err != nil {
+		return "", err
+	}
+	return bech32.Encode(hrp, conv)
+}
@@ -0,0 +1,257 @@
+package pbcardano
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+func mustHex(t *testing.T, s string) []byte {
+	t.Helper()
+	data, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+// Key and script hashes and pointer of the CIP-19 test vectors.
+const (
+	cip19PaymentKey = "9493315cd92eb5d8c4304e67b7e16ae36d61d34502694657811a2c8e"
+	cip19StakeKey   = "337b62cfff6403a06a3acbc34f8c46003c69fe79a3628cefa9c47251"
+	cip19Script     = "c37b1b5dc0669f1d3c61a6fddb2e8fde96be87b881c60bce8e8d542f"
+)
+
+var cip19Pointer = &Pointer{Slot: 2498243, TxIndex: 27, CertIndex: 3}
+
+func TestAddressVectors(t *testing.T) {
+	key := func(hash string) *Credential { return &Credential{Type: CredentialTypeKeyHash, Hash: []byte(hash)} }
+	script := func(hash string) *Credential { return &Credential{Type: CredentialTypeScriptHash, Hash: []byte(hash)} }
+
+	for _, tc := range []struct {
+		address    string
+		typ        AddressType
+		networkID  uint8
+		payment    *Credential
+		delegation *Credential
+		pointer    *Pointer
+	}{
+		// CIP-19
+		{
+			address:    "addr1qx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzer3n0d3vllmyqwsx5wktcd8cc3sq835lu7drv2xwl2wywfgse35a3x",
+			typ:        AddressTypeBase,
+			networkID:  NetworkIDMainnet,
+			payment:    key(cip19PaymentKey),
+			delegation: key(cip19StakeKey),
+		},
+		{
+			address:    "addr1z8phkx6acpnf78fuvxn0mkew3l0fd058hzquvz7w36x4gten0d3vllmyqwsx5wktcd8cc3sq835lu7drv2xwl2wywfgs9yc0hh",
+			typ:        AddressTypeBase,
+			networkID:  NetworkIDMainnet,
+			payment:    script(cip19Script),
+			delegation: key(cip19StakeKey),
+		},
+		{
+			address:    "addr1yx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzerkr0vd4msrxnuwnccdxlhdjar77j6lg0wypcc9uar5d2shs2z78ve",
+			typ:        AddressTypeBase,
+			networkID:  NetworkIDMainnet,
+			payment:    key(cip19PaymentKey),
+			delegation: script(cip19Script),
+		},
+		{
+			address:    "addr1x8phkx6acpnf78fuvxn0mkew3l0fd058hzquvz7w36x4gt7r0vd4msrxnuwnccdxlhdjar77j6lg0wypcc9uar5d2shskhj42g",
+			typ:        AddressTypeBase,
+			networkID:  NetworkIDMainnet,
+			payment:    script(cip19Script),
+			delegation: script(cip19Script),
+		},
+		{
+			address:   "addr1gx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzer5pnz75xxcrzqf96k",
+			typ:       AddressTypePointer,
+			networkID: NetworkIDMainnet,
+			payment:   key(cip19PaymentKey),
+			pointer:   cip19Pointer,
+		},
+		{
+			address:   "addr128phkx6acpnf78fuvxn0mkew3l0fd058hzquvz7w36x4gtupnz75xxcrtw79hu",
+			typ:       AddressTypePointer,
+			networkID: NetworkIDMainnet,
+			payment:   script(cip19Script),
+			pointer:   cip19Pointer,
+		},
+		{
+			address:   "addr1vx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzers66hrl8",
+			typ:       AddressTypeEnterprise,
+			networkID: NetworkIDMainnet,
+			payment:   key(cip19PaymentKey),
+		},
+		{
+			address:   "addr1w8phkx6acpnf78fuvxn0mkew3l0fd058hzquvz7w36x4gtcyjy7wx",
+			typ:       AddressTypeEnterprise,
+			networkID: NetworkIDMainnet,
+			payment:   script(cip19Script),
+		},
+		{
+			address:    "stake1uyehkck0lajq8gr28t9uxnuvgcqrc6070x3k9r8048z8y5gh6ffgw",
+			typ:        AddressTypeReward,
+			networkID:  NetworkIDMainnet,
+			delegation: key(cip19StakeKey),
+		},
+		{
+			address:    "stake178phkx6acpnf78fuvxn0mkew3l0fd058hzquvz7w36x4gtcccycj5",
+			typ:        AddressTypeReward,
+			networkID:  NetworkIDMainnet,
+			delegation: script(cip19Script),
+		},
+		{
+			address:    "addr_test1qz2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzer3n0d3vllmyqwsx5wktcd8cc3sq835lu7drv2xwl2wywfgs68faae",
+			typ:        AddressTypeBase,
+			networkID:  NetworkIDTestnet,
+			payment:    key(cip19PaymentKey),
+			delegation: key(cip19StakeKey),
+		},
+		{
+			address:   "addr_test1vz2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzerspjrlsz",
+			typ:       AddressTypeEnterprise,
+			networkID: NetworkIDTestnet,
+			payment:   key(cip19PaymentKey),
+		},
+		{
+			address:    "stake_test1uqehkck0lajq8gr28t9uxnuvgcqrc6070x3k9r8048z8y5gssrtvn",
+			typ:        AddressTypeReward,
+			networkID:  NetworkIDTestnet,
+			delegation: key(cip19StakeKey),
+		},
+		{
+			address:   "Ae2tdPwUPEZFRbyhz3cpfC2CumGzNkFBN2L42rcUc2yjQpEkxDbkPodpMAi",
+			typ:       AddressTypeByron,
+			networkID: NetworkIDMainnet,
+		},
+		{
+			address:   "DdzFFzCqrhsw3prhfMFDNFowbzUku3QmrMwarfjUbWXRisodn97R436SHc1rimp4MhPNmbdYb1aTdqtGSJixMVMi5MkArDQJ6Sc1n3Ez",
+			typ:       AddressTypeByron,
+			networkID: NetworkIDMainnet,
+		},
+	} {
+		t.Run(tc.address, func(t *testing.T) {
+			for _, cred := range []*Credential{tc.payment, tc.delegation} {
+				if cred != nil {
+					cred.Hash = mustHex(t, string(cred.Hash))
+				}
+			}
+
+			addr, err := DecodeAddressString(tc.address)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if addr.Type != tc.typ || addr.NetworkID != tc.networkID {
+				t.Errorf("%s address on network %d, expected %s on %d", addr.Type, addr.NetworkID, tc.typ, tc.networkID)
+			}
+			if !equalCredential(addr.Payment, tc.payment) {
+				t.Errorf("payment credential %v, expected %v", addr.Payment, tc.payment)
+			}
+			if !equalCredential(addr.Delegation, tc.delegation) {
+				t.Errorf("delegation credential %v, expected %v", addr.Delegation, tc.delegation)
+			}
+			if (addr.Pointer == nil) != (tc.pointer == nil) || addr.Pointer != nil && *addr.Pointer != *tc.pointer {
+				t.Errorf("pointer %v, expected %v", addr.Pointer, tc.pointer)
+			}
+
+			// Back from the raw bytes, as carried by the schema.
+			decoded, err := DecodeAddress(addr.Bytes())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if encoded, err := decoded.Encode(); err != nil || encoded != tc.address {
+				t.Errorf("encoded %q, %v", encoded, err)
+			}
+		})
+	}
+}
+
+func equalCredential(a, b *Credential) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Type == b.Type && bytes.Equal(a.Hash, b.Hash)
+}
+
+func TestDaedalusAddress(t *testing.T) {
+	addr, err := DecodeAddressString("DdzFFzCqrhsw3prhfMFDNFowbzUku3QmrMwarfjUbWXRisodn97R436SHc1rimp4MhPNmbdYb1aTdqtGSJixMVMi5MkArDQJ6Sc1n3Ez")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(addr.ByronRoot) != KeyHashLength || len(addr.ByronAttributes.DerivationPath) == 0 {
+		t.Errorf("root %x, derivation path %x", addr.ByronRoot, addr.ByronAttributes.DerivationPath)
+	}
+	if addr.ByronAttributes.NetworkMagic != nil {
+		t.Errorf("network magic %d on a mainnet address", *addr.ByronAttributes.NetworkMagic)
+	}
+}
+
+func TestAssetFingerprint(t *testing.T) {
+	// CIP-14
+	for _, tc := range []struct {
+		policyID    string
+		assetName   string
+		fingerprint string
+	}{
+		{"7eae28af2208be856f7a119668ae52a49b73725e326dc16579dcc373", "", "asset1rjklcrnsdzqp65wjgrg55sy9723kw09mlgvlc3"},
+		{"7eae28af2208be856f7a119668ae52a49b73725e326dc16579dcc37e", "", "asset1nl0puwxmhas8fawxp8nx4e2q3wekg969n2auw3"},
+		{"1e349c9bdea19fd6c147626a5260bc44b71635f398b67c59881df209", "", "asset1uyuxku60yqe57nusqzjx38aan3f2wq6s93f6ea"},
+		{"7eae28af2208be856f7a119668ae52a49b73725e326dc16579dcc373", "504154415445", "asset13n25uv0yaf5kus35fm2k86cqy60z58d9xmde92"},
+		{"1e349c9bdea19fd6c147626a5260bc44b71635f398b67c59881df209", "504154415445", "asset1hv4p5tv2a837mzqrst04d0dcptdjmluqvdx9k3"},
+		{"1e349c9bdea19fd6c147626a5260bc44b71635f398b67c59881df209", "7eae28af2208be856f7a119668ae52a49b73725e326dc16579dcc373", "asset1aqrdypg669jgazruv5ah07nuyqe0wxjhe2el6f"},
+		{"7eae28af2208be856f7a119668ae52a49b73725e326dc16579dcc373", "1e349c9bdea19fd6c147626a5260bc44b71635f398b67c59881df209", "asset17jd78wukhtrnmjh3fngzasxm8rck0l2r4hhyyt"},
+		{"7eae28af2208be856f7a119668ae52a49b73725e326dc16579dcc373", "0000000000000000000000000000000000000000000000000000000000000000", "asset1pkpwyknlvul7az0xx8czhl60pyel45rpje4z8w"},
+	} {
+		got, err := AssetFingerprint(mustHex(t, tc.policyID), mustHex(t, tc.assetName))
+		if err != nil || got != tc.fingerprint {
+			t.Errorf("fingerprint of %s.%s %q, %v, expected %q", tc.policyID, tc.assetName, got, err, tc.fingerprint)
+		}
+	}
+}
+
+func TestCIP129(t *testing.T) {
+	zero := make([]byte, KeyHashLength)
+	for _, tc := range []struct {
+		name string
+		id   func() (string, error)
+		want string
+	}{
+		{
+			name: "drep key hash",
+			id: func() (string, error) {
+				return DRepID(&StakeCredential{StakeCredential: &StakeCredential_AddrKeyHash{AddrKeyHash: zero}})
+			},
+			want: "drep1ygqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq7vlc9n",
+		},
+		{
+			name: "committee hot key hash",
+			id: func() (string, error) {
+				return CommitteeHotID(&StakeCredential{StakeCredential: &StakeCredential_AddrKeyHash{AddrKeyHash: zero}})
+			},
+			want: "cc_hot1qgqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqvcdjk7",
+		},
+		{
+			name: "governance action",
+			id: func() (string, error) {
+				return GovActionID(make([]byte, TxHashLength), 17)
+			},
+			want: "gov_action1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqpzklpgpf",
+		},
+		{
+			name: "governance action at index 0",
+			id: func() (string, error) {
+				return GovActionID(mustHex(t, "0b19476e40bbbb5e1e8ce153523762e2b6859e7ecacbaf06eae0ee6a447e79b9"), 0)
+			},
+			want: "gov_action1pvv5wmjqhwa4u85vu9f4ydmzu2mgt8n7et967ph2urhx53r70xusqnmm525",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if got, err := tc.id(); err != nil || got != tc.want {
+				t.Errorf("%q, %v, expected %q", got, err, tc.want)
+			}
+		})
+	}
+}
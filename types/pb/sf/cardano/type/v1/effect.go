@@ -0,0 +1,79 @@
+package pbcardano
+
+// Transactions whose scripts fail only apply their collateral. The helpers
+// below follow the ledger effect of a transaction so that consumers need not
+// know the rule; transactions converted without one are taken as applied in
+// full.
+
+// SpentInputs returns the inputs the ledger spends with a transaction: its
+// inputs, or its collateral inputs when its scripts failed.
+func (t *Tx) SpentInputs() []*TxInput {
+	effect := t.GetEffect()
+	if effect == nil {
+		return t.GetInputs()
+	}
+
+	out := make([]*TxInput, 0, len(effect.Spent))
+	for _, ref := range effect.Spent {
+		inputs := t.GetInputs()
+		if ref.Collateral {
+			inputs = t.GetCollateral().GetCollateral()
+		}
+		if int(ref.Index) < len(inputs) {
+			out = append(out, inputs[ref.Index])
+		}
+	}
+	return out
+}
+
+// CreatedOutputs returns the outputs the ledger creates with a transaction,
+// in the order of its effect's created references: its outputs, or its
+// collateral return when its scripts failed.
+func (t *Tx) CreatedOutputs() []*TxOutput {
+	effect := t.GetEffect()
+	if effect == nil {
+		return t.GetOutputs()
+	}
+
+	out := make([]*TxOutput, 0, len(effect.Created))
+	for _, ref := range effect.Created {
+		if output := t.CreatedOutput(ref.Index); output != nil {
+			out = append(out, output)
+		}
+	}
+	return out
+}
+
+// CreatedOutput returns the output a transaction creates at index, nil when
+// it creates none there.
+func (t *Tx) CreatedOutput(index uint32) *TxOutput {
+	effect := t.GetEffect()
+	if effect == nil {
+		if int(index) < len(t.GetOutputs()) {
+			return t.Outputs[index]
+		}
+		return nil
+	}
+
+	for _, ref := range effect.Created {
+		if ref.Index != index {
+			continue
+		}
+		if ref.CollateralReturn {
+			return t.GetCollateral().GetCollateralReturn()
+		}
+		if int(index) < len(t.GetOutputs()) {
+			return t.Outputs[index]
+		}
+	}
+	return nil
+}
+
+// BodyApplied tells whether the mint, certificates, withdrawals and
+// governance actions of a transaction take effect.
+func (t *Tx) BodyApplied() bool {
+	if effect := t.GetEffect(); effect != nil {
+		return effect.BodyApplied
+	}
+	return true
+}
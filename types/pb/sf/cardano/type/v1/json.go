@@ -0,0 +1,854 @@
+package pbcardano
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Cardano JSON codec
+//
+// The default protojson rendering is hard to consume for Cardano data: bytes
+// come out as base64 and PlutusData as deeply nested oneofs. MarshalJSON
+// renders any message of this package the way Cardano tooling does:
+//
+//   - field names are the proto field names (snake_case), unset fields are
+//     omitted and 64-bit integers are plain JSON numbers;
+//   - bytes (hashes, policy ids, asset names, keys, ...) are hex strings;
+//   - addresses and reward accounts are bech32 (base58 for Byron);
+//   - PlutusData uses the cardano-cli "detailed schema"
+//     ({"constructor":0,"fields":[...]}, {"map":[{"k":..,"v":..}]},
+//     {"list":[...]}, {"int":42}, {"bytes":"cafe"});
+//   - AuxData.metadata is an object keyed by label whose values use the
+//     detailed metadata schema ({"int"}, {"bytes"}, {"string"}, {"list"},
+//     {"map"}).
+//
+// UnmarshalJSON reverses the rendering losslessly. The few protobuf details
+// the detailed schema cannot express (a non-canonical constructor tag or a
+// bignum that would fit a 64-bit integer) are carried by the extra "tag",
+// "any_constructor" and "bignum" keys, only emitted when needed.
+
+// addressFields are the bytes fields holding a full address.
+var addressFields = map[protoreflect.FullName]bool{
+	"sf.cardano.type.v1.TxOutput.address":                        true,
+	"sf.cardano.type.v1.Withdrawal.reward_account":               true,
+	"sf.cardano.type.v1.GovernanceActionProposal.reward_account": true,
+	"sf.cardano.type.v1.WithdrawalAmount.reward_account":         true,
+	"sf.cardano.type.v1.PoolRegistrationCert.reward_account":     true,
+	"sf.cardano.type.v1.AddressPattern.exact_address":            true,
+}
+
+const auxDataMetadataField protoreflect.FullName = "sf.cardano.type.v1.AuxData.metadata"
+
+// MarshalJSON renders a message of this package as Cardano JSON.
+func MarshalJSON(m proto.Message) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	if err := encodeMessage(buf, m.ProtoReflect()); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON parses Cardano JSON produced by MarshalJSON into m.
+func UnmarshalJSON(data []byte, m proto.Message) error {
+	proto.Reset(m)
+	return decodeMessage(data, m.ProtoReflect())
+}
+
+func (b *Block) MarshalJSON() ([]byte, error)    { return MarshalJSON(b) }
+func (b *Block) UnmarshalJSON(data []byte) error { return UnmarshalJSON(data, b) }
+func (t *Tx) MarshalJSON() ([]byte, error)       { return MarshalJSON(t) }
+func (t *Tx) UnmarshalJSON(data []byte) error    { return UnmarshalJSON(data, t) }
+
+// Encoding
+
+func encodeMessage(buf *bytes.Buffer, m protoreflect.Message) error {
+	switch v := m.Interface().(type) {
+	case *PlutusData:
+		return encodePlutusData(buf, v)
+	case *Metadatum:
+		return encodeMetadatum(buf, v)
+	}
+
+	buf.WriteByte('{')
+	fields := m.Descriptor().Fields()
+	first := true
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if !isPopulated(m, fd) {
+			continue
+		}
+		if !first {
+			buf.WriteByte(',')
+		}
+		first = false
+		writeString(buf, string(fd.Name()))
+		buf.WriteByte(':')
+
+		var err error
+		switch {
+		case fd.FullName() == auxDataMetadataField:
+			err = encodeMetadataList(buf, m.Get(fd).List())
+		case fd.IsList():
+			err = encodeList(buf, fd, m.Get(fd).List())
+		case fd.IsMap():
+			err = encodeMap(buf, fd, m.Get(fd).Map())
+		default:
+			err = encodeValue(buf, fd, m.Get(fd))
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %w", fd.Name(), err)
+		}
+	}
+	buf.WriteByte('}')
+	return nil
+}
+
+func isPopulated(m protoreflect.Message, fd protoreflect.FieldDescriptor) bool {
+	switch {
+	case fd.IsList():
+		return m.Get(fd).List().Len() > 0
+	case fd.IsMap():
+		return m.Get(fd).Map().Len() > 0
+	default:
+		return m.Has(fd)
+	}
+}
+
+func encodeList(buf *bytes.Buffer, fd protoreflect.FieldDescriptor, list protoreflect.List) error {
+	buf.WriteByte('[')
+	for i := 0; i < list.Len(); i++ {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		if err := encodeValue(buf, fd, list.Get(i)); err != nil {
+			return err
+		}
+	}
+	buf.WriteByte(']')
+	return nil
+}
+
+func encodeMap(buf *bytes.Buffer, fd protoreflect.FieldDescriptor, m protoreflect.Map) error {
+	keys := make([]protoreflect.MapKey, 0, m.Len())
+	m.Range(func(k protoreflect.MapKey, _ protoreflect.Value) bool {
+		keys = append(keys, k)
+		return true
+	})
+	slices.SortFunc(keys, func(a, b protoreflect.MapKey) int { return strings.Compare(a.String(), b.String()) })
+
+	buf.WriteByte('{')
+	for i, k := range keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		writeString(buf, k.String())
+		buf.WriteByte(':')
+		if err := encodeValue(buf, fd.MapValue(), m.Get(k)); err != nil {
+			return err
+		}
+	}
+	buf.WriteByte('}')
+	return nil
+}
+
+func encodeValue(buf *bytes.Buffer, fd protoreflect.FieldDescriptor, v protoreflect.Value) error {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return encodeMessage(buf, v.Message())
+	case protoreflect.BytesKind:
+		if addressFields[fd.FullName()] {
+			if addr, err := DecodeAddress(v.Bytes()); err == nil {
+				if s, err := addr.Encode(); err == nil {
+					writeString(buf, s)
+					return nil
+				}
+			}
+		}
+		writeString(buf, hex.EncodeToString(v.Bytes()))
+	case protoreflect.StringKind:
+		writeString(buf, v.String())
+	case protoreflect.BoolKind:
+		buf.WriteString(strconv.FormatBool(v.Bool()))
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByNumber(v.Enum()); ev != nil {
+			writeString(buf, string(ev.Name()))
+		} else {
+			buf.WriteString(strconv.FormatInt(int64(v.Enum()), 10))
+		}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		buf.WriteString(strconv.FormatInt(v.Int(), 10))
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		buf.WriteString(strconv.FormatUint(v.Uint(), 10))
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		buf.WriteString(strconv.FormatFloat(v.Float(), 'g', -1, 64))
+	default:
+		return fmt.Errorf("unsupported field kind %s", fd.Kind())
+	}
+	return nil
+}
+
+func encodeMetadataList(buf *bytes.Buffer, list protoreflect.List) error {
+	buf.WriteByte('{')
+	for i := 0; i < list.Len(); i++ {
+		md := list.Get(i).Message().Interface().(*Metadata)
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		writeString(buf, strconv.FormatUint(md.Label, 10))
+		buf.WriteByte(':')
+		if err := encodeMetadatum(buf, md.Value); err != nil {
+			return fmt.Errorf("label %d: %w", md.Label, err)
+		}
+	}
+	buf.WriteByte('}')
+	return nil
+}
+
+func encodeMetadatum(buf *bytes.Buffer, md *Metadatum) error {
+	switch v := md.GetMetadatum().(type) {
+	case nil:
+		buf.WriteString("{}")
+	case *Metadatum_Int:
+		fmt.Fprintf(buf, `{"int":%d}`, v.Int)
+	case *Metadatum_BigInt:
+		fmt.Fprintf(buf, `{"int":%s}`, v.BigInt.Value().String())
+	case *Metadatum_Bytes:
+		fmt.Fprintf(buf, `{"bytes":"%x"}`, v.Bytes)
+	case *Metadatum_Text:
+		buf.WriteString(`{"string":`)
+		writeString(buf, v.Text)
+		buf.WriteByte('}')
+	case *Metadatum_Array:
+		buf.WriteString(`{"list":[`)
+		for i, item := range v.Array.GetItems() {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err := encodeMetadatum(buf, item); err != nil {
+				return err
+			}
+		}
+		buf.WriteString("]}")
+	case *Metadatum_Map:
+		buf.WriteString(`{"map":[`)
+		for i, pair := range v.Map.GetPairs() {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			buf.WriteString(`{"k":`)
+			if err := encodeMetadatum(buf, pair.Key); err != nil {
+				return err
+			}
+			buf.WriteString(`,"v":`)
+			if err := encodeMetadatum(buf, pair.Value); err != nil {
+				return err
+			}
+			buf.WriteByte('}')
+		}
+		buf.WriteString("]}")
+	}
+	return nil
+}
+
+func encodePlutusData(buf *bytes.Buffer, pd *PlutusData) error {
+	switch v := pd.GetPlutusData().(type) {
+	case nil:
+		buf.WriteString("{}")
+	case *PlutusData_Constr:
+		index := ConstrIndex(v.Constr)
+		fmt.Fprintf(buf, `{"constructor":%d`, index)
+		if tag := CanonicalConstrTag(index); v.Constr.Tag != tag {
+			fmt.Fprintf(buf, `,"tag":%d`, v.Constr.Tag)
+		}
+		if v.Constr.Tag != 102 && v.Constr.AnyConstructor != 0 {
+			fmt.Fprintf(buf, `,"any_constructor":%d`, v.Constr.AnyConstructor)
+		}
+		buf.WriteString(`,"fields":[`)
+		for i, field := range v.Constr.Fields {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err := encodePlutusData(buf, field); err != nil {
+				return err
+			}
+		}
+		buf.WriteString("]}")
+	case *PlutusData_Map:
+		buf.WriteString(`{"map":[`)
+		for i, pair := range v.Map.GetPairs() {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			buf.WriteString(`{"k":`)
+			if err := encodePlutusData(buf, pair.Key); err != nil {
+				return err
+			}
+			buf.WriteString(`,"v":`)
+			if err := encodePlutusData(buf, pair.Value); err != nil {
+				return err
+			}
+			buf.WriteByte('}')
+		}
+		buf.WriteString("]}")
+	case *PlutusData_Array:
+		buf.WriteString(`{"list":[`)
+		for i, item := range v.Array.GetItems() {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err := encodePlutusData(buf, item); err != nil {
+				return err
+			}
+		}
+		buf.WriteString("]}")
+	case *PlutusData_BigInt:
+		value := v.BigInt.Value()
+		fmt.Fprintf(buf, `{"int":%s`, value.String())
+		if raw, canonical := bigIntRaw(v.BigInt); !canonical {
+			fmt.Fprintf(buf, `,"bignum":"%x"`, raw)
+		}
+		buf.WriteByte('}')
+	case *PlutusData_BoundedBytes:
+		fmt.Fprintf(buf, `{"bytes":"%x"}`, v.BoundedBytes)
+	}
+	return nil
+}
+
+// ConstrIndex returns the constructor index of a Plutus constructor
+// according to its CBOR tag.
+func ConstrIndex(c *Constr) uint64 {
+	switch {
+	case c.Tag >= 121 && c.Tag <= 127:
+		return uint64(c.Tag - 121)
+	case c.Tag >= 1280 && c.Tag <= 1400:
+		return uint64(c.Tag-1280) + 7
+	default:
+		return c.AnyConstructor
+	}
+}
+
+// CanonicalConstrTag returns the CBOR tag used to encode a constructor
+// index: 121-127 for 0-6, 1280-1400 for 7-127 and the general form 102
+// beyond.
+func CanonicalConstrTag(index uint64) uint32 {
+	switch {
+	case index < 7:
+		return uint32(121 + index)
+	case index < 128:
+		return uint32(1280 + index - 7)
+	default:
+		return 102
+	}
+}
+
+// Value returns the integer value of a Plutus big integer. Negative bignums
+// follow the CBOR tag 3 convention and hold -1 - n.
+func (b *BigInt) Value() *big.Int {
+	switch v := b.GetBigInt().(type) {
+	case *BigInt_Int:
+		return big.NewInt(v.Int)
+	case *BigInt_BigUInt:
+		return new(big.Int).SetBytes(v.BigUInt)
+	case *BigInt_BigNInt:
+		n := new(big.Int).SetBytes(v.BigNInt)
+		return n.Neg(n).Sub(n, big.NewInt(1))
+	}
+	return new(big.Int)
+}
+
+// NewBigInt returns the canonical representation of an integer: int64 when it
+// fits, a bignum otherwise.
+func NewBigInt(value *big.Int) *BigInt {
+	if value.IsInt64() {
+		return &BigInt{BigInt: &BigInt_Int{Int: value.Int64()}}
+	}
+	if value.Sign() > 0 {
+		return &BigInt{BigInt: &BigInt_BigUInt{BigUInt: value.Bytes()}}
+	}
+	n := new(big.Int).Neg(value)
+	n.Sub(n, big.NewInt(1))
+	return &BigInt{BigInt: &BigInt_BigNInt{BigNInt: n.Bytes()}}
+}
+
+// bigIntRaw returns the raw bytes of a bignum and whether the BigInt is the
+// canonical representation of its value.
+func bigIntRaw(b *BigInt) ([]byte, bool) {
+	var raw []byte
+	switch v := b.GetBigInt().(type) {
+	case *BigInt_BigUInt:
+		raw = v.BigUInt
+	case *BigInt_BigNInt:
+		raw = v.BigNInt
+	default:
+		return nil, true
+	}
+	return raw, proto.Equal(b, NewBigInt(b.Value()))
+}
+
+func writeString(buf *bytes.Buffer, s string) {
+	out, _ := json.Marshal(s)
+	buf.Write(out)
+}
+
+// Decoding
+
+type jsonField struct {
+	key   string
+	value json.RawMessage
+}
+
+// decodeObject splits a JSON object in its fields, preserving their order.
+func decodeObject(data []byte) ([]jsonField, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	if tok != json.Delim('{') {
+		return nil, fmt.Errorf("expected object, got %v", tok)
+	}
+
+	var fields []jsonField
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		var value json.RawMessage
+		if err := dec.Decode(&value); err != nil {
+			return nil, err
+		}
+		fields = append(fields, jsonField{key: tok.(string), value: value})
+	}
+	if _, err := dec.Token(); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+func decodeArray(data []byte) ([]json.RawMessage, error) {
+	var items []json.RawMessage
+	if err := json.Unmarshal(data, &items); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+func decodeMessage(data []byte, m protoreflect.Message) error {
+	switch v := m.Interface().(type) {
+	case *PlutusData:
+		return decodePlutusData(data, v)
+	case *Metadatum:
+		return decodeMetadatum(data, v)
+	}
+
+	fields, err := decodeObject(data)
+	if err != nil {
+		return fmt.Errorf("%s: %w", m.Descriptor().FullName(), err)
+	}
+
+	descriptors := m.Descriptor().Fields()
+	for _, field := range fields {
+		fd := descriptors.ByName(protoreflect.Name(field.key))
+		if fd == nil {
+			fd = descriptors.ByJSONName(field.key)
+		}
+		if fd == nil {
+			return fmt.Errorf("%s: unknown field %q", m.Descriptor().FullName(), field.key)
+		}
+
+		if err := decodeField(field.value, m, fd); err != nil {
+			return fmt.Errorf("%s: %w", fd.Name(), err)
+		}
+	}
+	return nil
+}
+
+func decodeField(data []byte, m protoreflect.Message, fd protoreflect.FieldDescriptor) error {
+	switch {
+	case fd.FullName() == auxDataMetadataField:
+		return decodeMetadataList(data, m.Mutable(fd).List())
+	case fd.IsList():
+		items, err := decodeArray(data)
+		if err != nil {
+			return err
+		}
+		list := m.Mutable(fd).List()
+		for _, item := range items {
+			if fd.Message() != nil {
+				elem := list.NewElement()
+				if err := decodeMessage(item, elem.Message()); err != nil {
+					return err
+				}
+				list.Append(elem)
+				continue
+			}
+			v, err := decodeScalar(item, fd)
+			if err != nil {
+				return err
+			}
+			list.Append(v)
+		}
+	case fd.IsMap():
+		entries, err := decodeObject(data)
+		if err != nil {
+			return err
+		}
+		mp := m.Mutable(fd).Map()
+		for _, entry := range entries {
+			key, err := decodeMapKey(entry.key, fd.MapKey())
+			if err != nil {
+				return err
+			}
+			if fd.MapValue().Message() != nil {
+				val := mp.NewValue()
+				if err := decodeMessage(entry.value, val.Message()); err != nil {
+					return err
+				}
+				mp.Set(key, val)
+				continue
+			}
+			val, err := decodeScalar(entry.value, fd.MapValue())
+			if err != nil {
+				return err
+			}
+			mp.Set(key, val)
+		}
+	case fd.Message() != nil:
+		return decodeMessage(data, m.Mutable(fd).Message())
+	default:
+		v, err := decodeScalar(data, fd)
+		if err != nil {
+			return err
+		}
+		m.Set(fd, v)
+	}
+	return nil
+}
+
+func decodeMapKey(key string, fd protoreflect.FieldDescriptor) (protoreflect.MapKey, error) {
+	if fd.Kind() == protoreflect.StringKind {
+		return protoreflect.ValueOfString(key).MapKey(), nil
+	}
+	v, err := decodeScalar([]byte(key), fd)
+	if err != nil {
+		return protoreflect.MapKey{}, err
+	}
+	return v.MapKey(), nil
+}
+
+func decodeScalar(data []byte, fd protoreflect.FieldDescriptor) (protoreflect.Value, error) {
+	switch fd.Kind() {
+	case protoreflect.BytesKind:
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return protoreflect.Value{}, err
+		}
+		b, err := decodeBytes(s, addressFields[fd.FullName()])
+		return protoreflect.ValueOfBytes(b), err
+	case protoreflect.StringKind:
+		var s string
+		err := json.Unmarshal(data, &s)
+		return protoreflect.ValueOfString(s), err
+	case protoreflect.BoolKind:
+		var b bool
+		err := json.Unmarshal(data, &b)
+		return protoreflect.ValueOfBool(b), err
+	case protoreflect.EnumKind:
+		var name string
+		if err := json.Unmarshal(data, &name); err != nil {
+			n, err := strconv.ParseInt(string(data), 10, 32)
+			return protoreflect.ValueOfEnum(protoreflect.EnumNumber(n)), err
+		}
+		ev := fd.Enum().Values().ByName(protoreflect.Name(name))
+		if ev == nil {
+			return protoreflect.Value{}, fmt.Errorf("unknown enum value %q", name)
+		}
+		return protoreflect.ValueOfEnum(ev.Number()), nil
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		n, err := strconv.ParseInt(string(data), 10, 32)
+		return protoreflect.ValueOfInt32(int32(n)), err
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		n, err := strconv.ParseInt(string(data), 10, 64)
+		return protoreflect.ValueOfInt64(n), err
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		n, err := strconv.ParseUint(string(data), 10, 32)
+		return protoreflect.ValueOfUint32(uint32(n)), err
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		n, err := strconv.ParseUint(string(data), 10, 64)
+		return protoreflect.ValueOfUint64(n), err
+	case protoreflect.FloatKind:
+		f, err := strconv.ParseFloat(string(data), 32)
+		return protoreflect.ValueOfFloat32(float32(f)), err
+	case protoreflect.DoubleKind:
+		f, err := strconv.ParseFloat(string(data), 64)
+		return protoreflect.ValueOfFloat64(f), err
+	}
+	return protoreflect.Value{}, fmt.Errorf("unsupported field kind %s", fd.Kind())
+}
+
+func decodeBytes(s string, isAddress bool) ([]byte, error) {
+	if !isAddress {
+		return hex.DecodeString(s)
+	}
+	// Addresses that could not be rendered are emitted as hex, which never
+	// decodes to a valid address.
+	if raw, err := hex.DecodeString(s); err == nil {
+		if _, err := DecodeAddress(raw); err != nil {
+			return raw, nil
+		}
+	}
+	addr, err := DecodeAddressString(s)
+	if err != nil {
+		return nil, err
+	}
+	return addr.Bytes(), nil
+}
+
+func decodeMetadataList(data []byte, list protoreflect.List) error {
+	entries, err := decodeObject(data)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		label, err := strconv.ParseUint(entry.key, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid metadata label %q", entry.key)
+		}
+		md := &Metadata{Label: label, Value: &Metadatum{}}
+		if err := decodeMetadatum(entry.value, md.Value); err != nil {
+			return fmt.Errorf("label %d: %w", label, err)
+		}
+		list.Append(protoreflect.ValueOfMessage(md.ProtoReflect()))
+	}
+	return nil
+}
+
+func decodeKV(data []byte, decode func(k, v json.RawMessage) error) error {
+	items, err := decodeArray(data)
+	if err != nil {
+		return err
+	}
+	for _, item := range items {
+		var pair struct {
+			K json.RawMessage `json:"k"`
+			V json.RawMessage `json:"v"`
+		}
+		if err := json.Unmarshal(item, &pair); err != nil {
+			return err
+		}
+		if pair.K == nil || pair.V == nil {
+			return errors.New(`map entries require "k" and "v"`)
+		}
+		if err := decode(pair.K, pair.V); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func decodeMetadatum(data []byte, md *Metadatum) error {
+	fields, err := decodeObject(data)
+	if err != nil {
+		return err
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+	if len(fields) != 1 {
+		return fmt.Errorf("metadatum must have a single key, got %d", len(fields))
+	}
+
+	field := fields[0]
+	switch field.key {
+	case "int":
+		value, ok := new(big.Int).SetString(string(field.value), 10)
+		if !ok {
+			return fmt.Errorf("invalid int %s", field.value)
+		}
+		if value.IsInt64() {
+			md.Metadatum = &Metadatum_Int{Int: value.Int64()}
+		} else {
+			md.Metadatum = &Metadatum_BigInt{BigInt: NewBigInt(value)}
+		}
+	case "bytes":
+		var s string
+		if err := json.Unmarshal(field.value, &s); err != nil {
+			return err
+		}
+		b, err := hex.DecodeString(s)
+		if err != nil {
+			return err
+		}
+		md.Metadatum = &Metadatum_Bytes{Bytes: b}
+	case "string":
+		var s string
+		if err := json.Unmarshal(field.value, &s); err != nil {
+			return err
+		}
+		md.Metadatum = &Metadatum_Text{Text: s}
+	case "list":
+		items, err := decodeArray(field.value)
+		if err != nil {
+			return err
+		}
+		array := &MetadatumArray{}
+		for _, item := range items {
+			child := &Metadatum{}
+			if err := decodeMetadatum(item, child); err != nil {
+				return err
+			}
+			array.Items = append(array.Items, child)
+		}
+		md.Metadatum = &Metadatum_Array{Array: array}
+	case "map":
+		mp := &MetadatumMap{}
+		err := decodeKV(field.value, func(k, v json.RawMessage) error {
+			pair := &MetadatumPair{Key: &Metadatum{}, Value: &Metadatum{}}
+			if err := decodeMetadatum(k, pair.Key); err != nil {
+				return err
+			}
+			if err := decodeMetadatum(v, pair.Value); err != nil {
+				return err
+			}
+			mp.Pairs = append(mp.Pairs, pair)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		md.Metadatum = &Metadatum_Map{Map: mp}
+	default:
+		return fmt.Errorf("unknown metadatum key %q", field.key)
+	}
+	return nil
+}
+
+func decodePlutusData(data []byte, pd *PlutusData) error {
+	fields, err := decodeObject(data)
+	if err != nil {
+		return err
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+
+	values := map[string]json.RawMessage{}
+	for _, field := range fields {
+		values[field.key] = field.value
+	}
+
+	switch {
+	case values["constructor"] != nil:
+		index, err := strconv.ParseUint(string(values["constructor"]), 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid constructor: %w", err)
+		}
+		constr := &Constr{Tag: CanonicalConstrTag(index)}
+		if raw := values["tag"]; raw != nil {
+			tag, err := strconv.ParseUint(string(raw), 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid tag: %w", err)
+			}
+			constr.Tag = uint32(tag)
+		}
+		if constr.Tag == 102 {
+			constr.AnyConstructor = index
+		}
+		if raw := values["any_constructor"]; raw != nil {
+			if constr.AnyConstructor, err = strconv.ParseUint(string(raw), 10, 64); err != nil {
+				return fmt.Errorf("invalid any_constructor: %w", err)
+			}
+		}
+		items, err := decodeArray(values["fields"])
+		if err != nil {
+			return fmt.Errorf("invalid constructor fields: %w", err)
+		}
+		for _, item := range items {
+			child := &PlutusData{}
+			if err := decodePlutusData(item, child); err != nil {
+				return err
+			}
+			constr.Fields = append(constr.Fields, child)
+		}
+		pd.PlutusData = &PlutusData_Constr{Constr: constr}
+	case values["map"] != nil:
+		mp := &PlutusDataMap{}
+		err := decodeKV(values["map"], func(k, v json.RawMessage) error {
+			pair := &PlutusDataPair{Key: &PlutusData{}, Value: &PlutusData{}}
+			if err := decodePlutusData(k, pair.Key); err != nil {
+				return err
+			}
+			if err := decodePlutusData(v, pair.Value); err != nil {
+				return err
+			}
+			mp.Pairs = append(mp.Pairs, pair)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		pd.PlutusData = &PlutusData_Map{Map: mp}
+	case values["list"] != nil:
+		items, err := decodeArray(values["list"])
+		if err != nil {
+			return err
+		}
+		array := &PlutusDataArray{}
+		for _, item := range items {
+			child := &PlutusData{}
+			if err := decodePlutusData(item, child); err != nil {
+				return err
+			}
+			array.Items = append(array.Items, child)
+		}
+		pd.PlutusData = &PlutusData_Array{Array: array}
+	case values["int"] != nil:
+		value, ok := new(big.Int).SetString(string(values["int"]), 10)
+		if !ok {
+			return fmt.Errorf("invalid int %s", values["int"])
+		}
+		bi := NewBigInt(value)
+		if raw := values["bignum"]; raw != nil {
+			var s string
+			if err := json.Unmarshal(raw, &s); err != nil {
+				return err
+			}
+			b, err := hex.DecodeString(s)
+			if err != nil {
+				return err
+			}
+			if value.Sign() < 0 {
+				bi = &BigInt{BigInt: &BigInt_BigNInt{BigNInt: b}}
+			} else {
+				bi = &BigInt{BigInt: &BigInt_BigUInt{BigUInt: b}}
+			}
+		}
+		pd.PlutusData = &PlutusData_BigInt{BigInt: bi}
+	case values["bytes"] != nil:
+		var s string
+		if err := json.Unmarshal(values["bytes"], &s); err != nil {
+			return err
+		}
+		b, err := hex.DecodeString(s)
+		if err != nil {
+			return err
+		}
+		pd.PlutusData = &PlutusData_BoundedBytes{BoundedBytes: b}
+	default:
+		return fmt.Errorf("unknown plutus data keys in %s", data)
+	}
+	return nil
+}
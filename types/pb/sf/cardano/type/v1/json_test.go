@@ -0,0 +1,120 @@
+package pbcardano_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/no-witness-labs/firehose-cardano/convert"
+	"github.com/no-witness-labs/firehose-cardano/internal/nodetest"
+	pbcardano "github.com/no-witness-labs/firehose-cardano/types/pb/sf/cardano/type/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestBlockJSON(t *testing.T) {
+	block, err := convert.Block(nodetest.ConwayBlock(t), convert.WithRawCBOR(), convert.WithDecodedMetadata(), convert.WithCIP68())
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := block.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded := &pbcardano.Block{}
+	if err := decoded.UnmarshalJSON(data); err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(decoded, block) {
+		t.Error("block differs once through JSON")
+	}
+}
+
+func TestJSON(t *testing.T) {
+	// The CIP-19 mainnet enterprise address.
+	enterprise, err := hex.DecodeString("619493315cd92eb5d8c4304e67b7e16ae36d61d34502694657811a2c8e")
+	if err != nil {
+		t.Fatal(err)
+	}
+	constr := func(tag uint32, anyConstructor uint64) *pbcardano.PlutusData {
+		return &pbcardano.PlutusData{PlutusData: &pbcardano.PlutusData_Constr{Constr: &pbcardano.Constr{
+			Tag:            tag,
+			AnyConstructor: anyConstructor,
+			Fields:         []*pbcardano.PlutusData{},
+		}}}
+	}
+	bigInt := func(b *pbcardano.BigInt) *pbcardano.PlutusData {
+		return &pbcardano.PlutusData{PlutusData: &pbcardano.PlutusData_BigInt{BigInt: b}}
+	}
+	datum := func(pd *pbcardano.PlutusData) proto.Message {
+		return &pbcardano.Datum{Payload: pd}
+	}
+
+	for _, tc := range []struct {
+		name string
+		msg  proto.Message
+		json string
+	}{
+		{
+			name: "address",
+			msg:  &pbcardano.TxOutput{Address: enterprise},
+			json: `{"address":"addr1vx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzers66hrl8"}`,
+		},
+		{
+			name: "undecodable address",
+			msg:  &pbcardano.TxOutput{Address: []byte{0xf1, 0x01}},
+			json: `{"address":"f101"}`,
+		},
+		{
+			name: "canonical constructor",
+			msg:  datum(constr(122, 0)),
+			json: `{"payload":{"constructor":1,"fields":[]}}`,
+		},
+		{
+			name: "general form constructor",
+			msg:  datum(constr(102, 1)),
+			json: `{"payload":{"constructor":1,"tag":102,"fields":[]}}`,
+		},
+		{
+			name: "constructor beyond 127",
+			msg:  datum(constr(102, 128)),
+			json: `{"payload":{"constructor":128,"fields":[]}}`,
+		},
+		{
+			name: "stray any constructor",
+			msg:  datum(constr(1280, 3)),
+			json: `{"payload":{"constructor":7,"any_constructor":3,"fields":[]}}`,
+		},
+		{
+			name: "bignum",
+			msg:  datum(bigInt(&pbcardano.BigInt{BigInt: &pbcardano.BigInt_BigUInt{BigUInt: bytes.Repeat([]byte{0xff}, 9)}})),
+			json: `{"payload":{"int":4722366482869645213695}}`,
+		},
+		{
+			name: "negative bignum",
+			msg:  datum(bigInt(&pbcardano.BigInt{BigInt: &pbcardano.BigInt_BigNInt{BigNInt: bytes.Repeat([]byte{0xff}, 9)}})),
+			json: `{"payload":{"int":-4722366482869645213696}}`,
+		},
+		{
+			name: "bignum fitting an int",
+			msg:  datum(bigInt(&pbcardano.BigInt{BigInt: &pbcardano.BigInt_BigUInt{BigUInt: []byte{0x2a}}})),
+			json: `{"payload":{"int":42,"bignum":"2a"}}`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := pbcardano.MarshalJSON(tc.msg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(data) != tc.json {
+				t.Errorf("JSON %s, expected %s", data, tc.json)
+			}
+			decoded := tc.msg.ProtoReflect().New().Interface()
+			if err := pbcardano.UnmarshalJSON(data, decoded); err != nil {
+				t.Fatal(err)
+			}
+			if !proto.Equal(decoded, tc.msg) {
+				t.Errorf("decoded %v, expected %v", decoded, tc.msg)
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package pbcardano
+
+import "google.golang.org/protobuf/proto"
+
+// Apply sets the parameters an update changes in p. Cost models are updated
+// language by language, the ones the update does not carry are kept.
+func (u *PParamsUpdate) Apply(p *PParams) {
+	if u == nil {
+		return
+	}
+
+	setUint64(&p.MinFeeCoefficient, u.MinFeeCoefficient)
+	setUint64(&p.MinFeeConstant, u.MinFeeConstant)
+	setUint64(&p.MaxBlockBodySize, u.MaxBlockBodySize)
+	setUint64(&p.MaxTxSize, u.MaxTxSize)
+	setUint64(&p.MaxBlockHeaderSize, u.MaxBlockHeaderSize)
+	setUint64(&p.StakeKeyDeposit, u.StakeKeyDeposit)
+	setUint64(&p.PoolDeposit, u.PoolDeposit)
+	setUint64(&p.PoolRetirementEpochBound, u.PoolRetirementEpochBound)
+	setUint64(&p.DesiredNumberOfPools, u.DesiredNumberOfPools)
+	setMessage(&p.PoolInfluence, u.PoolInfluence)
+	setMessage(&p.MonetaryExpansion, u.MonetaryExpansion)
+	setMessage(&p.TreasuryExpansion, u.TreasuryExpansion)
+	setMessage(&p.Decentralization, u.Decentralization)
+	if u.ExtraEntropy != nil {
+		p.ExtraEntropy = append([]byte{}, u.ExtraEntropy...)
+	}
+	setMessage(&p.ProtocolVersion, u.ProtocolVersion)
+	setUint64(&p.MinUtxoValue, u.MinUtxoValue)
+	setUint64(&p.MinPoolCost, u.MinPoolCost)
+	setUint64(&p.CoinsPerUtxoWord, u.CoinsPerUtxoWord)
+	setUint64(&p.CoinsPerUtxoByte, u.CoinsPerUtxoByte)
+	if models := u.CostModels; models != nil {
+		if p.CostModels == nil {
+			p.CostModels = &CostModels{}
+		}
+		setMessage(&p.CostModels.PlutusV1, models.PlutusV1)
+		setMessage(&p.CostModels.PlutusV2, models.PlutusV2)
+		setMessage(&p.CostModels.PlutusV3, models.PlutusV3)
+	}
+	setMessage(&p.Prices, u.Prices)
+	setMessage(&p.MaxExecutionUnitsPerTransaction, u.MaxExecutionUnitsPerTransaction)
+	setMessage(&p.MaxExecutionUnitsPerBlock, u.MaxExecutionUnitsPerBlock)
+	setUint64(&p.MaxValueSize, u.MaxValueSize)
+	setUint64(&p.CollateralPercentage, u.CollateralPercentage)
+	setUint64(&p.MaxCollateralInputs, u.MaxCollateralInputs)
+	setMessage(&p.PoolVotingThresholds, u.PoolVotingThresholds)
+	setMessage(&p.DrepVotingThresholds, u.DrepVotingThresholds)
+	if u.MinCommitteeSize != nil {
+		p.MinCommitteeSize = *u.MinCommitteeSize
+	}
+	setUint64(&p.CommitteeTermLimit, u.CommitteeTermLimit)
+	setUint64(&p.GovernanceActionValidityPeriod, u.GovernanceActionValidityPeriod)
+	setUint64(&p.GovernanceActionDeposit, u.GovernanceActionDeposit)
+	setUint64(&p.DrepDeposit, u.DrepDeposit)
+	setUint64(&p.DrepInactivityPeriod, u.DrepInactivityPeriod)
+	setMessage(&p.MinFeeScriptRefCostPerByte, u.MinFeeScriptRefCostPerByte)
+}
+
+func setUint64(dst *uint64, value *uint64) {
+	if value != nil {
+		*dst = *value
+	}
+}
+
+func setMessage[M proto.Message](dst *M, value M) {
+	if !value.ProtoReflect().IsValid() {
+		return
+	}
+	*dst = proto.Clone(value).(M)
+}
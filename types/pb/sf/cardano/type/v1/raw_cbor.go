@@ -0,0 +1,12 @@
+package pbcardano
+
+// StripRawCBOR removes the original CBOR embedded in the block and its
+// transactions by fetchers running with raw CBOR preservation.
+func (b *Block) StripRawCBOR() {
+	b.OriginalCbor = nil
+	for _, tx := range b.GetBody().GetTx() {
+		tx.OriginalBodyCbor = nil
+		tx.OriginalWitnessesCbor = nil
+		tx.OriginalAuxiliaryCbor = nil
+	}
+}
@@ -86,6 +86,227 @@ func (RedeemerPurpose) EnumDescriptor() ([]byte, []int) {
 	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{0}
 }
 
+type VoterType int32
+
+const (
+	VoterType_VOTER_TYPE_UNSPECIFIED                              VoterType = 0
+	VoterType_VOTER_TYPE_CONSTITUTIONAL_COMMITTEE_HOT_KEY_HASH    VoterType = 1
+	VoterType_VOTER_TYPE_CONSTITUTIONAL_COMMITTEE_HOT_SCRIPT_HASH VoterType = 2
+	VoterType_VOTER_TYPE_DREP_KEY_HASH                            VoterType = 3
+	VoterType_VOTER_TYPE_DREP_SCRIPT_HASH                         VoterType = 4
+	VoterType_VOTER_TYPE_STAKE_POOL_KEY_HASH                      VoterType = 5
+)
+
+// Enum value maps for VoterType.
+var (
+	VoterType_name = map[int32]string{
+		0: "VOTER_TYPE_UNSPECIFIED",
+		1: "VOTER_TYPE_CONSTITUTIONAL_COMMITTEE_HOT_KEY_HASH",
+		2: "VOTER_TYPE_CONSTITUTIONAL_COMMITTEE_HOT_SCRIPT_HASH",
+		3: "VOTER_TYPE_DREP_KEY_HASH",
+		4: "VOTER_TYPE_DREP_SCRIPT_HASH",
+		5: "VOTER_TYPE_STAKE_POOL_KEY_HASH",
+	}
+	VoterType_value = map[string]int32{
+		"VOTER_TYPE_UNSPECIFIED":                              0,
+		"VOTER_TYPE_CONSTITUTIONAL_COMMITTEE_HOT_KEY_HASH":    1,
+		"VOTER_TYPE_CONSTITUTIONAL_COMMITTEE_HOT_SCRIPT_HASH": 2,
+		"VOTER_TYPE_DREP_KEY_HASH":                            3,
+		"VOTER_TYPE_DREP_SCRIPT_HASH":                         4,
+		"VOTER_TYPE_STAKE_POOL_KEY_HASH":                      5,
+	}
+)
+
+func (x VoterType) Enum() *VoterType {
+	p := new(VoterType)
+	*p = x
+	return p
+}
+
+func (x VoterType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VoterType) Descriptor() protoreflect.EnumDescriptor {
+	return file_sf_cardano_type_v1_type_proto_enumTypes[1].Descriptor()
+}
+
+func (VoterType) Type() protoreflect.EnumType {
+	return &file_sf_cardano_type_v1_type_proto_enumTypes[1]
+}
+
+func (x VoterType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VoterType.Descriptor instead.
+func (VoterType) EnumDescriptor() ([]byte, []int) {
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{1}
+}
+
+type Vote int32
+
+const (
+	Vote_VOTE_UNSPECIFIED Vote = 0
+	Vote_VOTE_NO          Vote = 1
+	Vote_VOTE_YES         Vote = 2
+	Vote_VOTE_ABSTAIN     Vote = 3
+)
+
+// Enum value maps for Vote.
+var (
+	Vote_name = map[int32]string{
+		0: "VOTE_UNSPECIFIED",
+		1: "VOTE_NO",
+		2: "VOTE_YES",
+		3: "VOTE_ABSTAIN",
+	}
+	Vote_value = map[string]int32{
+		"VOTE_UNSPECIFIED": 0,
+		"VOTE_NO":          1,
+		"VOTE_YES":         2,
+		"VOTE_ABSTAIN":     3,
+	}
+)
+
+func (x Vote) Enum() *Vote {
+	p := new(Vote)
+	*p = x
+	return p
+}
+
+func (x Vote) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Vote) Descriptor() protoreflect.EnumDescriptor {
+	return file_sf_cardano_type_v1_type_proto_enumTypes[2].Descriptor()
+}
+
+func (Vote) Type() protoreflect.EnumType {
+	return &file_sf_cardano_type_v1_type_proto_enumTypes[2]
+}
+
+func (x Vote) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Vote.Descriptor instead.
+func (Vote) EnumDescriptor() ([]byte, []int) {
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{2}
+}
+
+type ScriptLanguage int32
+
+const (
+	ScriptLanguage_SCRIPT_LANGUAGE_UNSPECIFIED ScriptLanguage = 0
+	ScriptLanguage_SCRIPT_LANGUAGE_NATIVE      ScriptLanguage = 1
+	ScriptLanguage_SCRIPT_LANGUAGE_PLUTUS_V1   ScriptLanguage = 2
+	ScriptLanguage_SCRIPT_LANGUAGE_PLUTUS_V2   ScriptLanguage = 3
+	ScriptLanguage_SCRIPT_LANGUAGE_PLUTUS_V3   ScriptLanguage = 4
+)
+
+// Enum value maps for ScriptLanguage.
+var (
+	ScriptLanguage_name = map[int32]string{
+		0: "SCRIPT_LANGUAGE_UNSPECIFIED",
+		1: "SCRIPT_LANGUAGE_NATIVE",
+		2: "SCRIPT_LANGUAGE_PLUTUS_V1",
+		3: "SCRIPT_LANGUAGE_PLUTUS_V2",
+		4: "SCRIPT_LANGUAGE_PLUTUS_V3",
+	}
+	ScriptLanguage_value = map[string]int32{
+		"SCRIPT_LANGUAGE_UNSPECIFIED": 0,
+		"SCRIPT_LANGUAGE_NATIVE":      1,
+		"SCRIPT_LANGUAGE_PLUTUS_V1":   2,
+		"SCRIPT_LANGUAGE_PLUTUS_V2":   3,
+		"SCRIPT_LANGUAGE_PLUTUS_V3":   4,
+	}
+)
+
+func (x ScriptLanguage) Enum() *ScriptLanguage {
+	p := new(ScriptLanguage)
+	*p = x
+	return p
+}
+
+func (x ScriptLanguage) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ScriptLanguage) Descriptor() protoreflect.EnumDescriptor {
+	return file_sf_cardano_type_v1_type_proto_enumTypes[3].Descriptor()
+}
+
+func (ScriptLanguage) Type() protoreflect.EnumType {
+	return &file_sf_cardano_type_v1_type_proto_enumTypes[3]
+}
+
+func (x ScriptLanguage) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ScriptLanguage.Descriptor instead.
+func (ScriptLanguage) EnumDescriptor() ([]byte, []int) {
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{3}
+}
+
+// Where the script run by a transaction was found.
+type ScriptOrigin int32
+
+const (
+	ScriptOrigin_SCRIPT_ORIGIN_UNSPECIFIED     ScriptOrigin = 0 // The script is unknown.
+	ScriptOrigin_SCRIPT_ORIGIN_WITNESS_SET     ScriptOrigin = 1 // Witness set of the transaction.
+	ScriptOrigin_SCRIPT_ORIGIN_REFERENCE_INPUT ScriptOrigin = 2 // Reference script of a reference input.
+	ScriptOrigin_SCRIPT_ORIGIN_INPUT           ScriptOrigin = 3 // Reference script of a spent input.
+	ScriptOrigin_SCRIPT_ORIGIN_REGISTRY        ScriptOrigin = 4 // Known from an earlier transaction only, the
+)
+
+// Enum value maps for ScriptOrigin.
+var (
+	ScriptOrigin_name = map[int32]string{
+		0: "SCRIPT_ORIGIN_UNSPECIFIED",
+		1: "SCRIPT_ORIGIN_WITNESS_SET",
+		2: "SCRIPT_ORIGIN_REFERENCE_INPUT",
+		3: "SCRIPT_ORIGIN_INPUT",
+		4: "SCRIPT_ORIGIN_REGISTRY",
+	}
+	ScriptOrigin_value = map[string]int32{
+		"SCRIPT_ORIGIN_UNSPECIFIED":     0,
+		"SCRIPT_ORIGIN_WITNESS_SET":     1,
+		"SCRIPT_ORIGIN_REFERENCE_INPUT": 2,
+		"SCRIPT_ORIGIN_INPUT":           3,
+		"SCRIPT_ORIGIN_REGISTRY":        4,
+	}
+)
+
+func (x ScriptOrigin) Enum() *ScriptOrigin {
+	p := new(ScriptOrigin)
+	*p = x
+	return p
+}
+
+func (x ScriptOrigin) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ScriptOrigin) Descriptor() protoreflect.EnumDescriptor {
+	return file_sf_cardano_type_v1_type_proto_enumTypes[4].Descriptor()
+}
+
+func (ScriptOrigin) Type() protoreflect.EnumType {
+	return &file_sf_cardano_type_v1_type_proto_enumTypes[4]
+}
+
+func (x ScriptOrigin) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ScriptOrigin.Descriptor instead.
+func (ScriptOrigin) EnumDescriptor() ([]byte, []int) {
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{4}
+}
+
 type MirSource int32
 
 const (
@@ -119,11 +340,11 @@ func (x MirSource) String() string {
 }
 
 func (MirSource) Descriptor() protoreflect.EnumDescriptor {
-	return file_sf_cardano_type_v1_type_proto_enumTypes[1].Descriptor()
+	return file_sf_cardano_type_v1_type_proto_enumTypes[5].Descriptor()
 }
 
 func (MirSource) Type() protoreflect.EnumType {
-	return &file_sf_cardano_type_v1_type_proto_enumTypes[1]
+	return &file_sf_cardano_type_v1_type_proto_enumTypes[5]
 }
 
 func (x MirSource) Number() protoreflect.EnumNumber {
@@ -132,19 +353,21 @@ func (x MirSource) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use MirSource.Descriptor instead.
 func (MirSource) EnumDescriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{1}
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{5}
 }
 
 // Redeemer information for a Plutus script.
 type Redeemer struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Purpose       RedeemerPurpose        `protobuf:"varint,1,opt,name=purpose,proto3,enum=sf.cardano.type.v1.RedeemerPurpose" json:"purpose,omitempty"` // Purpose of the redeemer.
-	Payload       *PlutusData            `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`                                          // Plutus data associated with the redeemer.
-	Index         uint32                 `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`                                             // Index of the redeemer.
-	ExUnits       *ExUnits               `protobuf:"bytes,4,opt,name=ex_units,json=exUnits,proto3" json:"ex_units,omitempty"`                           // Execution units consumed by the redeemer.
-	OriginalCbor  []byte                 `protobuf:"bytes,5,opt,name=original_cbor,json=originalCbor,proto3" json:"original_cbor,omitempty"`            // Original cbor-encoded data as seen on-chain
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Purpose        RedeemerPurpose        `protobuf:"varint,1,opt,name=purpose,proto3,enum=sf.cardano.type.v1.RedeemerPurpose" json:"purpose,omitempty"`                                    // Purpose of the redeemer.
+	Payload        *PlutusData            `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`                                                                             // Plutus data associated with the redeemer.
+	Index          uint32                 `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`                                                                                // Index of the redeemer.
+	ExUnits        *ExUnits               `protobuf:"bytes,4,opt,name=ex_units,json=exUnits,proto3" json:"ex_units,omitempty"`                                                              // Execution units consumed by the redeemer.
+	OriginalCbor   []byte                 `protobuf:"bytes,5,opt,name=original_cbor,json=originalCbor,proto3" json:"original_cbor,omitempty"`                                               // Original cbor-encoded data as seen on-chain
+	ScriptHash     []byte                 `protobuf:"bytes,6,opt,name=script_hash,json=scriptHash,proto3" json:"script_hash,omitempty"`                                                     // Hash of the script the redeemer is for
+	ScriptLanguage ScriptLanguage         `protobuf:"varint,7,opt,name=script_language,json=scriptLanguage,proto3,enum=sf.cardano.type.v1.ScriptLanguage" json:"script_language,omitempty"` // Language of the script, unspecified when it is unknown
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *Redeemer) Reset() {
@@ -212,6 +435,20 @@ func (x *Redeemer) GetOriginalCbor() []byte {
 	return nil
 }
 
+func (x *Redeemer) GetScriptHash() []byte {
+	if x != nil {
+		return x.ScriptHash
+	}
+	return nil
+}
+
+func (x *Redeemer) GetScriptLanguage() ScriptLanguage {
+	if x != nil {
+		return x.ScriptLanguage
+	}
+	return ScriptLanguage_SCRIPT_LANGUAGE_UNSPECIFIED
+}
+
 // Represents a transaction input in the Cardano blockchain.
 type TxInput struct {
 	state       protoimpl.MessageState `protogen:"open.v1"`
@@ -285,11 +522,12 @@ func (x *TxInput) GetRedeemer() *Redeemer {
 // Represents a transaction output in the Cardano blockchain.
 type TxOutput struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Address       []byte                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"` // Address receiving the output.
-	Coin          uint64                 `protobuf:"varint,2,opt,name=coin,proto3" json:"coin,omitempty"`      // Amount of ADA in the output.
-	Assets        []*Multiasset          `protobuf:"bytes,3,rep,name=assets,proto3" json:"assets,omitempty"`   // Additional native (non-ADA) assets in the output.
-	Datum         *Datum                 `protobuf:"bytes,4,opt,name=datum,proto3" json:"datum,omitempty"`     // Plutus data associated with the output.
-	Script        *Script                `protobuf:"bytes,5,opt,name=script,proto3" json:"script,omitempty"`   // Script associated with the output.
+	Address       []byte                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`                               // Address receiving the output.
+	Coin          uint64                 `protobuf:"varint,2,opt,name=coin,proto3" json:"coin,omitempty"`                                    // Amount of ADA in the output.
+	Assets        []*Multiasset          `protobuf:"bytes,3,rep,name=assets,proto3" json:"assets,omitempty"`                                 // Additional native (non-ADA) assets in the output.
+	Datum         *Datum                 `protobuf:"bytes,4,opt,name=datum,proto3" json:"datum,omitempty"`                                   // Plutus data associated with the output.
+	Script        *Script                `protobuf:"bytes,5,opt,name=script,proto3" json:"script,omitempty"`                                 // Script associated with the output.
+	ByronAddress  *ByronAddress          `protobuf:"bytes,6,opt,name=byron_address,json=byronAddress,proto3" json:"byron_address,omitempty"` // Decoded address, for Byron addresses.
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -359,6 +597,13 @@ func (x *TxOutput) GetScript() *Script {
 	return nil
 }
 
+func (x *TxOutput) GetByronAddress() *ByronAddress {
+	if x != nil {
+		return x.ByronAddress
+	}
+	return nil
+}
+
 type Datum struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Hash          []byte                 `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`                                     // Hash of this datum as seen on-chain
@@ -541,13 +786,19 @@ func (x *Multiasset) GetRedeemer() *Redeemer {
 	return nil
 }
 
-// Represents the validity interval of a transaction.
+// Represents the validity interval of a transaction. start and ttl are 0
+// when the bound is absent, which cannot be told from a bound at slot 0; use
+// invalid_before and invalid_hereafter instead.
 type TxValidity struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Start         uint64                 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"` // Start of the validity interval.
-	Ttl           uint64                 `protobuf:"varint,2,opt,name=ttl,proto3" json:"ttl,omitempty"`     // End of the validity interval (TTL: Time to Live).
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: Marked as deprecated in sf/cardano/type/v1/type.proto.
+	Start uint64 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"` // Start of the validity interval.
+	// Deprecated: Marked as deprecated in sf/cardano/type/v1/type.proto.
+	Ttl              uint64  `protobuf:"varint,2,opt,name=ttl,proto3" json:"ttl,omitempty"`                                                         // End of the validity interval (TTL).
+	InvalidBefore    *uint64 `protobuf:"varint,3,opt,name=invalid_before,json=invalidBefore,proto3,oneof" json:"invalid_before,omitempty"`          // First valid slot, absent when unbounded
+	InvalidHereafter *uint64 `protobuf:"varint,4,opt,name=invalid_hereafter,json=invalidHereafter,proto3,oneof" json:"invalid_hereafter,omitempty"` // First slot past the validity interval, absent when unbounded
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *TxValidity) Reset() {
@@ -580,6 +831,7 @@ func (*TxValidity) Descriptor() ([]byte, []int) {
 	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{6}
 }
 
+// Deprecated: Marked as deprecated in sf/cardano/type/v1/type.proto.
 func (x *TxValidity) GetStart() uint64 {
 	if x != nil {
 		return x.Start
@@ -587,6 +839,7 @@ func (x *TxValidity) GetStart() uint64 {
 	return 0
 }
 
+// Deprecated: Marked as deprecated in sf/cardano/type/v1/type.proto.
 func (x *TxValidity) GetTtl() uint64 {
 	if x != nil {
 		return x.Ttl
@@ -594,14 +847,30 @@ func (x *TxValidity) GetTtl() uint64 {
 	return 0
 }
 
+func (x *TxValidity) GetInvalidBefore() uint64 {
+	if x != nil && x.InvalidBefore != nil {
+		return *x.InvalidBefore
+	}
+	return 0
+}
+
+func (x *TxValidity) GetInvalidHereafter() uint64 {
+	if x != nil && x.InvalidHereafter != nil {
+		return *x.InvalidHereafter
+	}
+	return 0
+}
+
 // Represents the collateral information for a transaction.
 type Collateral struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
 	Collateral       []*TxInput             `protobuf:"bytes,1,rep,name=collateral,proto3" json:"collateral,omitempty"`                                     // Collateral inputs for the transaction.
 	CollateralReturn *TxOutput              `protobuf:"bytes,2,opt,name=collateral_return,json=collateralReturn,proto3" json:"collateral_return,omitempty"` // Collateral return in case of script failure.
-	TotalCollateral  uint64                 `protobuf:"varint,3,opt,name=total_collateral,json=totalCollateral,proto3" json:"total_collateral,omitempty"`   // Total amount of collateral.
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	// Deprecated: Marked as deprecated in sf/cardano/type/v1/type.proto.
+	TotalCollateral         uint64  `protobuf:"varint,3,opt,name=total_collateral,json=totalCollateral,proto3" json:"total_collateral,omitempty"`                                 // Total amount of collateral, 0 when not declared.
+	DeclaredTotalCollateral *uint64 `protobuf:"varint,4,opt,name=declared_total_collateral,json=declaredTotalCollateral,proto3,oneof" json:"declared_total_collateral,omitempty"` // Total amount of collateral, absent when not declared
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
 }
 
 func (x *Collateral) Reset() {
@@ -648,6 +917,7 @@ func (x *Collateral) GetCollateralReturn() *TxOutput {
 	return nil
 }
 
+// Deprecated: Marked as deprecated in sf/cardano/type/v1/type.proto.
 func (x *Collateral) GetTotalCollateral() uint64 {
 	if x != nil {
 		return x.TotalCollateral
@@ -655,6 +925,13 @@ func (x *Collateral) GetTotalCollateral() uint64 {
 	return 0
 }
 
+func (x *Collateral) GetDeclaredTotalCollateral() uint64 {
+	if x != nil && x.DeclaredTotalCollateral != nil {
+		return *x.DeclaredTotalCollateral
+	}
+	return 0
+}
+
 // Represents a withdrawal from a reward account.
 type Withdrawal struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -718,12 +995,14 @@ func (x *Withdrawal) GetRedeemer() *Redeemer {
 
 // Represents a set of witnesses that validate a transaction
 type WitnessSet struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Vkeywitness   []*VKeyWitness         `protobuf:"bytes,1,rep,name=vkeywitness,proto3" json:"vkeywitness,omitempty"`                       // List of VKey witnesses.
-	Script        []*Script              `protobuf:"bytes,2,rep,name=script,proto3" json:"script,omitempty"`                                 // List of scripts.
-	PlutusDatums  []*PlutusData          `protobuf:"bytes,3,rep,name=plutus_datums,json=plutusDatums,proto3" json:"plutus_datums,omitempty"` // List of Plutus data elements associated with the transaction.
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Vkeywitness        []*VKeyWitness         `protobuf:"bytes,1,rep,name=vkeywitness,proto3" json:"vkeywitness,omitempty"`                                         // List of VKey witnesses.
+	Script             []*Script              `protobuf:"bytes,2,rep,name=script,proto3" json:"script,omitempty"`                                                   // List of scripts.
+	PlutusDatums       []*PlutusData          `protobuf:"bytes,3,rep,name=plutus_datums,json=plutusDatums,proto3" json:"plutus_datums,omitempty"`                   // List of Plutus data elements associated with the transaction.
+	Redeemers          []*Redeemer            `protobuf:"bytes,4,rep,name=redeemers,proto3" json:"redeemers,omitempty"`                                             // List of redeemers.
+	BootstrapWitnesses []*BootstrapWitness    `protobuf:"bytes,5,rep,name=bootstrap_witnesses,json=bootstrapWitnesses,proto3" json:"bootstrap_witnesses,omitempty"` // Witnesses of Byron address keys.
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *WitnessSet) Reset() {
@@ -777,6 +1056,98 @@ func (x *WitnessSet) GetPlutusDatums() []*PlutusData {
 	return nil
 }
 
+func (x *WitnessSet) GetRedeemers() []*Redeemer {
+	if x != nil {
+		return x.Redeemers
+	}
+	return nil
+}
+
+func (x *WitnessSet) GetBootstrapWitnesses() []*BootstrapWitness {
+	if x != nil {
+		return x.BootstrapWitnesses
+	}
+	return nil
+}
+
+// A witness by a Byron address key: a bootstrap witness, or the witness of a
+// Byron transaction.
+type BootstrapWitness struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vkey          []byte                 `protobuf:"bytes,1,opt,name=vkey,proto3" json:"vkey,omitempty"`
+	Signature     []byte                 `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	ChainCode     []byte                 `protobuf:"bytes,3,opt,name=chain_code,json=chainCode,proto3" json:"chain_code,omitempty"`
+	Attributes    []byte                 `protobuf:"bytes,4,opt,name=attributes,proto3" json:"attributes,omitempty"` // CBOR-encoded address attributes
+	Redeem        bool                   `protobuf:"varint,5,opt,name=redeem,proto3" json:"redeem,omitempty"`        // Byron redeem witness, vkey being a redeem key
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BootstrapWitness) Reset() {
+	*x = BootstrapWitness{}
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BootstrapWitness) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BootstrapWitness) ProtoMessage() {}
+
+func (x *BootstrapWitness) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BootstrapWitness.ProtoReflect.Descriptor instead.
+func (*BootstrapWitness) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *BootstrapWitness) GetVkey() []byte {
+	if x != nil {
+		return x.Vkey
+	}
+	return nil
+}
+
+func (x *BootstrapWitness) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *BootstrapWitness) GetChainCode() []byte {
+	if x != nil {
+		return x.ChainCode
+	}
+	return nil
+}
+
+func (x *BootstrapWitness) GetAttributes() []byte {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *BootstrapWitness) GetRedeem() bool {
+	if x != nil {
+		return x.Redeem
+	}
+	return false
+}
+
 // Auxiliary data not directly tied to the validation process
 type AuxData struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -788,7 +1159,7 @@ type AuxData struct {
 
 func (x *AuxData) Reset() {
 	*x = AuxData{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[10]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -800,7 +1171,7 @@ func (x *AuxData) String() string {
 func (*AuxData) ProtoMessage() {}
 
 func (x *AuxData) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[10]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -813,7 +1184,7 @@ func (x *AuxData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuxData.ProtoReflect.Descriptor instead.
 func (*AuxData) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{10}
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *AuxData) GetMetadata() []*Metadata {
@@ -832,28 +1203,46 @@ func (x *AuxData) GetScripts() []*Script {
 
 // Represents a transaction in the Cardano blockchain.
 type Tx struct {
-	state           protoimpl.MessageState      `protogen:"open.v1"`
-	Inputs          []*TxInput                  `protobuf:"bytes,1,rep,name=inputs,proto3" json:"inputs,omitempty"`                                          // List of transaction inputs
-	Outputs         []*TxOutput                 `protobuf:"bytes,2,rep,name=outputs,proto3" json:"outputs,omitempty"`                                        // List of transaction outputs
-	Certificates    []*Certificate              `protobuf:"bytes,3,rep,name=certificates,proto3" json:"certificates,omitempty"`                              // List of certificates
-	Withdrawals     []*Withdrawal               `protobuf:"bytes,4,rep,name=withdrawals,proto3" json:"withdrawals,omitempty"`                                // List of withdrawals
-	Mint            []*Multiasset               `protobuf:"bytes,5,rep,name=mint,proto3" json:"mint,omitempty"`                                              // List of minted custom assets
-	ReferenceInputs []*TxInput                  `protobuf:"bytes,6,rep,name=reference_inputs,json=referenceInputs,proto3" json:"reference_inputs,omitempty"` // List of reference inputs
-	Witnesses       *WitnessSet                 `protobuf:"bytes,7,opt,name=witnesses,proto3" json:"witnesses,omitempty"`                                    // Witnesses that validte the transaction
-	Collateral      *Collateral                 `protobuf:"bytes,8,opt,name=collateral,proto3" json:"collateral,omitempty"`                                  // Collateral details in case of failed transaction
-	Fee             uint64                      `protobuf:"varint,9,opt,name=fee,proto3" json:"fee,omitempty"`                                               // Transaction fee in ADA
-	Validity        *TxValidity                 `protobuf:"bytes,10,opt,name=validity,proto3" json:"validity,omitempty"`                                     // Validity interval of the transaction
-	Successful      bool                        `protobuf:"varint,11,opt,name=successful,proto3" json:"successful,omitempty"`                                // Flag indicating whether the transaction was successful
-	Auxiliary       *AuxData                    `protobuf:"bytes,12,opt,name=auxiliary,proto3" json:"auxiliary,omitempty"`                                   // Auxiliary data not directly tied to the validation process
-	Hash            []byte                      `protobuf:"bytes,13,opt,name=hash,proto3" json:"hash,omitempty"`                                             // Hash of the transaction that serves as main identifier
-	Proposals       []*GovernanceActionProposal `protobuf:"bytes,14,rep,name=proposals,proto3" json:"proposals,omitempty"`                                   // List of governance actions proposed
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	state                 protoimpl.MessageState      `protogen:"open.v1"`
+	Inputs                []*TxInput                  `protobuf:"bytes,1,rep,name=inputs,proto3" json:"inputs,omitempty"`                                                                   // List of transaction inputs
+	Outputs               []*TxOutput                 `protobuf:"bytes,2,rep,name=outputs,proto3" json:"outputs,omitempty"`                                                                 // List of transaction outputs
+	Certificates          []*Certificate              `protobuf:"bytes,3,rep,name=certificates,proto3" json:"certificates,omitempty"`                                                       // List of certificates
+	Withdrawals           []*Withdrawal               `protobuf:"bytes,4,rep,name=withdrawals,proto3" json:"withdrawals,omitempty"`                                                         // List of withdrawals
+	Mint                  []*Multiasset               `protobuf:"bytes,5,rep,name=mint,proto3" json:"mint,omitempty"`                                                                       // List of minted custom assets
+	ReferenceInputs       []*TxInput                  `protobuf:"bytes,6,rep,name=reference_inputs,json=referenceInputs,proto3" json:"reference_inputs,omitempty"`                          // List of reference inputs
+	Witnesses             *WitnessSet                 `protobuf:"bytes,7,opt,name=witnesses,proto3" json:"witnesses,omitempty"`                                                             // Witnesses that validte the transaction
+	Collateral            *Collateral                 `protobuf:"bytes,8,opt,name=collateral,proto3" json:"collateral,omitempty"`                                                           // Collateral details in case of failed transaction
+	Fee                   uint64                      `protobuf:"varint,9,opt,name=fee,proto3" json:"fee,omitempty"`                                                                        // Transaction fee in ADA
+	Validity              *TxValidity                 `protobuf:"bytes,10,opt,name=validity,proto3" json:"validity,omitempty"`                                                              // Validity interval of the transaction
+	Successful            bool                        `protobuf:"varint,11,opt,name=successful,proto3" json:"successful,omitempty"`                                                         // Flag indicating whether the transaction was successful
+	Auxiliary             *AuxData                    `protobuf:"bytes,12,opt,name=auxiliary,proto3" json:"auxiliary,omitempty"`                                                            // Auxiliary data not directly tied to the validation process
+	Hash                  []byte                      `protobuf:"bytes,13,opt,name=hash,proto3" json:"hash,omitempty"`                                                                      // Hash of the transaction that serves as main identifier
+	Proposals             []*GovernanceActionProposal `protobuf:"bytes,14,rep,name=proposals,proto3" json:"proposals,omitempty"`                                                            // List of governance actions proposed
+	VotingProcedures      []*VotingProcedure          `protobuf:"bytes,15,rep,name=voting_procedures,json=votingProcedures,proto3" json:"voting_procedures,omitempty"`                      // List of votes cast on governance actions
+	RequiredSigners       [][]byte                    `protobuf:"bytes,16,rep,name=required_signers,json=requiredSigners,proto3" json:"required_signers,omitempty"`                         // Key hashes required to sign the transaction
+	ScriptDataHash        []byte                      `protobuf:"bytes,17,opt,name=script_data_hash,json=scriptDataHash,proto3" json:"script_data_hash,omitempty"`                          // Hash of the redeemers, datums and cost models of the transaction
+	NetworkId             *uint32                     `protobuf:"varint,18,opt,name=network_id,json=networkId,proto3,oneof" json:"network_id,omitempty"`                                    // Network the transaction is meant for
+	CurrentTreasuryValue  *uint64                     `protobuf:"varint,19,opt,name=current_treasury_value,json=currentTreasuryValue,proto3,oneof" json:"current_treasury_value,omitempty"` // Treasury value the transaction expects
+	TreasuryDonation      *uint64                     `protobuf:"varint,20,opt,name=treasury_donation,json=treasuryDonation,proto3,oneof" json:"treasury_donation,omitempty"`               // Amount donated to the treasury
+	AuxiliaryDataHash     []byte                      `protobuf:"bytes,21,opt,name=auxiliary_data_hash,json=auxiliaryDataHash,proto3" json:"auxiliary_data_hash,omitempty"`                 // Hash of the auxiliary data
+	Index                 uint32                      `protobuf:"varint,22,opt,name=index,proto3" json:"index,omitempty"`                                                                   // Index of the transaction within its block
+	Size                  uint64                      `protobuf:"varint,23,opt,name=size,proto3" json:"size,omitempty"`                                                                     // Size of the serialized transaction in bytes
+	OriginalBodyCbor      []byte                      `protobuf:"bytes,24,opt,name=original_body_cbor,json=originalBodyCbor,proto3" json:"original_body_cbor,omitempty"`                    // Original cbor-encoded transaction body (opt-in)
+	OriginalWitnessesCbor []byte                      `protobuf:"bytes,25,opt,name=original_witnesses_cbor,json=originalWitnessesCbor,proto3" json:"original_witnesses_cbor,omitempty"`     // Original cbor-encoded witness set (opt-in)
+	OriginalAuxiliaryCbor []byte                      `protobuf:"bytes,26,opt,name=original_auxiliary_cbor,json=originalAuxiliaryCbor,proto3" json:"original_auxiliary_cbor,omitempty"`     // Original cbor-encoded auxiliary data, if any (opt-in)
+	ExecutedScripts       []*ExecutedScript           `protobuf:"bytes,27,rep,name=executed_scripts,json=executedScripts,proto3" json:"executed_scripts,omitempty"`                         // Scripts run to validate the transaction
+	DecodedMetadata       []*DecodedMetadata          `protobuf:"bytes,28,rep,name=decoded_metadata,json=decodedMetadata,proto3" json:"decoded_metadata,omitempty"`                         // Metadata decoded according to its CIP (opt-in)
+	Cip68Tokens           []*Cip68Token               `protobuf:"bytes,29,rep,name=cip68_tokens,json=cip68Tokens,proto3" json:"cip68_tokens,omitempty"`                                     // CIP-68 tokens minted, burnt or output (opt-in)
+	Cip68Updates          []*Cip68MetadataUpdate      `protobuf:"bytes,30,rep,name=cip68_updates,json=cip68Updates,proto3" json:"cip68_updates,omitempty"`                                  // CIP-68 reference datums set by the transaction (opt-in)
+	Effect                *LedgerEffect               `protobuf:"bytes,31,opt,name=effect,proto3" json:"effect,omitempty"`                                                                  // What the ledger applies of the transaction
+	Update                *ProtocolParamUpdate        `protobuf:"bytes,32,opt,name=update,proto3" json:"update,omitempty"`                                                                  // Protocol parameter updates proposed (Shelley to Babbage)
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
 func (x *Tx) Reset() {
 	*x = Tx{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[11]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -865,7 +1254,7 @@ func (x *Tx) String() string {
 func (*Tx) ProtoMessage() {}
 
 func (x *Tx) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[11]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -878,7 +1267,7 @@ func (x *Tx) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Tx.ProtoReflect.Descriptor instead.
 func (*Tx) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{11}
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *Tx) GetInputs() []*TxInput {
@@ -979,262 +1368,228 @@ func (x *Tx) GetProposals() []*GovernanceActionProposal {
 	return nil
 }
 
-// Define a governance action proposal
-type GovernanceActionProposal struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Deposit       uint64                 `protobuf:"varint,1,opt,name=deposit,proto3" json:"deposit,omitempty"`                                 // The amount deposited for the governance action
-	RewardAccount []byte                 `protobuf:"bytes,2,opt,name=reward_account,json=rewardAccount,proto3" json:"reward_account,omitempty"` // The reward account the deposit should be returned to
-	GovAction     *GovernanceAction      `protobuf:"bytes,3,opt,name=gov_action,json=govAction,proto3" json:"gov_action,omitempty"`
-	Anchor        *Anchor                `protobuf:"bytes,4,opt,name=anchor,proto3" json:"anchor,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Tx) GetVotingProcedures() []*VotingProcedure {
+	if x != nil {
+		return x.VotingProcedures
+	}
+	return nil
 }
 
-func (x *GovernanceActionProposal) Reset() {
-	*x = GovernanceActionProposal{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[12]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Tx) GetRequiredSigners() [][]byte {
+	if x != nil {
+		return x.RequiredSigners
+	}
+	return nil
 }
 
-func (x *GovernanceActionProposal) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GovernanceActionProposal) ProtoMessage() {}
-
-func (x *GovernanceActionProposal) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[12]
+func (x *Tx) GetScriptDataHash() []byte {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ScriptDataHash
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use GovernanceActionProposal.ProtoReflect.Descriptor instead.
-func (*GovernanceActionProposal) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{12}
+func (x *Tx) GetNetworkId() uint32 {
+	if x != nil && x.NetworkId != nil {
+		return *x.NetworkId
+	}
+	return 0
 }
 
-func (x *GovernanceActionProposal) GetDeposit() uint64 {
-	if x != nil {
-		return x.Deposit
+func (x *Tx) GetCurrentTreasuryValue() uint64 {
+	if x != nil && x.CurrentTreasuryValue != nil {
+		return *x.CurrentTreasuryValue
 	}
 	return 0
 }
 
-func (x *GovernanceActionProposal) GetRewardAccount() []byte {
-	if x != nil {
-		return x.RewardAccount
+func (x *Tx) GetTreasuryDonation() uint64 {
+	if x != nil && x.TreasuryDonation != nil {
+		return *x.TreasuryDonation
 	}
-	return nil
+	return 0
 }
 
-func (x *GovernanceActionProposal) GetGovAction() *GovernanceAction {
+func (x *Tx) GetAuxiliaryDataHash() []byte {
 	if x != nil {
-		return x.GovAction
+		return x.AuxiliaryDataHash
 	}
 	return nil
 }
 
-func (x *GovernanceActionProposal) GetAnchor() *Anchor {
+func (x *Tx) GetIndex() uint32 {
 	if x != nil {
-		return x.Anchor
+		return x.Index
 	}
-	return nil
-}
-
-// Define a Governance Action
-type GovernanceAction struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to GovernanceAction:
-	//
-	//	*GovernanceAction_ParameterChangeAction
-	//	*GovernanceAction_HardForkInitiationAction
-	//	*GovernanceAction_TreasuryWithdrawalsAction
-	//	*GovernanceAction_NoConfidenceAction
-	//	*GovernanceAction_UpdateCommitteeAction
-	//	*GovernanceAction_NewConstitutionAction
-	//	*GovernanceAction_InfoAction
-	GovernanceAction isGovernanceAction_GovernanceAction `protobuf_oneof:"governance_action"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
-}
-
-func (x *GovernanceAction) Reset() {
-	*x = GovernanceAction{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[13]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GovernanceAction) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return 0
 }
 
-func (*GovernanceAction) ProtoMessage() {}
-
-func (x *GovernanceAction) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[13]
+func (x *Tx) GetSize() uint64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Size
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GovernanceAction.ProtoReflect.Descriptor instead.
-func (*GovernanceAction) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{13}
+func (x *Tx) GetOriginalBodyCbor() []byte {
+	if x != nil {
+		return x.OriginalBodyCbor
+	}
+	return nil
 }
 
-func (x *GovernanceAction) GetGovernanceAction() isGovernanceAction_GovernanceAction {
+func (x *Tx) GetOriginalWitnessesCbor() []byte {
 	if x != nil {
-		return x.GovernanceAction
+		return x.OriginalWitnessesCbor
 	}
 	return nil
 }
 
-func (x *GovernanceAction) GetParameterChangeAction() *ParameterChangeAction {
+func (x *Tx) GetOriginalAuxiliaryCbor() []byte {
 	if x != nil {
-		if x, ok := x.GovernanceAction.(*GovernanceAction_ParameterChangeAction); ok {
-			return x.ParameterChangeAction
-		}
+		return x.OriginalAuxiliaryCbor
 	}
 	return nil
 }
 
-func (x *GovernanceAction) GetHardForkInitiationAction() *HardForkInitiationAction {
+func (x *Tx) GetExecutedScripts() []*ExecutedScript {
 	if x != nil {
-		if x, ok := x.GovernanceAction.(*GovernanceAction_HardForkInitiationAction); ok {
-			return x.HardForkInitiationAction
-		}
+		return x.ExecutedScripts
 	}
 	return nil
 }
 
-func (x *GovernanceAction) GetTreasuryWithdrawalsAction() *TreasuryWithdrawalsAction {
+func (x *Tx) GetDecodedMetadata() []*DecodedMetadata {
 	if x != nil {
-		if x, ok := x.GovernanceAction.(*GovernanceAction_TreasuryWithdrawalsAction); ok {
-			return x.TreasuryWithdrawalsAction
-		}
+		return x.DecodedMetadata
 	}
 	return nil
 }
 
-func (x *GovernanceAction) GetNoConfidenceAction() *NoConfidenceAction {
+func (x *Tx) GetCip68Tokens() []*Cip68Token {
 	if x != nil {
-		if x, ok := x.GovernanceAction.(*GovernanceAction_NoConfidenceAction); ok {
-			return x.NoConfidenceAction
-		}
+		return x.Cip68Tokens
 	}
 	return nil
 }
 
-func (x *GovernanceAction) GetUpdateCommitteeAction() *UpdateCommitteeAction {
+func (x *Tx) GetCip68Updates() []*Cip68MetadataUpdate {
 	if x != nil {
-		if x, ok := x.GovernanceAction.(*GovernanceAction_UpdateCommitteeAction); ok {
-			return x.UpdateCommitteeAction
-		}
+		return x.Cip68Updates
 	}
 	return nil
 }
 
-func (x *GovernanceAction) GetNewConstitutionAction() *NewConstitutionAction {
+func (x *Tx) GetEffect() *LedgerEffect {
 	if x != nil {
-		if x, ok := x.GovernanceAction.(*GovernanceAction_NewConstitutionAction); ok {
-			return x.NewConstitutionAction
-		}
+		return x.Effect
 	}
 	return nil
 }
 
-func (x *GovernanceAction) GetInfoAction() uint32 {
+func (x *Tx) GetUpdate() *ProtocolParamUpdate {
 	if x != nil {
-		if x, ok := x.GovernanceAction.(*GovernanceAction_InfoAction); ok {
-			return x.InfoAction
-		}
+		return x.Update
 	}
-	return 0
+	return nil
 }
 
-type isGovernanceAction_GovernanceAction interface {
-	isGovernanceAction_GovernanceAction()
+// What the ledger applies of a transaction. When its scripts fail
+// (successful = false), a transaction only spends its collateral inputs and
+// creates its collateral return: its inputs, outputs, mint, certificates,
+// withdrawals, votes, proposals and donation are void.
+type LedgerEffect struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Spent   []*TxInputRef          `protobuf:"bytes,1,rep,name=spent,proto3" json:"spent,omitempty"`     // Outputs the transaction spends
+	Created []*TxOutputRef         `protobuf:"bytes,2,rep,name=created,proto3" json:"created,omitempty"` // Outputs the transaction creates
+	Fee     *uint64                `protobuf:"varint,3,opt,name=fee,proto3,oneof" json:"fee,omitempty"`  // Lovelace collected: the fee, or the collateral forfeited. Absent
+	// when unknown: a Byron fee or forfeited collateral whose inputs
+	// are not all resolved, without a declared total collateral
+	BodyApplied   bool `protobuf:"varint,4,opt,name=body_applied,json=bodyApplied,proto3" json:"body_applied,omitempty"` // Mint, certificates, withdrawals and governance take effect
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-type GovernanceAction_ParameterChangeAction struct {
-	ParameterChangeAction *ParameterChangeAction `protobuf:"bytes,1,opt,name=parameter_change_action,json=parameterChangeAction,proto3,oneof"` // Change on-chain parameters
+func (x *LedgerEffect) Reset() {
+	*x = LedgerEffect{}
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-type GovernanceAction_HardForkInitiationAction struct {
-	HardForkInitiationAction *HardForkInitiationAction `protobuf:"bytes,2,opt,name=hard_fork_initiation_action,json=hardForkInitiationAction,proto3,oneof"` // Initiate a Hard Fork
+func (x *LedgerEffect) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type GovernanceAction_TreasuryWithdrawalsAction struct {
-	TreasuryWithdrawalsAction *TreasuryWithdrawalsAction `protobuf:"bytes,3,opt,name=treasury_withdrawals_action,json=treasuryWithdrawalsAction,proto3,oneof"` // Withdraw from the Treasury
-}
+func (*LedgerEffect) ProtoMessage() {}
 
-type GovernanceAction_NoConfidenceAction struct {
-	NoConfidenceAction *NoConfidenceAction `protobuf:"bytes,4,opt,name=no_confidence_action,json=noConfidenceAction,proto3,oneof"` //
+func (x *LedgerEffect) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type GovernanceAction_UpdateCommitteeAction struct {
-	UpdateCommitteeAction *UpdateCommitteeAction `protobuf:"bytes,5,opt,name=update_committee_action,json=updateCommitteeAction,proto3,oneof"` // Update the Constitution Committee
+// Deprecated: Use LedgerEffect.ProtoReflect.Descriptor instead.
+func (*LedgerEffect) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{13}
 }
 
-type GovernanceAction_NewConstitutionAction struct {
-	NewConstitutionAction *NewConstitutionAction `protobuf:"bytes,6,opt,name=new_constitution_action,json=newConstitutionAction,proto3,oneof"` // Replace the Constitution
+func (x *LedgerEffect) GetSpent() []*TxInputRef {
+	if x != nil {
+		return x.Spent
+	}
+	return nil
 }
 
-type GovernanceAction_InfoAction struct {
-	// TODO: revisit if there's a better way to handle this option because it
-	// doesn't actually need a value but proto syntax needs to require it
-	InfoAction uint32 `protobuf:"varint,7,opt,name=info_action,json=infoAction,proto3,oneof"` // Info action should just be the integer number 6
+func (x *LedgerEffect) GetCreated() []*TxOutputRef {
+	if x != nil {
+		return x.Created
+	}
+	return nil
 }
 
-func (*GovernanceAction_ParameterChangeAction) isGovernanceAction_GovernanceAction() {}
-
-func (*GovernanceAction_HardForkInitiationAction) isGovernanceAction_GovernanceAction() {}
-
-func (*GovernanceAction_TreasuryWithdrawalsAction) isGovernanceAction_GovernanceAction() {}
-
-func (*GovernanceAction_NoConfidenceAction) isGovernanceAction_GovernanceAction() {}
-
-func (*GovernanceAction_UpdateCommitteeAction) isGovernanceAction_GovernanceAction() {}
-
-func (*GovernanceAction_NewConstitutionAction) isGovernanceAction_GovernanceAction() {}
+func (x *LedgerEffect) GetFee() uint64 {
+	if x != nil && x.Fee != nil {
+		return *x.Fee
+	}
+	return 0
+}
 
-func (*GovernanceAction_InfoAction) isGovernanceAction_GovernanceAction() {}
+func (x *LedgerEffect) GetBodyApplied() bool {
+	if x != nil {
+		return x.BodyApplied
+	}
+	return false
+}
 
-type GovernanceActionId struct {
-	state                 protoimpl.MessageState `protogen:"open.v1"`
-	TransactionId         []byte                 `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
-	GovernanceActionIndex uint32                 `protobuf:"varint,2,opt,name=governance_action_index,json=governanceActionIndex,proto3" json:"governance_action_index,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+type TxInputRef struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Collateral    bool                   `protobuf:"varint,1,opt,name=collateral,proto3" json:"collateral,omitempty"` // The input is collateral[index], inputs[index] otherwise
+	Index         uint32                 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GovernanceActionId) Reset() {
-	*x = GovernanceActionId{}
+func (x *TxInputRef) Reset() {
+	*x = TxInputRef{}
 	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GovernanceActionId) String() string {
+func (x *TxInputRef) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GovernanceActionId) ProtoMessage() {}
+func (*TxInputRef) ProtoMessage() {}
 
-func (x *GovernanceActionId) ProtoReflect() protoreflect.Message {
+func (x *TxInputRef) ProtoReflect() protoreflect.Message {
 	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1246,48 +1601,47 @@ func (x *GovernanceActionId) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GovernanceActionId.ProtoReflect.Descriptor instead.
-func (*GovernanceActionId) Descriptor() ([]byte, []int) {
+// Deprecated: Use TxInputRef.ProtoReflect.Descriptor instead.
+func (*TxInputRef) Descriptor() ([]byte, []int) {
 	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *GovernanceActionId) GetTransactionId() []byte {
+func (x *TxInputRef) GetCollateral() bool {
 	if x != nil {
-		return x.TransactionId
+		return x.Collateral
 	}
-	return nil
+	return false
 }
 
-func (x *GovernanceActionId) GetGovernanceActionIndex() uint32 {
+func (x *TxInputRef) GetIndex() uint32 {
 	if x != nil {
-		return x.GovernanceActionIndex
+		return x.Index
 	}
 	return 0
 }
 
-type ParameterChangeAction struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	GovActionId         *GovernanceActionId    `protobuf:"bytes,1,opt,name=gov_action_id,json=govActionId,proto3" json:"gov_action_id,omitempty"`
-	ProtocolParamUpdate *PParams               `protobuf:"bytes,2,opt,name=protocol_param_update,json=protocolParamUpdate,proto3" json:"protocol_param_update,omitempty"` // The updates proposed
-	PolicyHash          []byte                 `protobuf:"bytes,3,opt,name=policy_hash,json=policyHash,proto3" json:"policy_hash,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+type TxOutputRef struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	CollateralReturn bool                   `protobuf:"varint,1,opt,name=collateral_return,json=collateralReturn,proto3" json:"collateral_return,omitempty"` // The output is the collateral return, outputs[index] otherwise
+	Index            uint32                 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`                                               // Output index the created output is spent by
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *ParameterChangeAction) Reset() {
-	*x = ParameterChangeAction{}
+func (x *TxOutputRef) Reset() {
+	*x = TxOutputRef{}
 	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ParameterChangeAction) String() string {
+func (x *TxOutputRef) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ParameterChangeAction) ProtoMessage() {}
+func (*TxOutputRef) ProtoMessage() {}
 
-func (x *ParameterChangeAction) ProtoReflect() protoreflect.Message {
+func (x *TxOutputRef) ProtoReflect() protoreflect.Message {
 	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1299,54 +1653,50 @@ func (x *ParameterChangeAction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ParameterChangeAction.ProtoReflect.Descriptor instead.
-func (*ParameterChangeAction) Descriptor() ([]byte, []int) {
+// Deprecated: Use TxOutputRef.ProtoReflect.Descriptor instead.
+func (*TxOutputRef) Descriptor() ([]byte, []int) {
 	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *ParameterChangeAction) GetGovActionId() *GovernanceActionId {
-	if x != nil {
-		return x.GovActionId
-	}
-	return nil
-}
-
-func (x *ParameterChangeAction) GetProtocolParamUpdate() *PParams {
+func (x *TxOutputRef) GetCollateralReturn() bool {
 	if x != nil {
-		return x.ProtocolParamUpdate
+		return x.CollateralReturn
 	}
-	return nil
+	return false
 }
 
-func (x *ParameterChangeAction) GetPolicyHash() []byte {
+func (x *TxOutputRef) GetIndex() uint32 {
 	if x != nil {
-		return x.PolicyHash
+		return x.Index
 	}
-	return nil
+	return 0
 }
 
-type HardForkInitiationAction struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	GovActionId     *GovernanceActionId    `protobuf:"bytes,1,opt,name=gov_action_id,json=govActionId,proto3" json:"gov_action_id,omitempty"`
-	ProtocolVersion *ProtocolVersion       `protobuf:"bytes,2,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"` // The protocol version to fork to
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+// Protocol parameter updates proposed by genesis delegates through the body
+// of a transaction, before Conway. An update is adopted at the start of its
+// epoch when a quorum of delegates proposed the same changes for it.
+type ProtocolParamUpdate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Epoch         uint64                 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`        // Epoch the updates are proposed for
+	Proposals     []*GenesisParamUpdate  `protobuf:"bytes,2,rep,name=proposals,proto3" json:"proposals,omitempty"` // Proposals, ordered by genesis key hash
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *HardForkInitiationAction) Reset() {
-	*x = HardForkInitiationAction{}
+func (x *ProtocolParamUpdate) Reset() {
+	*x = ProtocolParamUpdate{}
 	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HardForkInitiationAction) String() string {
+func (x *ProtocolParamUpdate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HardForkInitiationAction) ProtoMessage() {}
+func (*ProtocolParamUpdate) ProtoMessage() {}
 
-func (x *HardForkInitiationAction) ProtoReflect() protoreflect.Message {
+func (x *ProtocolParamUpdate) ProtoReflect() protoreflect.Message {
 	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1358,47 +1708,47 @@ func (x *HardForkInitiationAction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HardForkInitiationAction.ProtoReflect.Descriptor instead.
-func (*HardForkInitiationAction) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProtocolParamUpdate.ProtoReflect.Descriptor instead.
+func (*ProtocolParamUpdate) Descriptor() ([]byte, []int) {
 	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *HardForkInitiationAction) GetGovActionId() *GovernanceActionId {
+func (x *ProtocolParamUpdate) GetEpoch() uint64 {
 	if x != nil {
-		return x.GovActionId
+		return x.Epoch
 	}
-	return nil
+	return 0
 }
 
-func (x *HardForkInitiationAction) GetProtocolVersion() *ProtocolVersion {
+func (x *ProtocolParamUpdate) GetProposals() []*GenesisParamUpdate {
 	if x != nil {
-		return x.ProtocolVersion
+		return x.Proposals
 	}
 	return nil
 }
 
-type TreasuryWithdrawalsAction struct {
+type GenesisParamUpdate struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Withdrawals   []*WithdrawalAmount    `protobuf:"bytes,1,rep,name=withdrawals,proto3" json:"withdrawals,omitempty"` // A map of the withdrawals to make
-	PolicyHash    []byte                 `protobuf:"bytes,2,opt,name=policy_hash,json=policyHash,proto3" json:"policy_hash,omitempty"`
+	GenesisHash   []byte                 `protobuf:"bytes,1,opt,name=genesis_hash,json=genesisHash,proto3" json:"genesis_hash,omitempty"` // Genesis key hash of the proposing delegate
+	Params        *PParamsUpdate         `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`                              // Parameters the delegate proposes to change
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TreasuryWithdrawalsAction) Reset() {
-	*x = TreasuryWithdrawalsAction{}
+func (x *GenesisParamUpdate) Reset() {
+	*x = GenesisParamUpdate{}
 	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TreasuryWithdrawalsAction) String() string {
+func (x *GenesisParamUpdate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TreasuryWithdrawalsAction) ProtoMessage() {}
+func (*GenesisParamUpdate) ProtoMessage() {}
 
-func (x *TreasuryWithdrawalsAction) ProtoReflect() protoreflect.Message {
+func (x *GenesisParamUpdate) ProtoReflect() protoreflect.Message {
 	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1410,47 +1760,82 @@ func (x *TreasuryWithdrawalsAction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TreasuryWithdrawalsAction.ProtoReflect.Descriptor instead.
-func (*TreasuryWithdrawalsAction) Descriptor() ([]byte, []int) {
+// Deprecated: Use GenesisParamUpdate.ProtoReflect.Descriptor instead.
+func (*GenesisParamUpdate) Descriptor() ([]byte, []int) {
 	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *TreasuryWithdrawalsAction) GetWithdrawals() []*WithdrawalAmount {
+func (x *GenesisParamUpdate) GetGenesisHash() []byte {
 	if x != nil {
-		return x.Withdrawals
+		return x.GenesisHash
 	}
 	return nil
 }
 
-func (x *TreasuryWithdrawalsAction) GetPolicyHash() []byte {
+func (x *GenesisParamUpdate) GetParams() *PParamsUpdate {
 	if x != nil {
-		return x.PolicyHash
+		return x.Params
 	}
 	return nil
 }
 
-type WithdrawalAmount struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	RewardAccount []byte                 `protobuf:"bytes,1,opt,name=reward_account,json=rewardAccount,proto3" json:"reward_account,omitempty"`
-	Coin          uint64                 `protobuf:"varint,2,opt,name=coin,proto3" json:"coin,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Protocol parameters changed by a pre-Conway update or a Conway parameter
+// change action. Unset fields keep their current value.
+type PParamsUpdate struct {
+	state                           protoimpl.MessageState `protogen:"open.v1"`
+	MinFeeCoefficient               *uint64                `protobuf:"varint,1,opt,name=min_fee_coefficient,json=minFeeCoefficient,proto3,oneof" json:"min_fee_coefficient,omitempty"`
+	MinFeeConstant                  *uint64                `protobuf:"varint,2,opt,name=min_fee_constant,json=minFeeConstant,proto3,oneof" json:"min_fee_constant,omitempty"`
+	MaxBlockBodySize                *uint64                `protobuf:"varint,3,opt,name=max_block_body_size,json=maxBlockBodySize,proto3,oneof" json:"max_block_body_size,omitempty"`
+	MaxTxSize                       *uint64                `protobuf:"varint,4,opt,name=max_tx_size,json=maxTxSize,proto3,oneof" json:"max_tx_size,omitempty"`
+	MaxBlockHeaderSize              *uint64                `protobuf:"varint,5,opt,name=max_block_header_size,json=maxBlockHeaderSize,proto3,oneof" json:"max_block_header_size,omitempty"`
+	StakeKeyDeposit                 *uint64                `protobuf:"varint,6,opt,name=stake_key_deposit,json=stakeKeyDeposit,proto3,oneof" json:"stake_key_deposit,omitempty"`
+	PoolDeposit                     *uint64                `protobuf:"varint,7,opt,name=pool_deposit,json=poolDeposit,proto3,oneof" json:"pool_deposit,omitempty"`
+	PoolRetirementEpochBound        *uint64                `protobuf:"varint,8,opt,name=pool_retirement_epoch_bound,json=poolRetirementEpochBound,proto3,oneof" json:"pool_retirement_epoch_bound,omitempty"`
+	DesiredNumberOfPools            *uint64                `protobuf:"varint,9,opt,name=desired_number_of_pools,json=desiredNumberOfPools,proto3,oneof" json:"desired_number_of_pools,omitempty"`
+	PoolInfluence                   *RationalNumber        `protobuf:"bytes,10,opt,name=pool_influence,json=poolInfluence,proto3" json:"pool_influence,omitempty"`
+	MonetaryExpansion               *RationalNumber        `protobuf:"bytes,11,opt,name=monetary_expansion,json=monetaryExpansion,proto3" json:"monetary_expansion,omitempty"`
+	TreasuryExpansion               *RationalNumber        `protobuf:"bytes,12,opt,name=treasury_expansion,json=treasuryExpansion,proto3" json:"treasury_expansion,omitempty"`
+	Decentralization                *RationalNumber        `protobuf:"bytes,13,opt,name=decentralization,proto3" json:"decentralization,omitempty"`                   // Up to Alonzo
+	ExtraEntropy                    []byte                 `protobuf:"bytes,14,opt,name=extra_entropy,json=extraEntropy,proto3,oneof" json:"extra_entropy,omitempty"` // Up to Alonzo, empty for the neutral nonce
+	ProtocolVersion                 *ProtocolVersion       `protobuf:"bytes,15,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+	MinUtxoValue                    *uint64                `protobuf:"varint,16,opt,name=min_utxo_value,json=minUtxoValue,proto3,oneof" json:"min_utxo_value,omitempty"` // Shelley to Mary
+	MinPoolCost                     *uint64                `protobuf:"varint,17,opt,name=min_pool_cost,json=minPoolCost,proto3,oneof" json:"min_pool_cost,omitempty"`
+	CoinsPerUtxoWord                *uint64                `protobuf:"varint,18,opt,name=coins_per_utxo_word,json=coinsPerUtxoWord,proto3,oneof" json:"coins_per_utxo_word,omitempty"` // Alonzo
+	CoinsPerUtxoByte                *uint64                `protobuf:"varint,19,opt,name=coins_per_utxo_byte,json=coinsPerUtxoByte,proto3,oneof" json:"coins_per_utxo_byte,omitempty"` // Babbage
+	CostModels                      *CostModels            `protobuf:"bytes,20,opt,name=cost_models,json=costModels,proto3" json:"cost_models,omitempty"`                              // Cost models of the languages updated
+	Prices                          *ExPrices              `protobuf:"bytes,21,opt,name=prices,proto3" json:"prices,omitempty"`
+	MaxExecutionUnitsPerTransaction *ExUnits               `protobuf:"bytes,22,opt,name=max_execution_units_per_transaction,json=maxExecutionUnitsPerTransaction,proto3" json:"max_execution_units_per_transaction,omitempty"`
+	MaxExecutionUnitsPerBlock       *ExUnits               `protobuf:"bytes,23,opt,name=max_execution_units_per_block,json=maxExecutionUnitsPerBlock,proto3" json:"max_execution_units_per_block,omitempty"`
+	MaxValueSize                    *uint64                `protobuf:"varint,24,opt,name=max_value_size,json=maxValueSize,proto3,oneof" json:"max_value_size,omitempty"`
+	CollateralPercentage            *uint64                `protobuf:"varint,25,opt,name=collateral_percentage,json=collateralPercentage,proto3,oneof" json:"collateral_percentage,omitempty"`
+	MaxCollateralInputs             *uint64                `protobuf:"varint,26,opt,name=max_collateral_inputs,json=maxCollateralInputs,proto3,oneof" json:"max_collateral_inputs,omitempty"`
+	PoolVotingThresholds            *VotingThresholds      `protobuf:"bytes,27,opt,name=pool_voting_thresholds,json=poolVotingThresholds,proto3" json:"pool_voting_thresholds,omitempty"`                                        // Conway
+	DrepVotingThresholds            *VotingThresholds      `protobuf:"bytes,28,opt,name=drep_voting_thresholds,json=drepVotingThresholds,proto3" json:"drep_voting_thresholds,omitempty"`                                        // Conway
+	MinCommitteeSize                *uint32                `protobuf:"varint,29,opt,name=min_committee_size,json=minCommitteeSize,proto3,oneof" json:"min_committee_size,omitempty"`                                             // Conway
+	CommitteeTermLimit              *uint64                `protobuf:"varint,30,opt,name=committee_term_limit,json=committeeTermLimit,proto3,oneof" json:"committee_term_limit,omitempty"`                                       // Conway
+	GovernanceActionValidityPeriod  *uint64                `protobuf:"varint,31,opt,name=governance_action_validity_period,json=governanceActionValidityPeriod,proto3,oneof" json:"governance_action_validity_period,omitempty"` // Conway
+	GovernanceActionDeposit         *uint64                `protobuf:"varint,32,opt,name=governance_action_deposit,json=governanceActionDeposit,proto3,oneof" json:"governance_action_deposit,omitempty"`                        // Conway
+	DrepDeposit                     *uint64                `protobuf:"varint,33,opt,name=drep_deposit,json=drepDeposit,proto3,oneof" json:"drep_deposit,omitempty"`                                                              // Conway
+	DrepInactivityPeriod            *uint64                `protobuf:"varint,34,opt,name=drep_inactivity_period,json=drepInactivityPeriod,proto3,oneof" json:"drep_inactivity_period,omitempty"`                                 // Conway
+	MinFeeScriptRefCostPerByte      *RationalNumber        `protobuf:"bytes,35,opt,name=min_fee_script_ref_cost_per_byte,json=minFeeScriptRefCostPerByte,proto3" json:"min_fee_script_ref_cost_per_byte,omitempty"`              // Conway
+	unknownFields                   protoimpl.UnknownFields
+	sizeCache                       protoimpl.SizeCache
 }
 
-func (x *WithdrawalAmount) Reset() {
-	*x = WithdrawalAmount{}
+func (x *PParamsUpdate) Reset() {
+	*x = PParamsUpdate{}
 	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WithdrawalAmount) String() string {
+func (x *PParamsUpdate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WithdrawalAmount) ProtoMessage() {}
+func (*PParamsUpdate) ProtoMessage() {}
 
-func (x *WithdrawalAmount) ProtoReflect() protoreflect.Message {
+func (x *PParamsUpdate) ProtoReflect() protoreflect.Message {
 	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1462,212 +1847,282 @@ func (x *WithdrawalAmount) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WithdrawalAmount.ProtoReflect.Descriptor instead.
-func (*WithdrawalAmount) Descriptor() ([]byte, []int) {
+// Deprecated: Use PParamsUpdate.ProtoReflect.Descriptor instead.
+func (*PParamsUpdate) Descriptor() ([]byte, []int) {
 	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *WithdrawalAmount) GetRewardAccount() []byte {
-	if x != nil {
-		return x.RewardAccount
+func (x *PParamsUpdate) GetMinFeeCoefficient() uint64 {
+	if x != nil && x.MinFeeCoefficient != nil {
+		return *x.MinFeeCoefficient
 	}
-	return nil
+	return 0
 }
 
-func (x *WithdrawalAmount) GetCoin() uint64 {
-	if x != nil {
-		return x.Coin
+func (x *PParamsUpdate) GetMinFeeConstant() uint64 {
+	if x != nil && x.MinFeeConstant != nil {
+		return *x.MinFeeConstant
 	}
 	return 0
 }
 
-type NoConfidenceAction struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	GovActionId   *GovernanceActionId    `protobuf:"bytes,1,opt,name=gov_action_id,json=govActionId,proto3" json:"gov_action_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *PParamsUpdate) GetMaxBlockBodySize() uint64 {
+	if x != nil && x.MaxBlockBodySize != nil {
+		return *x.MaxBlockBodySize
+	}
+	return 0
 }
 
-func (x *NoConfidenceAction) Reset() {
-	*x = NoConfidenceAction{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[19]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *PParamsUpdate) GetMaxTxSize() uint64 {
+	if x != nil && x.MaxTxSize != nil {
+		return *x.MaxTxSize
+	}
+	return 0
 }
 
-func (x *NoConfidenceAction) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *PParamsUpdate) GetMaxBlockHeaderSize() uint64 {
+	if x != nil && x.MaxBlockHeaderSize != nil {
+		return *x.MaxBlockHeaderSize
+	}
+	return 0
 }
 
-func (*NoConfidenceAction) ProtoMessage() {}
+func (x *PParamsUpdate) GetStakeKeyDeposit() uint64 {
+	if x != nil && x.StakeKeyDeposit != nil {
+		return *x.StakeKeyDeposit
+	}
+	return 0
+}
 
-func (x *NoConfidenceAction) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[19]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *PParamsUpdate) GetPoolDeposit() uint64 {
+	if x != nil && x.PoolDeposit != nil {
+		return *x.PoolDeposit
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use NoConfidenceAction.ProtoReflect.Descriptor instead.
-func (*NoConfidenceAction) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{19}
+func (x *PParamsUpdate) GetPoolRetirementEpochBound() uint64 {
+	if x != nil && x.PoolRetirementEpochBound != nil {
+		return *x.PoolRetirementEpochBound
+	}
+	return 0
 }
 
-func (x *NoConfidenceAction) GetGovActionId() *GovernanceActionId {
+func (x *PParamsUpdate) GetDesiredNumberOfPools() uint64 {
+	if x != nil && x.DesiredNumberOfPools != nil {
+		return *x.DesiredNumberOfPools
+	}
+	return 0
+}
+
+func (x *PParamsUpdate) GetPoolInfluence() *RationalNumber {
 	if x != nil {
-		return x.GovActionId
+		return x.PoolInfluence
 	}
 	return nil
 }
 
-type UpdateCommitteeAction struct {
-	state                      protoimpl.MessageState     `protogen:"open.v1"`
-	GovActionId                *GovernanceActionId        `protobuf:"bytes,1,opt,name=gov_action_id,json=govActionId,proto3" json:"gov_action_id,omitempty"`
-	RemoveCommitteeCredentials []*StakeCredential         `protobuf:"bytes,2,rep,name=remove_committee_credentials,json=removeCommitteeCredentials,proto3" json:"remove_committee_credentials,omitempty"` // Committee members to remove (if any)
-	NewCommitteeCredentials    []*NewCommitteeCredentials `protobuf:"bytes,3,rep,name=new_committee_credentials,json=newCommitteeCredentials,proto3" json:"new_committee_credentials,omitempty"`          // The new committee members
-	NewCommitteeThreshold      *RationalNumber            `protobuf:"bytes,4,opt,name=new_committee_threshold,json=newCommitteeThreshold,proto3" json:"new_committee_threshold,omitempty"`                // The required threshold for the committee
-	unknownFields              protoimpl.UnknownFields
-	sizeCache                  protoimpl.SizeCache
+func (x *PParamsUpdate) GetMonetaryExpansion() *RationalNumber {
+	if x != nil {
+		return x.MonetaryExpansion
+	}
+	return nil
 }
 
-func (x *UpdateCommitteeAction) Reset() {
-	*x = UpdateCommitteeAction{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[20]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *PParamsUpdate) GetTreasuryExpansion() *RationalNumber {
+	if x != nil {
+		return x.TreasuryExpansion
+	}
+	return nil
 }
 
-func (x *UpdateCommitteeAction) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *PParamsUpdate) GetDecentralization() *RationalNumber {
+	if x != nil {
+		return x.Decentralization
+	}
+	return nil
 }
 
-func (*UpdateCommitteeAction) ProtoMessage() {}
+func (x *PParamsUpdate) GetExtraEntropy() []byte {
+	if x != nil {
+		return x.ExtraEntropy
+	}
+	return nil
+}
 
-func (x *UpdateCommitteeAction) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[20]
+func (x *PParamsUpdate) GetProtocolVersion() *ProtocolVersion {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ProtocolVersion
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use UpdateCommitteeAction.ProtoReflect.Descriptor instead.
-func (*UpdateCommitteeAction) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{20}
+func (x *PParamsUpdate) GetMinUtxoValue() uint64 {
+	if x != nil && x.MinUtxoValue != nil {
+		return *x.MinUtxoValue
+	}
+	return 0
 }
 
-func (x *UpdateCommitteeAction) GetGovActionId() *GovernanceActionId {
+func (x *PParamsUpdate) GetMinPoolCost() uint64 {
+	if x != nil && x.MinPoolCost != nil {
+		return *x.MinPoolCost
+	}
+	return 0
+}
+
+func (x *PParamsUpdate) GetCoinsPerUtxoWord() uint64 {
+	if x != nil && x.CoinsPerUtxoWord != nil {
+		return *x.CoinsPerUtxoWord
+	}
+	return 0
+}
+
+func (x *PParamsUpdate) GetCoinsPerUtxoByte() uint64 {
+	if x != nil && x.CoinsPerUtxoByte != nil {
+		return *x.CoinsPerUtxoByte
+	}
+	return 0
+}
+
+func (x *PParamsUpdate) GetCostModels() *CostModels {
 	if x != nil {
-		return x.GovActionId
+		return x.CostModels
 	}
 	return nil
 }
 
-func (x *UpdateCommitteeAction) GetRemoveCommitteeCredentials() []*StakeCredential {
+func (x *PParamsUpdate) GetPrices() *ExPrices {
 	if x != nil {
-		return x.RemoveCommitteeCredentials
+		return x.Prices
 	}
 	return nil
 }
 
-func (x *UpdateCommitteeAction) GetNewCommitteeCredentials() []*NewCommitteeCredentials {
+func (x *PParamsUpdate) GetMaxExecutionUnitsPerTransaction() *ExUnits {
 	if x != nil {
-		return x.NewCommitteeCredentials
+		return x.MaxExecutionUnitsPerTransaction
 	}
 	return nil
 }
 
-func (x *UpdateCommitteeAction) GetNewCommitteeThreshold() *RationalNumber {
+func (x *PParamsUpdate) GetMaxExecutionUnitsPerBlock() *ExUnits {
 	if x != nil {
-		return x.NewCommitteeThreshold
+		return x.MaxExecutionUnitsPerBlock
 	}
 	return nil
 }
 
-type NewConstitutionAction struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	GovActionId   *GovernanceActionId    `protobuf:"bytes,1,opt,name=gov_action_id,json=govActionId,proto3" json:"gov_action_id,omitempty"`
-	Constitution  *Constitution          `protobuf:"bytes,2,opt,name=constitution,proto3" json:"constitution,omitempty"` // The Constitution proposed
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *PParamsUpdate) GetMaxValueSize() uint64 {
+	if x != nil && x.MaxValueSize != nil {
+		return *x.MaxValueSize
+	}
+	return 0
 }
 
-func (x *NewConstitutionAction) Reset() {
-	*x = NewConstitutionAction{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[21]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *PParamsUpdate) GetCollateralPercentage() uint64 {
+	if x != nil && x.CollateralPercentage != nil {
+		return *x.CollateralPercentage
+	}
+	return 0
 }
 
-func (x *NewConstitutionAction) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *PParamsUpdate) GetMaxCollateralInputs() uint64 {
+	if x != nil && x.MaxCollateralInputs != nil {
+		return *x.MaxCollateralInputs
+	}
+	return 0
 }
 
-func (*NewConstitutionAction) ProtoMessage() {}
+func (x *PParamsUpdate) GetPoolVotingThresholds() *VotingThresholds {
+	if x != nil {
+		return x.PoolVotingThresholds
+	}
+	return nil
+}
 
-func (x *NewConstitutionAction) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[21]
+func (x *PParamsUpdate) GetDrepVotingThresholds() *VotingThresholds {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.DrepVotingThresholds
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use NewConstitutionAction.ProtoReflect.Descriptor instead.
-func (*NewConstitutionAction) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{21}
+func (x *PParamsUpdate) GetMinCommitteeSize() uint32 {
+	if x != nil && x.MinCommitteeSize != nil {
+		return *x.MinCommitteeSize
+	}
+	return 0
 }
 
-func (x *NewConstitutionAction) GetGovActionId() *GovernanceActionId {
-	if x != nil {
-		return x.GovActionId
+func (x *PParamsUpdate) GetCommitteeTermLimit() uint64 {
+	if x != nil && x.CommitteeTermLimit != nil {
+		return *x.CommitteeTermLimit
 	}
-	return nil
+	return 0
 }
 
-func (x *NewConstitutionAction) GetConstitution() *Constitution {
+func (x *PParamsUpdate) GetGovernanceActionValidityPeriod() uint64 {
+	if x != nil && x.GovernanceActionValidityPeriod != nil {
+		return *x.GovernanceActionValidityPeriod
+	}
+	return 0
+}
+
+func (x *PParamsUpdate) GetGovernanceActionDeposit() uint64 {
+	if x != nil && x.GovernanceActionDeposit != nil {
+		return *x.GovernanceActionDeposit
+	}
+	return 0
+}
+
+func (x *PParamsUpdate) GetDrepDeposit() uint64 {
+	if x != nil && x.DrepDeposit != nil {
+		return *x.DrepDeposit
+	}
+	return 0
+}
+
+func (x *PParamsUpdate) GetDrepInactivityPeriod() uint64 {
+	if x != nil && x.DrepInactivityPeriod != nil {
+		return *x.DrepInactivityPeriod
+	}
+	return 0
+}
+
+func (x *PParamsUpdate) GetMinFeeScriptRefCostPerByte() *RationalNumber {
 	if x != nil {
-		return x.Constitution
+		return x.MinFeeScriptRefCostPerByte
 	}
 	return nil
 }
 
-type Constitution struct {
+// Define a governance action proposal
+type GovernanceActionProposal struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Anchor        *Anchor                `protobuf:"bytes,1,opt,name=anchor,proto3" json:"anchor,omitempty"` // Anchor to the new document
-	Hash          []byte                 `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`     // Hash of the document
+	Deposit       uint64                 `protobuf:"varint,1,opt,name=deposit,proto3" json:"deposit,omitempty"`                                 // The amount deposited for the governance action
+	RewardAccount []byte                 `protobuf:"bytes,2,opt,name=reward_account,json=rewardAccount,proto3" json:"reward_account,omitempty"` // The reward account the deposit should be returned to
+	GovAction     *GovernanceAction      `protobuf:"bytes,3,opt,name=gov_action,json=govAction,proto3" json:"gov_action,omitempty"`
+	Anchor        *Anchor                `protobuf:"bytes,4,opt,name=anchor,proto3" json:"anchor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Constitution) Reset() {
-	*x = Constitution{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[22]
+func (x *GovernanceActionProposal) Reset() {
+	*x = GovernanceActionProposal{}
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Constitution) String() string {
+func (x *GovernanceActionProposal) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Constitution) ProtoMessage() {}
+func (*GovernanceActionProposal) ProtoMessage() {}
 
-func (x *Constitution) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[22]
+func (x *GovernanceActionProposal) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1678,21 +2133,814 @@ func (x *Constitution) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Constitution.ProtoReflect.Descriptor instead.
-func (*Constitution) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use GovernanceActionProposal.ProtoReflect.Descriptor instead.
+func (*GovernanceActionProposal) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *Constitution) GetAnchor() *Anchor {
+func (x *GovernanceActionProposal) GetDeposit() uint64 {
 	if x != nil {
-		return x.Anchor
+		return x.Deposit
+	}
+	return 0
+}
+
+func (x *GovernanceActionProposal) GetRewardAccount() []byte {
+	if x != nil {
+		return x.RewardAccount
 	}
 	return nil
 }
 
-func (x *Constitution) GetHash() []byte {
+func (x *GovernanceActionProposal) GetGovAction() *GovernanceAction {
 	if x != nil {
-		return x.Hash
+		return x.GovAction
+	}
+	return nil
+}
+
+func (x *GovernanceActionProposal) GetAnchor() *Anchor {
+	if x != nil {
+		return x.Anchor
+	}
+	return nil
+}
+
+// Define a Governance Action
+type GovernanceAction struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to GovernanceAction:
+	//
+	//	*GovernanceAction_ParameterChangeAction
+	//	*GovernanceAction_HardForkInitiationAction
+	//	*GovernanceAction_TreasuryWithdrawalsAction
+	//	*GovernanceAction_NoConfidenceAction
+	//	*GovernanceAction_UpdateCommitteeAction
+	//	*GovernanceAction_NewConstitutionAction
+	//	*GovernanceAction_InfoAction
+	GovernanceAction isGovernanceAction_GovernanceAction `protobuf_oneof:"governance_action"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GovernanceAction) Reset() {
+	*x = GovernanceAction{}
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GovernanceAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GovernanceAction) ProtoMessage() {}
+
+func (x *GovernanceAction) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GovernanceAction.ProtoReflect.Descriptor instead.
+func (*GovernanceAction) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GovernanceAction) GetGovernanceAction() isGovernanceAction_GovernanceAction {
+	if x != nil {
+		return x.GovernanceAction
+	}
+	return nil
+}
+
+func (x *GovernanceAction) GetParameterChangeAction() *ParameterChangeAction {
+	if x != nil {
+		if x, ok := x.GovernanceAction.(*GovernanceAction_ParameterChangeAction); ok {
+			return x.ParameterChangeAction
+		}
+	}
+	return nil
+}
+
+func (x *GovernanceAction) GetHardForkInitiationAction() *HardForkInitiationAction {
+	if x != nil {
+		if x, ok := x.GovernanceAction.(*GovernanceAction_HardForkInitiationAction); ok {
+			return x.HardForkInitiationAction
+		}
+	}
+	return nil
+}
+
+func (x *GovernanceAction) GetTreasuryWithdrawalsAction() *TreasuryWithdrawalsAction {
+	if x != nil {
+		if x, ok := x.GovernanceAction.(*GovernanceAction_TreasuryWithdrawalsAction); ok {
+			return x.TreasuryWithdrawalsAction
+		}
+	}
+	return nil
+}
+
+func (x *GovernanceAction) GetNoConfidenceAction() *NoConfidenceAction {
+	if x != nil {
+		if x, ok := x.GovernanceAction.(*GovernanceAction_NoConfidenceAction); ok {
+			return x.NoConfidenceAction
+		}
+	}
+	return nil
+}
+
+func (x *GovernanceAction) GetUpdateCommitteeAction() *UpdateCommitteeAction {
+	if x != nil {
+		if x, ok := x.GovernanceAction.(*GovernanceAction_UpdateCommitteeAction); ok {
+			return x.UpdateCommitteeAction
+		}
+	}
+	return nil
+}
+
+func (x *GovernanceAction) GetNewConstitutionAction() *NewConstitutionAction {
+	if x != nil {
+		if x, ok := x.GovernanceAction.(*GovernanceAction_NewConstitutionAction); ok {
+			return x.NewConstitutionAction
+		}
+	}
+	return nil
+}
+
+func (x *GovernanceAction) GetInfoAction() uint32 {
+	if x != nil {
+		if x, ok := x.GovernanceAction.(*GovernanceAction_InfoAction); ok {
+			return x.InfoAction
+		}
+	}
+	return 0
+}
+
+type isGovernanceAction_GovernanceAction interface {
+	isGovernanceAction_GovernanceAction()
+}
+
+type GovernanceAction_ParameterChangeAction struct {
+	ParameterChangeAction *ParameterChangeAction `protobuf:"bytes,1,opt,name=parameter_change_action,json=parameterChangeAction,proto3,oneof"` // Change on-chain parameters
+}
+
+type GovernanceAction_HardForkInitiationAction struct {
+	HardForkInitiationAction *HardForkInitiationAction `protobuf:"bytes,2,opt,name=hard_fork_initiation_action,json=hardForkInitiationAction,proto3,oneof"` // Initiate a Hard Fork
+}
+
+type GovernanceAction_TreasuryWithdrawalsAction struct {
+	TreasuryWithdrawalsAction *TreasuryWithdrawalsAction `protobuf:"bytes,3,opt,name=treasury_withdrawals_action,json=treasuryWithdrawalsAction,proto3,oneof"` // Withdraw from the Treasury
+}
+
+type GovernanceAction_NoConfidenceAction struct {
+	NoConfidenceAction *NoConfidenceAction `protobuf:"bytes,4,opt,name=no_confidence_action,json=noConfidenceAction,proto3,oneof"` //
+}
+
+type GovernanceAction_UpdateCommitteeAction struct {
+	UpdateCommitteeAction *UpdateCommitteeAction `protobuf:"bytes,5,opt,name=update_committee_action,json=updateCommitteeAction,proto3,oneof"` // Update the Constitution Committee
+}
+
+type GovernanceAction_NewConstitutionAction struct {
+	NewConstitutionAction *NewConstitutionAction `protobuf:"bytes,6,opt,name=new_constitution_action,json=newConstitutionAction,proto3,oneof"` // Replace the Constitution
+}
+
+type GovernanceAction_InfoAction struct {
+	// TODO: revisit if there's a better way to handle this option because it
+	// doesn't actually need a value but proto syntax needs to require it
+	InfoAction uint32 `protobuf:"varint,7,opt,name=info_action,json=infoAction,proto3,oneof"` // Info action should just be the integer number 6
+}
+
+func (*GovernanceAction_ParameterChangeAction) isGovernanceAction_GovernanceAction() {}
+
+func (*GovernanceAction_HardForkInitiationAction) isGovernanceAction_GovernanceAction() {}
+
+func (*GovernanceAction_TreasuryWithdrawalsAction) isGovernanceAction_GovernanceAction() {}
+
+func (*GovernanceAction_NoConfidenceAction) isGovernanceAction_GovernanceAction() {}
+
+func (*GovernanceAction_UpdateCommitteeAction) isGovernanceAction_GovernanceAction() {}
+
+func (*GovernanceAction_NewConstitutionAction) isGovernanceAction_GovernanceAction() {}
+
+func (*GovernanceAction_InfoAction) isGovernanceAction_GovernanceAction() {}
+
+type GovernanceActionId struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId         []byte                 `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	GovernanceActionIndex uint32                 `protobuf:"varint,2,opt,name=governance_action_index,json=governanceActionIndex,proto3" json:"governance_action_index,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *GovernanceActionId) Reset() {
+	*x = GovernanceActionId{}
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GovernanceActionId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GovernanceActionId) ProtoMessage() {}
+
+func (x *GovernanceActionId) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GovernanceActionId.ProtoReflect.Descriptor instead.
+func (*GovernanceActionId) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GovernanceActionId) GetTransactionId() []byte {
+	if x != nil {
+		return x.TransactionId
+	}
+	return nil
+}
+
+func (x *GovernanceActionId) GetGovernanceActionIndex() uint32 {
+	if x != nil {
+		return x.GovernanceActionIndex
+	}
+	return 0
+}
+
+// Define the voter of a voting procedure
+type Voter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          VoterType              `protobuf:"varint,1,opt,name=type,proto3,enum=sf.cardano.type.v1.VoterType" json:"type,omitempty"` // Role of the voter and kind of its credential
+	Hash          []byte                 `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`                                    // Key hash or script hash of the voter credential
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Voter) Reset() {
+	*x = Voter{}
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Voter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Voter) ProtoMessage() {}
+
+func (x *Voter) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Voter.ProtoReflect.Descriptor instead.
+func (*Voter) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *Voter) GetType() VoterType {
+	if x != nil {
+		return x.Type
+	}
+	return VoterType_VOTER_TYPE_UNSPECIFIED
+}
+
+func (x *Voter) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+// Define a vote cast on a governance action
+type VotingProcedure struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Voter         *Voter                 `protobuf:"bytes,1,opt,name=voter,proto3" json:"voter,omitempty"`
+	GovActionId   *GovernanceActionId    `protobuf:"bytes,2,opt,name=gov_action_id,json=govActionId,proto3" json:"gov_action_id,omitempty"` // The governance action voted on
+	Vote          Vote                   `protobuf:"varint,3,opt,name=vote,proto3,enum=sf.cardano.type.v1.Vote" json:"vote,omitempty"`
+	Anchor        *Anchor                `protobuf:"bytes,4,opt,name=anchor,proto3" json:"anchor,omitempty"` // Optional anchor to the vote rationale
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VotingProcedure) Reset() {
+	*x = VotingProcedure{}
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VotingProcedure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VotingProcedure) ProtoMessage() {}
+
+func (x *VotingProcedure) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VotingProcedure.ProtoReflect.Descriptor instead.
+func (*VotingProcedure) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *VotingProcedure) GetVoter() *Voter {
+	if x != nil {
+		return x.Voter
+	}
+	return nil
+}
+
+func (x *VotingProcedure) GetGovActionId() *GovernanceActionId {
+	if x != nil {
+		return x.GovActionId
+	}
+	return nil
+}
+
+func (x *VotingProcedure) GetVote() Vote {
+	if x != nil {
+		return x.Vote
+	}
+	return Vote_VOTE_UNSPECIFIED
+}
+
+func (x *VotingProcedure) GetAnchor() *Anchor {
+	if x != nil {
+		return x.Anchor
+	}
+	return nil
+}
+
+type ParameterChangeAction struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	GovActionId         *GovernanceActionId    `protobuf:"bytes,1,opt,name=gov_action_id,json=govActionId,proto3" json:"gov_action_id,omitempty"`
+	ProtocolParamUpdate *PParams               `protobuf:"bytes,2,opt,name=protocol_param_update,json=protocolParamUpdate,proto3" json:"protocol_param_update,omitempty"` // The updates proposed
+	PolicyHash          []byte                 `protobuf:"bytes,3,opt,name=policy_hash,json=policyHash,proto3" json:"policy_hash,omitempty"`
+	Update              *PParamsUpdate         `protobuf:"bytes,4,opt,name=update,proto3" json:"update,omitempty"` // The updates proposed, telling unchanged from zero parameters
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ParameterChangeAction) Reset() {
+	*x = ParameterChangeAction{}
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParameterChangeAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParameterChangeAction) ProtoMessage() {}
+
+func (x *ParameterChangeAction) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParameterChangeAction.ProtoReflect.Descriptor instead.
+func (*ParameterChangeAction) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ParameterChangeAction) GetGovActionId() *GovernanceActionId {
+	if x != nil {
+		return x.GovActionId
+	}
+	return nil
+}
+
+func (x *ParameterChangeAction) GetProtocolParamUpdate() *PParams {
+	if x != nil {
+		return x.ProtocolParamUpdate
+	}
+	return nil
+}
+
+func (x *ParameterChangeAction) GetPolicyHash() []byte {
+	if x != nil {
+		return x.PolicyHash
+	}
+	return nil
+}
+
+func (x *ParameterChangeAction) GetUpdate() *PParamsUpdate {
+	if x != nil {
+		return x.Update
+	}
+	return nil
+}
+
+type HardForkInitiationAction struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	GovActionId     *GovernanceActionId    `protobuf:"bytes,1,opt,name=gov_action_id,json=govActionId,proto3" json:"gov_action_id,omitempty"`
+	ProtocolVersion *ProtocolVersion       `protobuf:"bytes,2,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"` // The protocol version to fork to
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *HardForkInitiationAction) Reset() {
+	*x = HardForkInitiationAction{}
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HardForkInitiationAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HardForkInitiationAction) ProtoMessage() {}
+
+func (x *HardForkInitiationAction) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HardForkInitiationAction.ProtoReflect.Descriptor instead.
+func (*HardForkInitiationAction) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *HardForkInitiationAction) GetGovActionId() *GovernanceActionId {
+	if x != nil {
+		return x.GovActionId
+	}
+	return nil
+}
+
+func (x *HardForkInitiationAction) GetProtocolVersion() *ProtocolVersion {
+	if x != nil {
+		return x.ProtocolVersion
+	}
+	return nil
+}
+
+type TreasuryWithdrawalsAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Withdrawals   []*WithdrawalAmount    `protobuf:"bytes,1,rep,name=withdrawals,proto3" json:"withdrawals,omitempty"` // A map of the withdrawals to make
+	PolicyHash    []byte                 `protobuf:"bytes,2,opt,name=policy_hash,json=policyHash,proto3" json:"policy_hash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TreasuryWithdrawalsAction) Reset() {
+	*x = TreasuryWithdrawalsAction{}
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TreasuryWithdrawalsAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TreasuryWithdrawalsAction) ProtoMessage() {}
+
+func (x *TreasuryWithdrawalsAction) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TreasuryWithdrawalsAction.ProtoReflect.Descriptor instead.
+func (*TreasuryWithdrawalsAction) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *TreasuryWithdrawalsAction) GetWithdrawals() []*WithdrawalAmount {
+	if x != nil {
+		return x.Withdrawals
+	}
+	return nil
+}
+
+func (x *TreasuryWithdrawalsAction) GetPolicyHash() []byte {
+	if x != nil {
+		return x.PolicyHash
+	}
+	return nil
+}
+
+type WithdrawalAmount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RewardAccount []byte                 `protobuf:"bytes,1,opt,name=reward_account,json=rewardAccount,proto3" json:"reward_account,omitempty"`
+	Coin          uint64                 `protobuf:"varint,2,opt,name=coin,proto3" json:"coin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WithdrawalAmount) Reset() {
+	*x = WithdrawalAmount{}
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WithdrawalAmount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawalAmount) ProtoMessage() {}
+
+func (x *WithdrawalAmount) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawalAmount.ProtoReflect.Descriptor instead.
+func (*WithdrawalAmount) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *WithdrawalAmount) GetRewardAccount() []byte {
+	if x != nil {
+		return x.RewardAccount
+	}
+	return nil
+}
+
+func (x *WithdrawalAmount) GetCoin() uint64 {
+	if x != nil {
+		return x.Coin
+	}
+	return 0
+}
+
+type NoConfidenceAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GovActionId   *GovernanceActionId    `protobuf:"bytes,1,opt,name=gov_action_id,json=govActionId,proto3" json:"gov_action_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NoConfidenceAction) Reset() {
+	*x = NoConfidenceAction{}
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NoConfidenceAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NoConfidenceAction) ProtoMessage() {}
+
+func (x *NoConfidenceAction) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NoConfidenceAction.ProtoReflect.Descriptor instead.
+func (*NoConfidenceAction) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *NoConfidenceAction) GetGovActionId() *GovernanceActionId {
+	if x != nil {
+		return x.GovActionId
+	}
+	return nil
+}
+
+type UpdateCommitteeAction struct {
+	state                      protoimpl.MessageState     `protogen:"open.v1"`
+	GovActionId                *GovernanceActionId        `protobuf:"bytes,1,opt,name=gov_action_id,json=govActionId,proto3" json:"gov_action_id,omitempty"`
+	RemoveCommitteeCredentials []*StakeCredential         `protobuf:"bytes,2,rep,name=remove_committee_credentials,json=removeCommitteeCredentials,proto3" json:"remove_committee_credentials,omitempty"` // Committee members to remove (if any)
+	NewCommitteeCredentials    []*NewCommitteeCredentials `protobuf:"bytes,3,rep,name=new_committee_credentials,json=newCommitteeCredentials,proto3" json:"new_committee_credentials,omitempty"`          // The new committee members
+	NewCommitteeThreshold      *RationalNumber            `protobuf:"bytes,4,opt,name=new_committee_threshold,json=newCommitteeThreshold,proto3" json:"new_committee_threshold,omitempty"`                // The required threshold for the committee
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *UpdateCommitteeAction) Reset() {
+	*x = UpdateCommitteeAction{}
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCommitteeAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCommitteeAction) ProtoMessage() {}
+
+func (x *UpdateCommitteeAction) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCommitteeAction.ProtoReflect.Descriptor instead.
+func (*UpdateCommitteeAction) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *UpdateCommitteeAction) GetGovActionId() *GovernanceActionId {
+	if x != nil {
+		return x.GovActionId
+	}
+	return nil
+}
+
+func (x *UpdateCommitteeAction) GetRemoveCommitteeCredentials() []*StakeCredential {
+	if x != nil {
+		return x.RemoveCommitteeCredentials
+	}
+	return nil
+}
+
+func (x *UpdateCommitteeAction) GetNewCommitteeCredentials() []*NewCommitteeCredentials {
+	if x != nil {
+		return x.NewCommitteeCredentials
+	}
+	return nil
+}
+
+func (x *UpdateCommitteeAction) GetNewCommitteeThreshold() *RationalNumber {
+	if x != nil {
+		return x.NewCommitteeThreshold
+	}
+	return nil
+}
+
+type NewConstitutionAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GovActionId   *GovernanceActionId    `protobuf:"bytes,1,opt,name=gov_action_id,json=govActionId,proto3" json:"gov_action_id,omitempty"`
+	Constitution  *Constitution          `protobuf:"bytes,2,opt,name=constitution,proto3" json:"constitution,omitempty"` // The Constitution proposed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NewConstitutionAction) Reset() {
+	*x = NewConstitutionAction{}
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NewConstitutionAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewConstitutionAction) ProtoMessage() {}
+
+func (x *NewConstitutionAction) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewConstitutionAction.ProtoReflect.Descriptor instead.
+func (*NewConstitutionAction) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *NewConstitutionAction) GetGovActionId() *GovernanceActionId {
+	if x != nil {
+		return x.GovActionId
+	}
+	return nil
+}
+
+func (x *NewConstitutionAction) GetConstitution() *Constitution {
+	if x != nil {
+		return x.Constitution
+	}
+	return nil
+}
+
+type Constitution struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Anchor        *Anchor                `protobuf:"bytes,1,opt,name=anchor,proto3" json:"anchor,omitempty"` // Anchor to the new document
+	Hash          []byte                 `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`     // Hash of the document
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Constitution) Reset() {
+	*x = Constitution{}
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Constitution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Constitution) ProtoMessage() {}
+
+func (x *Constitution) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Constitution.ProtoReflect.Descriptor instead.
+func (*Constitution) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *Constitution) GetAnchor() *Anchor {
+	if x != nil {
+		return x.Anchor
+	}
+	return nil
+}
+
+func (x *Constitution) GetHash() []byte {
+	if x != nil {
+		return x.Hash
 	}
 	return nil
 }
@@ -1702,27 +2950,2642 @@ func (x *Constitution) GetHash() []byte {
 // credential
 type NewCommitteeCredentials struct {
 	state                   protoimpl.MessageState `protogen:"open.v1"`
-	CommitteeColdCredential *StakeCredential       `protobuf:"bytes,1,opt,name=committee_cold_credential,json=committeeColdCredential,proto3" json:"committee_cold_credential,omitempty"`
-	ExpiresEpoch            uint32                 `protobuf:"varint,2,opt,name=expires_epoch,json=expiresEpoch,proto3" json:"expires_epoch,omitempty"`
+	CommitteeColdCredential *StakeCredential       `protobuf:"bytes,1,opt,name=committee_cold_credential,json=committeeColdCredential,proto3" json:"committee_cold_credential,omitempty"`
+	ExpiresEpoch            uint32                 `protobuf:"varint,2,opt,name=expires_epoch,json=expiresEpoch,proto3" json:"expires_epoch,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *NewCommitteeCredentials) Reset() {
+	*x = NewCommitteeCredentials{}
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NewCommitteeCredentials) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewCommitteeCredentials) ProtoMessage() {}
+
+func (x *NewCommitteeCredentials) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewCommitteeCredentials.ProtoReflect.Descriptor instead.
+func (*NewCommitteeCredentials) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *NewCommitteeCredentials) GetCommitteeColdCredential() *StakeCredential {
+	if x != nil {
+		return x.CommitteeColdCredential
+	}
+	return nil
+}
+
+func (x *NewCommitteeCredentials) GetExpiresEpoch() uint32 {
+	if x != nil {
+		return x.ExpiresEpoch
+	}
+	return 0
+}
+
+// Contains the header information for a block.
+type BlockHeader struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slot          uint64                 `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`     // Slot number.
+	Hash          []byte                 `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`      // Block hash.
+	Height        uint64                 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"` // Block height.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockHeader) Reset() {
+	*x = BlockHeader{}
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockHeader) ProtoMessage() {}
+
+func (x *BlockHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockHeader.ProtoReflect.Descriptor instead.
+func (*BlockHeader) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *BlockHeader) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *BlockHeader) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+func (x *BlockHeader) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+// Contains the transaction data for a block.
+type BlockBody struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tx            []*Tx                  `protobuf:"bytes,1,rep,name=tx,proto3" json:"tx,omitempty"` // List of transactions.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockBody) Reset() {
+	*x = BlockBody{}
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockBody) ProtoMessage() {}
+
+func (x *BlockBody) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockBody.ProtoReflect.Descriptor instead.
+func (*BlockBody) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *BlockBody) GetTx() []*Tx {
+	if x != nil {
+		return x.Tx
+	}
+	return nil
+}
+
+// Represents a complete block, including header and body.
+type Block struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Header         *BlockHeader           `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`                                       // Block header.
+	Body           *BlockBody             `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`                                           // Block body.
+	Timestamp      uint64                 `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`                                // Block ms timestamp
+	OriginalCbor   []byte                 `protobuf:"bytes,4,opt,name=original_cbor,json=originalCbor,proto3" json:"original_cbor,omitempty"`       // Original cbor-encoded block as seen on-chain (opt-in)
+	Stats          *BlockStats            `protobuf:"bytes,5,opt,name=stats,proto3" json:"stats,omitempty"`                                         // Aggregates of the block transactions
+	Byron          *ByronBlock            `protobuf:"bytes,6,opt,name=byron,proto3" json:"byron,omitempty"`                                         // Byron-era payloads, for Byron blocks only
+	ProtocolParams *PParams               `protobuf:"bytes,7,opt,name=protocol_params,json=protocolParams,proto3" json:"protocol_params,omitempty"` // Protocol parameters in force, on the first block of each epoch
+	// when parameters are tracked
+	Era                      *EraSummary      `protobuf:"bytes,8,opt,name=era,proto3" json:"era,omitempty"`                                                                               // Era of the epoch, along with protocol_params
+	EpochTransition          *EpochTransition `protobuf:"bytes,9,opt,name=epoch_transition,json=epochTransition,proto3" json:"epoch_transition,omitempty"`                                // On the first block of each epoch when epochs are tracked
+	LedgerSnapshot           *LedgerSnapshot  `protobuf:"bytes,10,opt,name=ledger_snapshot,json=ledgerSnapshot,proto3" json:"ledger_snapshot,omitempty"`                                  // Ledger state at the first block of an epoch, when queried
+	ProtocolParamsUnreliable bool             `protobuf:"varint,11,opt,name=protocol_params_unreliable,json=protocolParamsUnreliable,proto3" json:"protocol_params_unreliable,omitempty"` // Along with protocol_params: Conway governance actions may have
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *Block) Reset() {
+	*x = Block{}
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Block) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Block) ProtoMessage() {}
+
+func (x *Block) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Block.ProtoReflect.Descriptor instead.
+func (*Block) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *Block) GetHeader() *BlockHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *Block) GetBody() *BlockBody {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+func (x *Block) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *Block) GetOriginalCbor() []byte {
+	if x != nil {
+		return x.OriginalCbor
+	}
+	return nil
+}
+
+func (x *Block) GetStats() *BlockStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+func (x *Block) GetByron() *ByronBlock {
+	if x != nil {
+		return x.Byron
+	}
+	return nil
+}
+
+func (x *Block) GetProtocolParams() *PParams {
+	if x != nil {
+		return x.ProtocolParams
+	}
+	return nil
+}
+
+func (x *Block) GetEra() *EraSummary {
+	if x != nil {
+		return x.Era
+	}
+	return nil
+}
+
+func (x *Block) GetEpochTransition() *EpochTransition {
+	if x != nil {
+		return x.EpochTransition
+	}
+	return nil
+}
+
+func (x *Block) GetLedgerSnapshot() *LedgerSnapshot {
+	if x != nil {
+		return x.LedgerSnapshot
+	}
+	return nil
+}
+
+func (x *Block) GetProtocolParamsUnreliable() bool {
+	if x != nil {
+		return x.ProtocolParamsUnreliable
+	}
+	return false
+}
+
+// The Byron-era parts of a Byron main block or epoch boundary block (EBB).
+// Transactions of main blocks are in the block body like the ones of later
+// eras.
+type ByronBlock struct {
+	state                  protoimpl.MessageState        `protogen:"open.v1"`
+	EpochBoundary          bool                          `protobuf:"varint,1,opt,name=epoch_boundary,json=epochBoundary,proto3" json:"epoch_boundary,omitempty"` // The block is an EBB
+	ProtocolMagic          uint32                        `protobuf:"varint,2,opt,name=protocol_magic,json=protocolMagic,proto3" json:"protocol_magic,omitempty"`
+	PrevHash               []byte                        `protobuf:"bytes,3,opt,name=prev_hash,json=prevHash,proto3" json:"prev_hash,omitempty"`
+	Epoch                  uint64                        `protobuf:"varint,4,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	SlotInEpoch            uint32                        `protobuf:"varint,5,opt,name=slot_in_epoch,json=slotInEpoch,proto3" json:"slot_in_epoch,omitempty"`          // Slot within the epoch (main blocks)
+	IssuerKey              []byte                        `protobuf:"bytes,6,opt,name=issuer_key,json=issuerKey,proto3" json:"issuer_key,omitempty"`                   // Extended public key of the slot leader (main blocks)
+	BlockVersion           *ByronBlockVersion            `protobuf:"bytes,7,opt,name=block_version,json=blockVersion,proto3" json:"block_version,omitempty"`          // (main blocks)
+	SoftwareVersion        *ByronSoftwareVersion         `protobuf:"bytes,8,opt,name=software_version,json=softwareVersion,proto3" json:"software_version,omitempty"` // (main blocks)
+	DelegationCertificates []*ByronDelegationCertificate `protobuf:"bytes,9,rep,name=delegation_certificates,json=delegationCertificates,proto3" json:"delegation_certificates,omitempty"`
+	UpdateProposals        []*ByronUpdateProposal        `protobuf:"bytes,10,rep,name=update_proposals,json=updateProposals,proto3" json:"update_proposals,omitempty"`
+	UpdateVotes            []*ByronUpdateVote            `protobuf:"bytes,11,rep,name=update_votes,json=updateVotes,proto3" json:"update_votes,omitempty"`
+	EpochLeaders           [][]byte                      `protobuf:"bytes,12,rep,name=epoch_leaders,json=epochLeaders,proto3" json:"epoch_leaders,omitempty"` // Stakeholder ids of the slot leaders of the epoch (EBBs)
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ByronBlock) Reset() {
+	*x = ByronBlock{}
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ByronBlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ByronBlock) ProtoMessage() {}
+
+func (x *ByronBlock) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ByronBlock.ProtoReflect.Descriptor instead.
+func (*ByronBlock) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ByronBlock) GetEpochBoundary() bool {
+	if x != nil {
+		return x.EpochBoundary
+	}
+	return false
+}
+
+func (x *ByronBlock) GetProtocolMagic() uint32 {
+	if x != nil {
+		return x.ProtocolMagic
+	}
+	return 0
+}
+
+func (x *ByronBlock) GetPrevHash() []byte {
+	if x != nil {
+		return x.PrevHash
+	}
+	return nil
+}
+
+func (x *ByronBlock) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *ByronBlock) GetSlotInEpoch() uint32 {
+	if x != nil {
+		return x.SlotInEpoch
+	}
+	return 0
+}
+
+func (x *ByronBlock) GetIssuerKey() []byte {
+	if x != nil {
+		return x.IssuerKey
+	}
+	return nil
+}
+
+func (x *ByronBlock) GetBlockVersion() *ByronBlockVersion {
+	if x != nil {
+		return x.BlockVersion
+	}
+	return nil
+}
+
+func (x *ByronBlock) GetSoftwareVersion() *ByronSoftwareVersion {
+	if x != nil {
+		return x.SoftwareVersion
+	}
+	return nil
+}
+
+func (x *ByronBlock) GetDelegationCertificates() []*ByronDelegationCertificate {
+	if x != nil {
+		return x.DelegationCertificates
+	}
+	return nil
+}
+
+func (x *ByronBlock) GetUpdateProposals() []*ByronUpdateProposal {
+	if x != nil {
+		return x.UpdateProposals
+	}
+	return nil
+}
+
+func (x *ByronBlock) GetUpdateVotes() []*ByronUpdateVote {
+	if x != nil {
+		return x.UpdateVotes
+	}
+	return nil
+}
+
+func (x *ByronBlock) GetEpochLeaders() [][]byte {
+	if x != nil {
+		return x.EpochLeaders
+	}
+	return nil
+}
+
+type ByronBlockVersion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Major         uint32                 `protobuf:"varint,1,opt,name=major,proto3" json:"major,omitempty"`
+	Minor         uint32                 `protobuf:"varint,2,opt,name=minor,proto3" json:"minor,omitempty"`
+	Alt           uint32                 `protobuf:"varint,3,opt,name=alt,proto3" json:"alt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ByronBlockVersion) Reset() {
+	*x = ByronBlockVersion{}
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ByronBlockVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ByronBlockVersion) ProtoMessage() {}
+
+func (x *ByronBlockVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ByronBlockVersion.ProtoReflect.Descriptor instead.
+func (*ByronBlockVersion) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ByronBlockVersion) GetMajor() uint32 {
+	if x != nil {
+		return x.Major
+	}
+	return 0
+}
+
+func (x *ByronBlockVersion) GetMinor() uint32 {
+	if x != nil {
+		return x.Minor
+	}
+	return 0
+}
+
+func (x *ByronBlockVersion) GetAlt() uint32 {
+	if x != nil {
+		return x.Alt
+	}
+	return 0
+}
+
+type ByronSoftwareVersion struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationName string                 `protobuf:"bytes,1,opt,name=application_name,json=applicationName,proto3" json:"application_name,omitempty"`
+	Version         uint32                 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ByronSoftwareVersion) Reset() {
+	*x = ByronSoftwareVersion{}
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ByronSoftwareVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ByronSoftwareVersion) ProtoMessage() {}
+
+func (x *ByronSoftwareVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ByronSoftwareVersion.ProtoReflect.Descriptor instead.
+func (*ByronSoftwareVersion) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ByronSoftwareVersion) GetApplicationName() string {
+	if x != nil {
+		return x.ApplicationName
+	}
+	return ""
+}
+
+func (x *ByronSoftwareVersion) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// Heavyweight delegation of a genesis key's block signing right.
+type ByronDelegationCertificate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Epoch         uint64                 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`                               // Epoch the delegation starts at
+	IssuerKey     []byte                 `protobuf:"bytes,2,opt,name=issuer_key,json=issuerKey,proto3" json:"issuer_key,omitempty"`       // Extended public key of the delegator
+	DelegateKey   []byte                 `protobuf:"bytes,3,opt,name=delegate_key,json=delegateKey,proto3" json:"delegate_key,omitempty"` // Extended public key of the delegate
+	Signature     []byte                 `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ByronDelegationCertificate) Reset() {
+	*x = ByronDelegationCertificate{}
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ByronDelegationCertificate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ByronDelegationCertificate) ProtoMessage() {}
+
+func (x *ByronDelegationCertificate) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ByronDelegationCertificate.ProtoReflect.Descriptor instead.
+func (*ByronDelegationCertificate) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ByronDelegationCertificate) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *ByronDelegationCertificate) GetIssuerKey() []byte {
+	if x != nil {
+		return x.IssuerKey
+	}
+	return nil
+}
+
+func (x *ByronDelegationCertificate) GetDelegateKey() []byte {
+	if x != nil {
+		return x.DelegateKey
+	}
+	return nil
+}
+
+func (x *ByronDelegationCertificate) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type ByronUpdateProposal struct {
+	state                    protoimpl.MessageState         `protogen:"open.v1"`
+	Id                       []byte                         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // Hash of the proposal, the one votes refer to
+	BlockVersion             *ByronBlockVersion             `protobuf:"bytes,2,opt,name=block_version,json=blockVersion,proto3" json:"block_version,omitempty"`
+	BlockVersionModification *ByronBlockVersionModification `protobuf:"bytes,3,opt,name=block_version_modification,json=blockVersionModification,proto3" json:"block_version_modification,omitempty"`
+	SoftwareVersion          *ByronSoftwareVersion          `protobuf:"bytes,4,opt,name=software_version,json=softwareVersion,proto3" json:"software_version,omitempty"`
+	Data                     []*ByronSystemUpdate           `protobuf:"bytes,5,rep,name=data,proto3" json:"data,omitempty"`                                  // Update data by system tag
+	ProposerKey              []byte                         `protobuf:"bytes,6,opt,name=proposer_key,json=proposerKey,proto3" json:"proposer_key,omitempty"` // Extended public key of the proposer
+	Signature                []byte                         `protobuf:"bytes,7,opt,name=signature,proto3" json:"signature,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *ByronUpdateProposal) Reset() {
+	*x = ByronUpdateProposal{}
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ByronUpdateProposal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ByronUpdateProposal) ProtoMessage() {}
+
+func (x *ByronUpdateProposal) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ByronUpdateProposal.ProtoReflect.Descriptor instead.
+func (*ByronUpdateProposal) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ByronUpdateProposal) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *ByronUpdateProposal) GetBlockVersion() *ByronBlockVersion {
+	if x != nil {
+		return x.BlockVersion
+	}
+	return nil
+}
+
+func (x *ByronUpdateProposal) GetBlockVersionModification() *ByronBlockVersionModification {
+	if x != nil {
+		return x.BlockVersionModification
+	}
+	return nil
+}
+
+func (x *ByronUpdateProposal) GetSoftwareVersion() *ByronSoftwareVersion {
+	if x != nil {
+		return x.SoftwareVersion
+	}
+	return nil
+}
+
+func (x *ByronUpdateProposal) GetData() []*ByronSystemUpdate {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ByronUpdateProposal) GetProposerKey() []byte {
+	if x != nil {
+		return x.ProposerKey
+	}
+	return nil
+}
+
+func (x *ByronUpdateProposal) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+// Protocol parameters changed by a Byron update proposal, unset when kept.
+// Thresholds are fractions of 10^15, fee coefficients of 10^9.
+type ByronBlockVersionModification struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ScriptVersion     *uint32                `protobuf:"varint,1,opt,name=script_version,json=scriptVersion,proto3,oneof" json:"script_version,omitempty"`
+	SlotDuration      *uint64                `protobuf:"varint,2,opt,name=slot_duration,json=slotDuration,proto3,oneof" json:"slot_duration,omitempty"` // Milliseconds
+	MaxBlockSize      *uint64                `protobuf:"varint,3,opt,name=max_block_size,json=maxBlockSize,proto3,oneof" json:"max_block_size,omitempty"`
+	MaxHeaderSize     *uint64                `protobuf:"varint,4,opt,name=max_header_size,json=maxHeaderSize,proto3,oneof" json:"max_header_size,omitempty"`
+	MaxTxSize         *uint64                `protobuf:"varint,5,opt,name=max_tx_size,json=maxTxSize,proto3,oneof" json:"max_tx_size,omitempty"`
+	MaxProposalSize   *uint64                `protobuf:"varint,6,opt,name=max_proposal_size,json=maxProposalSize,proto3,oneof" json:"max_proposal_size,omitempty"`
+	MpcThd            *uint64                `protobuf:"varint,7,opt,name=mpc_thd,json=mpcThd,proto3,oneof" json:"mpc_thd,omitempty"`
+	HeavyDelThd       *uint64                `protobuf:"varint,8,opt,name=heavy_del_thd,json=heavyDelThd,proto3,oneof" json:"heavy_del_thd,omitempty"`
+	UpdateVoteThd     *uint64                `protobuf:"varint,9,opt,name=update_vote_thd,json=updateVoteThd,proto3,oneof" json:"update_vote_thd,omitempty"`
+	UpdateProposalThd *uint64                `protobuf:"varint,10,opt,name=update_proposal_thd,json=updateProposalThd,proto3,oneof" json:"update_proposal_thd,omitempty"`
+	UpdateImplicit    *uint64                `protobuf:"varint,11,opt,name=update_implicit,json=updateImplicit,proto3,oneof" json:"update_implicit,omitempty"` // Slots
+	SoftforkRule      *ByronSoftforkRule     `protobuf:"bytes,12,opt,name=softfork_rule,json=softforkRule,proto3" json:"softfork_rule,omitempty"`
+	TxFeePolicy       *ByronTxFeePolicy      `protobuf:"bytes,13,opt,name=tx_fee_policy,json=txFeePolicy,proto3" json:"tx_fee_policy,omitempty"`
+	UnlockStakeEpoch  *uint64                `protobuf:"varint,14,opt,name=unlock_stake_epoch,json=unlockStakeEpoch,proto3,oneof" json:"unlock_stake_epoch,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ByronBlockVersionModification) Reset() {
+	*x = ByronBlockVersionModification{}
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ByronBlockVersionModification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ByronBlockVersionModification) ProtoMessage() {}
+
+func (x *ByronBlockVersionModification) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ByronBlockVersionModification.ProtoReflect.Descriptor instead.
+func (*ByronBlockVersionModification) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ByronBlockVersionModification) GetScriptVersion() uint32 {
+	if x != nil && x.ScriptVersion != nil {
+		return *x.ScriptVersion
+	}
+	return 0
+}
+
+func (x *ByronBlockVersionModification) GetSlotDuration() uint64 {
+	if x != nil && x.SlotDuration != nil {
+		return *x.SlotDuration
+	}
+	return 0
+}
+
+func (x *ByronBlockVersionModification) GetMaxBlockSize() uint64 {
+	if x != nil && x.MaxBlockSize != nil {
+		return *x.MaxBlockSize
+	}
+	return 0
+}
+
+func (x *ByronBlockVersionModification) GetMaxHeaderSize() uint64 {
+	if x != nil && x.MaxHeaderSize != nil {
+		return *x.MaxHeaderSize
+	}
+	return 0
+}
+
+func (x *ByronBlockVersionModification) GetMaxTxSize() uint64 {
+	if x != nil && x.MaxTxSize != nil {
+		return *x.MaxTxSize
+	}
+	return 0
+}
+
+func (x *ByronBlockVersionModification) GetMaxProposalSize() uint64 {
+	if x != nil && x.MaxProposalSize != nil {
+		return *x.MaxProposalSize
+	}
+	return 0
+}
+
+func (x *ByronBlockVersionModification) GetMpcThd() uint64 {
+	if x != nil && x.MpcThd != nil {
+		return *x.MpcThd
+	}
+	return 0
+}
+
+func (x *ByronBlockVersionModification) GetHeavyDelThd() uint64 {
+	if x != nil && x.HeavyDelThd != nil {
+		return *x.HeavyDelThd
+	}
+	return 0
+}
+
+func (x *ByronBlockVersionModification) GetUpdateVoteThd() uint64 {
+	if x != nil && x.UpdateVoteThd != nil {
+		return *x.UpdateVoteThd
+	}
+	return 0
+}
+
+func (x *ByronBlockVersionModification) GetUpdateProposalThd() uint64 {
+	if x != nil && x.UpdateProposalThd != nil {
+		return *x.UpdateProposalThd
+	}
+	return 0
+}
+
+func (x *ByronBlockVersionModification) GetUpdateImplicit() uint64 {
+	if x != nil && x.UpdateImplicit != nil {
+		return *x.UpdateImplicit
+	}
+	return 0
+}
+
+func (x *ByronBlockVersionModification) GetSoftforkRule() *ByronSoftforkRule {
+	if x != nil {
+		return x.SoftforkRule
+	}
+	return nil
+}
+
+func (x *ByronBlockVersionModification) GetTxFeePolicy() *ByronTxFeePolicy {
+	if x != nil {
+		return x.TxFeePolicy
+	}
+	return nil
+}
+
+func (x *ByronBlockVersionModification) GetUnlockStakeEpoch() uint64 {
+	if x != nil && x.UnlockStakeEpoch != nil {
+		return *x.UnlockStakeEpoch
+	}
+	return 0
+}
+
+type ByronSoftforkRule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InitThd       uint64                 `protobuf:"varint,1,opt,name=init_thd,json=initThd,proto3" json:"init_thd,omitempty"`
+	MinThd        uint64                 `protobuf:"varint,2,opt,name=min_thd,json=minThd,proto3" json:"min_thd,omitempty"`
+	ThdDecrement  uint64                 `protobuf:"varint,3,opt,name=thd_decrement,json=thdDecrement,proto3" json:"thd_decrement,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ByronSoftforkRule) Reset() {
+	*x = ByronSoftforkRule{}
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ByronSoftforkRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ByronSoftforkRule) ProtoMessage() {}
+
+func (x *ByronSoftforkRule) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ByronSoftforkRule.ProtoReflect.Descriptor instead.
+func (*ByronSoftforkRule) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ByronSoftforkRule) GetInitThd() uint64 {
+	if x != nil {
+		return x.InitThd
+	}
+	return 0
+}
+
+func (x *ByronSoftforkRule) GetMinThd() uint64 {
+	if x != nil {
+		return x.MinThd
+	}
+	return 0
+}
+
+func (x *ByronSoftforkRule) GetThdDecrement() uint64 {
+	if x != nil {
+		return x.ThdDecrement
+	}
+	return 0
+}
+
+// Linear fee policy: summand + multiplier * size.
+type ByronTxFeePolicy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Summand       uint64                 `protobuf:"varint,1,opt,name=summand,proto3" json:"summand,omitempty"`
+	Multiplier    uint64                 `protobuf:"varint,2,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ByronTxFeePolicy) Reset() {
+	*x = ByronTxFeePolicy{}
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ByronTxFeePolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ByronTxFeePolicy) ProtoMessage() {}
+
+func (x *ByronTxFeePolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ByronTxFeePolicy.ProtoReflect.Descriptor instead.
+func (*ByronTxFeePolicy) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ByronTxFeePolicy) GetSummand() uint64 {
+	if x != nil {
+		return x.Summand
+	}
+	return 0
+}
+
+func (x *ByronTxFeePolicy) GetMultiplier() uint64 {
+	if x != nil {
+		return x.Multiplier
+	}
+	return 0
+}
+
+type ByronSystemUpdate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SystemTag     string                 `protobuf:"bytes,1,opt,name=system_tag,json=systemTag,proto3" json:"system_tag,omitempty"`
+	AppDiffHash   []byte                 `protobuf:"bytes,2,opt,name=app_diff_hash,json=appDiffHash,proto3" json:"app_diff_hash,omitempty"`
+	PkgHash       []byte                 `protobuf:"bytes,3,opt,name=pkg_hash,json=pkgHash,proto3" json:"pkg_hash,omitempty"`
+	UpdaterHash   []byte                 `protobuf:"bytes,4,opt,name=updater_hash,json=updaterHash,proto3" json:"updater_hash,omitempty"`
+	MetadataHash  []byte                 `protobuf:"bytes,5,opt,name=metadata_hash,json=metadataHash,proto3" json:"metadata_hash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ByronSystemUpdate) Reset() {
+	*x = ByronSystemUpdate{}
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ByronSystemUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ByronSystemUpdate) ProtoMessage() {}
+
+func (x *ByronSystemUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ByronSystemUpdate.ProtoReflect.Descriptor instead.
+func (*ByronSystemUpdate) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ByronSystemUpdate) GetSystemTag() string {
+	if x != nil {
+		return x.SystemTag
+	}
+	return ""
+}
+
+func (x *ByronSystemUpdate) GetAppDiffHash() []byte {
+	if x != nil {
+		return x.AppDiffHash
+	}
+	return nil
+}
+
+func (x *ByronSystemUpdate) GetPkgHash() []byte {
+	if x != nil {
+		return x.PkgHash
+	}
+	return nil
+}
+
+func (x *ByronSystemUpdate) GetUpdaterHash() []byte {
+	if x != nil {
+		return x.UpdaterHash
+	}
+	return nil
+}
+
+func (x *ByronSystemUpdate) GetMetadataHash() []byte {
+	if x != nil {
+		return x.MetadataHash
+	}
+	return nil
+}
+
+type ByronUpdateVote struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VoterKey      []byte                 `protobuf:"bytes,1,opt,name=voter_key,json=voterKey,proto3" json:"voter_key,omitempty"`       // Extended public key of the voter
+	ProposalId    []byte                 `protobuf:"bytes,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"` // Id of the proposal voted on
+	Approve       bool                   `protobuf:"varint,3,opt,name=approve,proto3" json:"approve,omitempty"`
+	Signature     []byte                 `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ByronUpdateVote) Reset() {
+	*x = ByronUpdateVote{}
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ByronUpdateVote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ByronUpdateVote) ProtoMessage() {}
+
+func (x *ByronUpdateVote) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ByronUpdateVote.ProtoReflect.Descriptor instead.
+func (*ByronUpdateVote) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ByronUpdateVote) GetVoterKey() []byte {
+	if x != nil {
+		return x.VoterKey
+	}
+	return nil
+}
+
+func (x *ByronUpdateVote) GetProposalId() []byte {
+	if x != nil {
+		return x.ProposalId
+	}
+	return nil
+}
+
+func (x *ByronUpdateVote) GetApprove() bool {
+	if x != nil {
+		return x.Approve
+	}
+	return false
+}
+
+func (x *ByronUpdateVote) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+// A Byron address, decoded.
+type ByronAddress struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Root           []byte                 `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`                                            // Hash of the address spending data and attributes
+	Type           uint32                 `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`                                           // 0 public key, 1 script, 2 redeem
+	DerivationPath []byte                 `protobuf:"bytes,3,opt,name=derivation_path,json=derivationPath,proto3" json:"derivation_path,omitempty"`  // Encrypted HD derivation path attribute, if any
+	NetworkMagic   *uint32                `protobuf:"varint,4,opt,name=network_magic,json=networkMagic,proto3,oneof" json:"network_magic,omitempty"` // Network magic attribute (testnets)
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ByronAddress) Reset() {
+	*x = ByronAddress{}
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ByronAddress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ByronAddress) ProtoMessage() {}
+
+func (x *ByronAddress) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ByronAddress.ProtoReflect.Descriptor instead.
+func (*ByronAddress) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ByronAddress) GetRoot() []byte {
+	if x != nil {
+		return x.Root
+	}
+	return nil
+}
+
+func (x *ByronAddress) GetType() uint32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *ByronAddress) GetDerivationPath() []byte {
+	if x != nil {
+		return x.DerivationPath
+	}
+	return nil
+}
+
+func (x *ByronAddress) GetNetworkMagic() uint32 {
+	if x != nil && x.NetworkMagic != nil {
+		return *x.NetworkMagic
+	}
+	return 0
+}
+
+// Aggregates of the transactions of a block. Transactions whose scripts
+// failed only count for what they consume: their collateral, their scripts
+// and redeemers.
+type BlockStats struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TxCount        uint64                 `protobuf:"varint,1,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
+	InvalidTxCount uint64                 `protobuf:"varint,2,opt,name=invalid_tx_count,json=invalidTxCount,proto3" json:"invalid_tx_count,omitempty"` // Transactions whose scripts failed
+	Fees           uint64                 `protobuf:"varint,3,opt,name=fees,proto3" json:"fees,omitempty"`                                             // Fees, plus the collateral collected from invalid transactions,
+	// of the transactions whose ledger effect fee is known
+	OutputLovelace  uint64            `protobuf:"varint,4,opt,name=output_lovelace,json=outputLovelace,proto3" json:"output_lovelace,omitempty"` // Lovelace of the outputs produced
+	ScriptCount     uint64            `protobuf:"varint,5,opt,name=script_count,json=scriptCount,proto3" json:"script_count,omitempty"`          // Scripts executed
+	RedeemerCount   uint64            `protobuf:"varint,6,opt,name=redeemer_count,json=redeemerCount,proto3" json:"redeemer_count,omitempty"`
+	ExUnits         *ExUnits          `protobuf:"bytes,7,opt,name=ex_units,json=exUnits,proto3" json:"ex_units,omitempty"`                                                                       // Execution units of all redeemers
+	Certificates    map[string]uint64 `protobuf:"bytes,8,rep,name=certificates,proto3" json:"certificates,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // Certificate count by type (Certificate field name)
+	MintCount       uint64            `protobuf:"varint,9,opt,name=mint_count,json=mintCount,proto3" json:"mint_count,omitempty"`                                                                // Assets minted, counted once per transaction
+	BurnCount       uint64            `protobuf:"varint,10,opt,name=burn_count,json=burnCount,proto3" json:"burn_count,omitempty"`                                                               // Assets burnt, counted once per transaction
+	UnknownFeeCount uint64            `protobuf:"varint,11,opt,name=unknown_fee_count,json=unknownFeeCount,proto3" json:"unknown_fee_count,omitempty"`                                           // Transactions whose fee is unknown, left out of fees
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *BlockStats) Reset() {
+	*x = BlockStats{}
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockStats) ProtoMessage() {}
+
+func (x *BlockStats) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockStats.ProtoReflect.Descriptor instead.
+func (*BlockStats) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *BlockStats) GetTxCount() uint64 {
+	if x != nil {
+		return x.TxCount
+	}
+	return 0
+}
+
+func (x *BlockStats) GetInvalidTxCount() uint64 {
+	if x != nil {
+		return x.InvalidTxCount
+	}
+	return 0
+}
+
+func (x *BlockStats) GetFees() uint64 {
+	if x != nil {
+		return x.Fees
+	}
+	return 0
+}
+
+func (x *BlockStats) GetOutputLovelace() uint64 {
+	if x != nil {
+		return x.OutputLovelace
+	}
+	return 0
+}
+
+func (x *BlockStats) GetScriptCount() uint64 {
+	if x != nil {
+		return x.ScriptCount
+	}
+	return 0
+}
+
+func (x *BlockStats) GetRedeemerCount() uint64 {
+	if x != nil {
+		return x.RedeemerCount
+	}
+	return 0
+}
+
+func (x *BlockStats) GetExUnits() *ExUnits {
+	if x != nil {
+		return x.ExUnits
+	}
+	return nil
+}
+
+func (x *BlockStats) GetCertificates() map[string]uint64 {
+	if x != nil {
+		return x.Certificates
+	}
+	return nil
+}
+
+func (x *BlockStats) GetMintCount() uint64 {
+	if x != nil {
+		return x.MintCount
+	}
+	return 0
+}
+
+func (x *BlockStats) GetBurnCount() uint64 {
+	if x != nil {
+		return x.BurnCount
+	}
+	return 0
+}
+
+func (x *BlockStats) GetUnknownFeeCount() uint64 {
+	if x != nil {
+		return x.UnknownFeeCount
+	}
+	return 0
+}
+
+// Marks the first block of an epoch. Epochs without blocks are skipped:
+// previous_epoch is the last epoch a block was seen in.
+type EpochTransition struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	PreviousEpoch           uint64                 `protobuf:"varint,1,opt,name=previous_epoch,json=previousEpoch,proto3" json:"previous_epoch,omitempty"`
+	Epoch                   uint64                 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	FirstSlot               uint64                 `protobuf:"varint,3,opt,name=first_slot,json=firstSlot,proto3" json:"first_slot,omitempty"` // First slot of the epoch, the block may come later
+	PreviousEpochBlockCount uint64                 `protobuf:"varint,4,opt,name=previous_epoch_block_count,json=previousEpochBlockCount,proto3" json:"previous_epoch_block_count,omitempty"`
+	PreviousEpochTxCount    uint64                 `protobuf:"varint,5,opt,name=previous_epoch_tx_count,json=previousEpochTxCount,proto3" json:"previous_epoch_tx_count,omitempty"`
+	Partial                 bool                   `protobuf:"varint,6,opt,name=partial,proto3" json:"partial,omitempty"` // The counts only cover the blocks followed since the
 	unknownFields           protoimpl.UnknownFields
 	sizeCache               protoimpl.SizeCache
 }
 
-func (x *NewCommitteeCredentials) Reset() {
-	*x = NewCommitteeCredentials{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[23]
+func (x *EpochTransition) Reset() {
+	*x = EpochTransition{}
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EpochTransition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EpochTransition) ProtoMessage() {}
+
+func (x *EpochTransition) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EpochTransition.ProtoReflect.Descriptor instead.
+func (*EpochTransition) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *EpochTransition) GetPreviousEpoch() uint64 {
+	if x != nil {
+		return x.PreviousEpoch
+	}
+	return 0
+}
+
+func (x *EpochTransition) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *EpochTransition) GetFirstSlot() uint64 {
+	if x != nil {
+		return x.FirstSlot
+	}
+	return 0
+}
+
+func (x *EpochTransition) GetPreviousEpochBlockCount() uint64 {
+	if x != nil {
+		return x.PreviousEpochBlockCount
+	}
+	return 0
+}
+
+func (x *EpochTransition) GetPreviousEpochTxCount() uint64 {
+	if x != nil {
+		return x.PreviousEpochTxCount
+	}
+	return 0
+}
+
+func (x *EpochTransition) GetPartial() bool {
+	if x != nil {
+		return x.Partial
+	}
+	return false
+}
+
+// Ledger state a local node reports at the first block of an epoch, queried
+// through LocalStateQuery.
+type LedgerSnapshot struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Epoch             uint64                 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	EraHistory        *EraSummaries          `protobuf:"bytes,2,opt,name=era_history,json=eraHistory,proto3" json:"era_history,omitempty"`                      // Eras as the node knows them
+	ProtocolParams    *PParams               `protobuf:"bytes,3,opt,name=protocol_params,json=protocolParams,proto3" json:"protocol_params,omitempty"`          // Parameters in force in the epoch
+	StakeDistribution []*PoolStake           `protobuf:"bytes,4,rep,name=stake_distribution,json=stakeDistribution,proto3" json:"stake_distribution,omitempty"` // Stake of the pools in the epoch, sorted by pool id
+	Pools             []*PoolParams          `protobuf:"bytes,5,rep,name=pools,proto3" json:"pools,omitempty"`                                                  // Registered pools, sorted by pool id
+	Treasury          *uint64                `protobuf:"varint,6,opt,name=treasury,proto3,oneof" json:"treasury,omitempty"`                                     // Unset when the epoch state could not be queried
+	Reserves          *uint64                `protobuf:"varint,7,opt,name=reserves,proto3,oneof" json:"reserves,omitempty"`                                     // Unset along with the treasury
+	Governance        *GovernanceState       `protobuf:"bytes,8,opt,name=governance,proto3" json:"governance,omitempty"`                                        // Unset before Conway or along with the treasury
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *LedgerSnapshot) Reset() {
+	*x = LedgerSnapshot{}
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LedgerSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerSnapshot) ProtoMessage() {}
+
+func (x *LedgerSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerSnapshot.ProtoReflect.Descriptor instead.
+func (*LedgerSnapshot) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *LedgerSnapshot) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *LedgerSnapshot) GetEraHistory() *EraSummaries {
+	if x != nil {
+		return x.EraHistory
+	}
+	return nil
+}
+
+func (x *LedgerSnapshot) GetProtocolParams() *PParams {
+	if x != nil {
+		return x.ProtocolParams
+	}
+	return nil
+}
+
+func (x *LedgerSnapshot) GetStakeDistribution() []*PoolStake {
+	if x != nil {
+		return x.StakeDistribution
+	}
+	return nil
+}
+
+func (x *LedgerSnapshot) GetPools() []*PoolParams {
+	if x != nil {
+		return x.Pools
+	}
+	return nil
+}
+
+func (x *LedgerSnapshot) GetTreasury() uint64 {
+	if x != nil && x.Treasury != nil {
+		return *x.Treasury
+	}
+	return 0
+}
+
+func (x *LedgerSnapshot) GetReserves() uint64 {
+	if x != nil && x.Reserves != nil {
+		return *x.Reserves
+	}
+	return 0
+}
+
+func (x *LedgerSnapshot) GetGovernance() *GovernanceState {
+	if x != nil {
+		return x.Governance
+	}
+	return nil
+}
+
+// Conway governance state of the ledger.
+type GovernanceState struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Constitution       *Constitution          `protobuf:"bytes,1,opt,name=constitution,proto3" json:"constitution,omitempty"`
+	CommitteeThreshold *RationalNumber        `protobuf:"bytes,2,opt,name=committee_threshold,json=committeeThreshold,proto3" json:"committee_threshold,omitempty"` // Vote threshold of the committee, unset without one
+	Proposals          []*GovernanceActionId  `protobuf:"bytes,3,rep,name=proposals,proto3" json:"proposals,omitempty"`                                             // Actions under vote, in submission order
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GovernanceState) Reset() {
+	*x = GovernanceState{}
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GovernanceState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GovernanceState) ProtoMessage() {}
+
+func (x *GovernanceState) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GovernanceState.ProtoReflect.Descriptor instead.
+func (*GovernanceState) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GovernanceState) GetConstitution() *Constitution {
+	if x != nil {
+		return x.Constitution
+	}
+	return nil
+}
+
+func (x *GovernanceState) GetCommitteeThreshold() *RationalNumber {
+	if x != nil {
+		return x.CommitteeThreshold
+	}
+	return nil
+}
+
+func (x *GovernanceState) GetProposals() []*GovernanceActionId {
+	if x != nil {
+		return x.Proposals
+	}
+	return nil
+}
+
+// Share of the active stake delegated to a pool.
+type PoolStake struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	PoolId           []byte                 `protobuf:"bytes,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	StakeNumerator   uint64                 `protobuf:"varint,2,opt,name=stake_numerator,json=stakeNumerator,proto3" json:"stake_numerator,omitempty"`
+	StakeDenominator uint64                 `protobuf:"varint,3,opt,name=stake_denominator,json=stakeDenominator,proto3" json:"stake_denominator,omitempty"`
+	VrfKeyhash       []byte                 `protobuf:"bytes,4,opt,name=vrf_keyhash,json=vrfKeyhash,proto3" json:"vrf_keyhash,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *PoolStake) Reset() {
+	*x = PoolStake{}
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PoolStake) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PoolStake) ProtoMessage() {}
+
+func (x *PoolStake) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PoolStake.ProtoReflect.Descriptor instead.
+func (*PoolStake) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *PoolStake) GetPoolId() []byte {
+	if x != nil {
+		return x.PoolId
+	}
+	return nil
+}
+
+func (x *PoolStake) GetStakeNumerator() uint64 {
+	if x != nil {
+		return x.StakeNumerator
+	}
+	return 0
+}
+
+func (x *PoolStake) GetStakeDenominator() uint64 {
+	if x != nil {
+		return x.StakeDenominator
+	}
+	return 0
+}
+
+func (x *PoolStake) GetVrfKeyhash() []byte {
+	if x != nil {
+		return x.VrfKeyhash
+	}
+	return nil
+}
+
+// Current registration of a pool.
+type PoolParams struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PoolId        []byte                 `protobuf:"bytes,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	Params        *PoolRegistrationCert  `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PoolParams) Reset() {
+	*x = PoolParams{}
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PoolParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PoolParams) ProtoMessage() {}
+
+func (x *PoolParams) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PoolParams.ProtoReflect.Descriptor instead.
+func (*PoolParams) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *PoolParams) GetPoolId() []byte {
+	if x != nil {
+		return x.PoolId
+	}
+	return nil
+}
+
+func (x *PoolParams) GetParams() *PoolRegistrationCert {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+// Represents a VKey witness used to sign a transaction.
+type VKeyWitness struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vkey          []byte                 `protobuf:"bytes,1,opt,name=vkey,proto3" json:"vkey,omitempty"`           // Verification key.
+	Signature     []byte                 `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"` // Signature generated using the associated private key.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VKeyWitness) Reset() {
+	*x = VKeyWitness{}
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VKeyWitness) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VKeyWitness) ProtoMessage() {}
+
+func (x *VKeyWitness) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VKeyWitness.ProtoReflect.Descriptor instead.
+func (*VKeyWitness) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *VKeyWitness) GetVkey() []byte {
+	if x != nil {
+		return x.Vkey
+	}
+	return nil
+}
+
+func (x *VKeyWitness) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+// Represents a native script in Cardano.
+type NativeScript struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to NativeScript:
+	//
+	//	*NativeScript_ScriptPubkey
+	//	*NativeScript_ScriptAll
+	//	*NativeScript_ScriptAny
+	//	*NativeScript_ScriptNOfK
+	//	*NativeScript_InvalidBefore
+	//	*NativeScript_InvalidHereafter
+	NativeScript  isNativeScript_NativeScript `protobuf_oneof:"native_script"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NativeScript) Reset() {
+	*x = NativeScript{}
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NativeScript) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NativeScript) ProtoMessage() {}
+
+func (x *NativeScript) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NativeScript.ProtoReflect.Descriptor instead.
+func (*NativeScript) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *NativeScript) GetNativeScript() isNativeScript_NativeScript {
+	if x != nil {
+		return x.NativeScript
+	}
+	return nil
+}
+
+func (x *NativeScript) GetScriptPubkey() []byte {
+	if x != nil {
+		if x, ok := x.NativeScript.(*NativeScript_ScriptPubkey); ok {
+			return x.ScriptPubkey
+		}
+	}
+	return nil
+}
+
+func (x *NativeScript) GetScriptAll() *NativeScriptList {
+	if x != nil {
+		if x, ok := x.NativeScript.(*NativeScript_ScriptAll); ok {
+			return x.ScriptAll
+		}
+	}
+	return nil
+}
+
+func (x *NativeScript) GetScriptAny() *NativeScriptList {
+	if x != nil {
+		if x, ok := x.NativeScript.(*NativeScript_ScriptAny); ok {
+			return x.ScriptAny
+		}
+	}
+	return nil
+}
+
+func (x *NativeScript) GetScriptNOfK() *ScriptNOfK {
+	if x != nil {
+		if x, ok := x.NativeScript.(*NativeScript_ScriptNOfK); ok {
+			return x.ScriptNOfK
+		}
+	}
+	return nil
+}
+
+func (x *NativeScript) GetInvalidBefore() uint64 {
+	if x != nil {
+		if x, ok := x.NativeScript.(*NativeScript_InvalidBefore); ok {
+			return x.InvalidBefore
+		}
+	}
+	return 0
+}
+
+func (x *NativeScript) GetInvalidHereafter() uint64 {
+	if x != nil {
+		if x, ok := x.NativeScript.(*NativeScript_InvalidHereafter); ok {
+			return x.InvalidHereafter
+		}
+	}
+	return 0
+}
+
+type isNativeScript_NativeScript interface {
+	isNativeScript_NativeScript()
+}
+
+type NativeScript_ScriptPubkey struct {
+	ScriptPubkey []byte `protobuf:"bytes,1,opt,name=script_pubkey,json=scriptPubkey,proto3,oneof"` // Script based on an address key hash.
+}
+
+type NativeScript_ScriptAll struct {
+	ScriptAll *NativeScriptList `protobuf:"bytes,2,opt,name=script_all,json=scriptAll,proto3,oneof"` // Script that requires all nested scripts to be satisfied.
+}
+
+type NativeScript_ScriptAny struct {
+	ScriptAny *NativeScriptList `protobuf:"bytes,3,opt,name=script_any,json=scriptAny,proto3,oneof"` // Script that requires any of the nested scripts to be satisfied.
+}
+
+type NativeScript_ScriptNOfK struct {
+	ScriptNOfK *ScriptNOfK `protobuf:"bytes,4,opt,name=script_n_of_k,json=scriptNOfK,proto3,oneof"` // Script that requires k out of n nested scripts to be satisfied.
+}
+
+type NativeScript_InvalidBefore struct {
+	InvalidBefore uint64 `protobuf:"varint,5,opt,name=invalid_before,json=invalidBefore,proto3,oneof"` // Slot number before which the script is invalid.
+}
+
+type NativeScript_InvalidHereafter struct {
+	InvalidHereafter uint64 `protobuf:"varint,6,opt,name=invalid_hereafter,json=invalidHereafter,proto3,oneof"` // Slot number after which the script is invalid.
+}
+
+func (*NativeScript_ScriptPubkey) isNativeScript_NativeScript() {}
+
+func (*NativeScript_ScriptAll) isNativeScript_NativeScript() {}
+
+func (*NativeScript_ScriptAny) isNativeScript_NativeScript() {}
+
+func (*NativeScript_ScriptNOfK) isNativeScript_NativeScript() {}
+
+func (*NativeScript_InvalidBefore) isNativeScript_NativeScript() {}
+
+func (*NativeScript_InvalidHereafter) isNativeScript_NativeScript() {}
+
+// Represents a list of native scripts.
+type NativeScriptList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*NativeScript        `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"` // List of native scripts.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NativeScriptList) Reset() {
+	*x = NativeScriptList{}
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NativeScriptList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NativeScriptList) ProtoMessage() {}
+
+func (x *NativeScriptList) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NativeScriptList.ProtoReflect.Descriptor instead.
+func (*NativeScriptList) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *NativeScriptList) GetItems() []*NativeScript {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// Represents a "k out of n" native script.
+type ScriptNOfK struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	K             uint32                 `protobuf:"varint,1,opt,name=k,proto3" json:"k,omitempty"`            // The number of required satisfied scripts.
+	Scripts       []*NativeScript        `protobuf:"bytes,2,rep,name=scripts,proto3" json:"scripts,omitempty"` // List of native scripts.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScriptNOfK) Reset() {
+	*x = ScriptNOfK{}
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScriptNOfK) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScriptNOfK) ProtoMessage() {}
+
+func (x *ScriptNOfK) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScriptNOfK.ProtoReflect.Descriptor instead.
+func (*ScriptNOfK) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ScriptNOfK) GetK() uint32 {
+	if x != nil {
+		return x.K
+	}
+	return 0
+}
+
+func (x *ScriptNOfK) GetScripts() []*NativeScript {
+	if x != nil {
+		return x.Scripts
+	}
+	return nil
+}
+
+// Represents a constructor for Plutus data in Cardano.
+type Constr struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Tag            uint32                 `protobuf:"varint,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	AnyConstructor uint64                 `protobuf:"varint,2,opt,name=any_constructor,json=anyConstructor,proto3" json:"any_constructor,omitempty"`
+	Fields         []*PlutusData          `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Constr) Reset() {
+	*x = Constr{}
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Constr) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Constr) ProtoMessage() {}
+
+func (x *Constr) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Constr.ProtoReflect.Descriptor instead.
+func (*Constr) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *Constr) GetTag() uint32 {
+	if x != nil {
+		return x.Tag
+	}
+	return 0
+}
+
+func (x *Constr) GetAnyConstructor() uint64 {
+	if x != nil {
+		return x.AnyConstructor
+	}
+	return 0
+}
+
+func (x *Constr) GetFields() []*PlutusData {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+// Represents a big integer for Plutus data in Cardano.
+type BigInt struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to BigInt:
+	//
+	//	*BigInt_Int
+	//	*BigInt_BigUInt
+	//	*BigInt_BigNInt
+	BigInt        isBigInt_BigInt `protobuf_oneof:"big_int"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BigInt) Reset() {
+	*x = BigInt{}
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BigInt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BigInt) ProtoMessage() {}
+
+func (x *BigInt) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BigInt.ProtoReflect.Descriptor instead.
+func (*BigInt) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *BigInt) GetBigInt() isBigInt_BigInt {
+	if x != nil {
+		return x.BigInt
+	}
+	return nil
+}
+
+func (x *BigInt) GetInt() int64 {
+	if x != nil {
+		if x, ok := x.BigInt.(*BigInt_Int); ok {
+			return x.Int
+		}
+	}
+	return 0
+}
+
+func (x *BigInt) GetBigUInt() []byte {
+	if x != nil {
+		if x, ok := x.BigInt.(*BigInt_BigUInt); ok {
+			return x.BigUInt
+		}
+	}
+	return nil
+}
+
+func (x *BigInt) GetBigNInt() []byte {
+	if x != nil {
+		if x, ok := x.BigInt.(*BigInt_BigNInt); ok {
+			return x.BigNInt
+		}
+	}
+	return nil
+}
+
+type isBigInt_BigInt interface {
+	isBigInt_BigInt()
+}
+
+type BigInt_Int struct {
+	Int int64 `protobuf:"varint,1,opt,name=int,proto3,oneof"`
+}
+
+type BigInt_BigUInt struct {
+	BigUInt []byte `protobuf:"bytes,2,opt,name=big_u_int,json=bigUInt,proto3,oneof"`
+}
+
+type BigInt_BigNInt struct {
+	BigNInt []byte `protobuf:"bytes,3,opt,name=big_n_int,json=bigNInt,proto3,oneof"`
+}
+
+func (*BigInt_Int) isBigInt_BigInt() {}
+
+func (*BigInt_BigUInt) isBigInt_BigInt() {}
+
+func (*BigInt_BigNInt) isBigInt_BigInt() {}
+
+// Represents a key-value pair for Plutus data in Cardano.
+type PlutusDataPair struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           *PlutusData            `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`     // Key of the pair.
+	Value         *PlutusData            `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"` // Value of the pair.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlutusDataPair) Reset() {
+	*x = PlutusDataPair{}
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlutusDataPair) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlutusDataPair) ProtoMessage() {}
+
+func (x *PlutusDataPair) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlutusDataPair.ProtoReflect.Descriptor instead.
+func (*PlutusDataPair) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *PlutusDataPair) GetKey() *PlutusData {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *PlutusDataPair) GetValue() *PlutusData {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+// Represents a Plutus data item in Cardano.
+type PlutusData struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to PlutusData:
+	//
+	//	*PlutusData_Constr
+	//	*PlutusData_Map
+	//	*PlutusData_BigInt
+	//	*PlutusData_BoundedBytes
+	//	*PlutusData_Array
+	PlutusData    isPlutusData_PlutusData `protobuf_oneof:"plutus_data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlutusData) Reset() {
+	*x = PlutusData{}
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlutusData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlutusData) ProtoMessage() {}
+
+func (x *PlutusData) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlutusData.ProtoReflect.Descriptor instead.
+func (*PlutusData) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *PlutusData) GetPlutusData() isPlutusData_PlutusData {
+	if x != nil {
+		return x.PlutusData
+	}
+	return nil
+}
+
+func (x *PlutusData) GetConstr() *Constr {
+	if x != nil {
+		if x, ok := x.PlutusData.(*PlutusData_Constr); ok {
+			return x.Constr
+		}
+	}
+	return nil
+}
+
+func (x *PlutusData) GetMap() *PlutusDataMap {
+	if x != nil {
+		if x, ok := x.PlutusData.(*PlutusData_Map); ok {
+			return x.Map
+		}
+	}
+	return nil
+}
+
+func (x *PlutusData) GetBigInt() *BigInt {
+	if x != nil {
+		if x, ok := x.PlutusData.(*PlutusData_BigInt); ok {
+			return x.BigInt
+		}
+	}
+	return nil
+}
+
+func (x *PlutusData) GetBoundedBytes() []byte {
+	if x != nil {
+		if x, ok := x.PlutusData.(*PlutusData_BoundedBytes); ok {
+			return x.BoundedBytes
+		}
+	}
+	return nil
+}
+
+func (x *PlutusData) GetArray() *PlutusDataArray {
+	if x != nil {
+		if x, ok := x.PlutusData.(*PlutusData_Array); ok {
+			return x.Array
+		}
+	}
+	return nil
+}
+
+type isPlutusData_PlutusData interface {
+	isPlutusData_PlutusData()
+}
+
+type PlutusData_Constr struct {
+	Constr *Constr `protobuf:"bytes,2,opt,name=constr,proto3,oneof"` // Constructor.
+}
+
+type PlutusData_Map struct {
+	Map *PlutusDataMap `protobuf:"bytes,3,opt,name=map,proto3,oneof"` // Map of Plutus data.
+}
+
+type PlutusData_BigInt struct {
+	BigInt *BigInt `protobuf:"bytes,4,opt,name=big_int,json=bigInt,proto3,oneof"` // Big integer.
+}
+
+type PlutusData_BoundedBytes struct {
+	BoundedBytes []byte `protobuf:"bytes,5,opt,name=bounded_bytes,json=boundedBytes,proto3,oneof"` // Bounded bytes.
+}
+
+type PlutusData_Array struct {
+	Array *PlutusDataArray `protobuf:"bytes,6,opt,name=array,proto3,oneof"` // Array of Plutus data.
+}
+
+func (*PlutusData_Constr) isPlutusData_PlutusData() {}
+
+func (*PlutusData_Map) isPlutusData_PlutusData() {}
+
+func (*PlutusData_BigInt) isPlutusData_PlutusData() {}
+
+func (*PlutusData_BoundedBytes) isPlutusData_PlutusData() {}
+
+func (*PlutusData_Array) isPlutusData_PlutusData() {}
+
+// Represents a map of Plutus data in Cardano.
+type PlutusDataMap struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pairs         []*PlutusDataPair      `protobuf:"bytes,1,rep,name=pairs,proto3" json:"pairs,omitempty"` // List of key-value pairs.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlutusDataMap) Reset() {
+	*x = PlutusDataMap{}
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlutusDataMap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlutusDataMap) ProtoMessage() {}
+
+func (x *PlutusDataMap) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlutusDataMap.ProtoReflect.Descriptor instead.
+func (*PlutusDataMap) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *PlutusDataMap) GetPairs() []*PlutusDataPair {
+	if x != nil {
+		return x.Pairs
+	}
+	return nil
+}
+
+// Represents an array of Plutus data in Cardano.
+type PlutusDataArray struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*PlutusData          `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"` // List of Plutus data items.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlutusDataArray) Reset() {
+	*x = PlutusDataArray{}
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NewCommitteeCredentials) String() string {
+func (x *PlutusDataArray) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlutusDataArray) ProtoMessage() {}
+
+func (x *PlutusDataArray) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlutusDataArray.ProtoReflect.Descriptor instead.
+func (*PlutusDataArray) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *PlutusDataArray) GetItems() []*PlutusData {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// Represents a script in Cardano.
+type Script struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Script:
+	//
+	//	*Script_Native
+	//	*Script_PlutusV1
+	//	*Script_PlutusV2
+	//	*Script_PlutusV3
+	Script        isScript_Script `protobuf_oneof:"script"`
+	Hash          []byte          `protobuf:"bytes,5,opt,name=hash,proto3" json:"hash,omitempty"` // Hash of the script as seen on-chain
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Script) Reset() {
+	*x = Script{}
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Script) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Script) ProtoMessage() {}
+
+func (x *Script) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Script.ProtoReflect.Descriptor instead.
+func (*Script) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *Script) GetScript() isScript_Script {
+	if x != nil {
+		return x.Script
+	}
+	return nil
+}
+
+func (x *Script) GetNative() *NativeScript {
+	if x != nil {
+		if x, ok := x.Script.(*Script_Native); ok {
+			return x.Native
+		}
+	}
+	return nil
+}
+
+func (x *Script) GetPlutusV1() []byte {
+	if x != nil {
+		if x, ok := x.Script.(*Script_PlutusV1); ok {
+			return x.PlutusV1
+		}
+	}
+	return nil
+}
+
+func (x *Script) GetPlutusV2() []byte {
+	if x != nil {
+		if x, ok := x.Script.(*Script_PlutusV2); ok {
+			return x.PlutusV2
+		}
+	}
+	return nil
+}
+
+func (x *Script) GetPlutusV3() []byte {
+	if x != nil {
+		if x, ok := x.Script.(*Script_PlutusV3); ok {
+			return x.PlutusV3
+		}
+	}
+	return nil
+}
+
+func (x *Script) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+type isScript_Script interface {
+	isScript_Script()
+}
+
+type Script_Native struct {
+	Native *NativeScript `protobuf:"bytes,1,opt,name=native,proto3,oneof"` // Native script.
+}
+
+type Script_PlutusV1 struct {
+	PlutusV1 []byte `protobuf:"bytes,2,opt,name=plutus_v1,json=plutusV1,proto3,oneof"` // Plutus V1 script.
+}
+
+type Script_PlutusV2 struct {
+	PlutusV2 []byte `protobuf:"bytes,3,opt,name=plutus_v2,json=plutusV2,proto3,oneof"` // Plutus V2 script.
+}
+
+type Script_PlutusV3 struct {
+	PlutusV3 []byte `protobuf:"bytes,4,opt,name=plutus_v3,json=plutusV3,proto3,oneof"` // Plutus V3 script.
+}
+
+func (*Script_Native) isScript_Script() {}
+
+func (*Script_PlutusV1) isScript_Script() {}
+
+func (*Script_PlutusV2) isScript_Script() {}
+
+func (*Script_PlutusV3) isScript_Script() {}
+
+// A script a transaction has to satisfy: native scripts and the Plutus
+// scripts its redeemers are for.
+type ExecutedScript struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hash          []byte                 `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`                                                 // Hash of the script.
+	Language      ScriptLanguage         `protobuf:"varint,2,opt,name=language,proto3,enum=sf.cardano.type.v1.ScriptLanguage" json:"language,omitempty"` // Language, unspecified when unknown.
+	Script        *Script                `protobuf:"bytes,3,opt,name=script,proto3" json:"script,omitempty"`                                             // The script, unset when unknown.
+	Origin        ScriptOrigin           `protobuf:"varint,4,opt,name=origin,proto3,enum=sf.cardano.type.v1.ScriptOrigin" json:"origin,omitempty"`       // Where the script was found.
+	TxHash        []byte                 `protobuf:"bytes,5,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`                               // Output providing the reference script, for the input
+	OutputIndex   uint32                 `protobuf:"varint,6,opt,name=output_index,json=outputIndex,proto3" json:"output_index,omitempty"`               // origins.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecutedScript) Reset() {
+	*x = ExecutedScript{}
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecutedScript) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecutedScript) ProtoMessage() {}
+
+func (x *ExecutedScript) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecutedScript.ProtoReflect.Descriptor instead.
+func (*ExecutedScript) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ExecutedScript) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+func (x *ExecutedScript) GetLanguage() ScriptLanguage {
+	if x != nil {
+		return x.Language
+	}
+	return ScriptLanguage_SCRIPT_LANGUAGE_UNSPECIFIED
+}
+
+func (x *ExecutedScript) GetScript() *Script {
+	if x != nil {
+		return x.Script
+	}
+	return nil
+}
+
+func (x *ExecutedScript) GetOrigin() ScriptOrigin {
+	if x != nil {
+		return x.Origin
+	}
+	return ScriptOrigin_SCRIPT_ORIGIN_UNSPECIFIED
+}
+
+func (x *ExecutedScript) GetTxHash() []byte {
+	if x != nil {
+		return x.TxHash
+	}
+	return nil
+}
+
+func (x *ExecutedScript) GetOutputIndex() uint32 {
+	if x != nil {
+		return x.OutputIndex
+	}
+	return 0
+}
+
+type Metadatum struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Metadatum:
+	//
+	//	*Metadatum_Int
+	//	*Metadatum_Bytes
+	//	*Metadatum_Text
+	//	*Metadatum_Array
+	//	*Metadatum_Map
+	//	*Metadatum_BigInt
+	Metadatum     isMetadatum_Metadatum `protobuf_oneof:"metadatum"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Metadatum) Reset() {
+	*x = Metadatum{}
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Metadatum) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NewCommitteeCredentials) ProtoMessage() {}
+func (*Metadatum) ProtoMessage() {}
 
-func (x *NewCommitteeCredentials) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[23]
+func (x *Metadatum) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1733,50 +5596,134 @@ func (x *NewCommitteeCredentials) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NewCommitteeCredentials.ProtoReflect.Descriptor instead.
-func (*NewCommitteeCredentials) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use Metadatum.ProtoReflect.Descriptor instead.
+func (*Metadatum) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *NewCommitteeCredentials) GetCommitteeColdCredential() *StakeCredential {
+func (x *Metadatum) GetMetadatum() isMetadatum_Metadatum {
 	if x != nil {
-		return x.CommitteeColdCredential
+		return x.Metadatum
 	}
 	return nil
 }
 
-func (x *NewCommitteeCredentials) GetExpiresEpoch() uint32 {
+func (x *Metadatum) GetInt() int64 {
 	if x != nil {
-		return x.ExpiresEpoch
+		if x, ok := x.Metadatum.(*Metadatum_Int); ok {
+			return x.Int
+		}
 	}
 	return 0
 }
 
-// Contains the header information for a block.
-type BlockHeader struct {
+func (x *Metadatum) GetBytes() []byte {
+	if x != nil {
+		if x, ok := x.Metadatum.(*Metadatum_Bytes); ok {
+			return x.Bytes
+		}
+	}
+	return nil
+}
+
+func (x *Metadatum) GetText() string {
+	if x != nil {
+		if x, ok := x.Metadatum.(*Metadatum_Text); ok {
+			return x.Text
+		}
+	}
+	return ""
+}
+
+func (x *Metadatum) GetArray() *MetadatumArray {
+	if x != nil {
+		if x, ok := x.Metadatum.(*Metadatum_Array); ok {
+			return x.Array
+		}
+	}
+	return nil
+}
+
+func (x *Metadatum) GetMap() *MetadatumMap {
+	if x != nil {
+		if x, ok := x.Metadatum.(*Metadatum_Map); ok {
+			return x.Map
+		}
+	}
+	return nil
+}
+
+func (x *Metadatum) GetBigInt() *BigInt {
+	if x != nil {
+		if x, ok := x.Metadatum.(*Metadatum_BigInt); ok {
+			return x.BigInt
+		}
+	}
+	return nil
+}
+
+type isMetadatum_Metadatum interface {
+	isMetadatum_Metadatum()
+}
+
+type Metadatum_Int struct {
+	Int int64 `protobuf:"varint,1,opt,name=int,proto3,oneof"`
+}
+
+type Metadatum_Bytes struct {
+	Bytes []byte `protobuf:"bytes,2,opt,name=bytes,proto3,oneof"`
+}
+
+type Metadatum_Text struct {
+	Text string `protobuf:"bytes,3,opt,name=text,proto3,oneof"`
+}
+
+type Metadatum_Array struct {
+	Array *MetadatumArray `protobuf:"bytes,4,opt,name=array,proto3,oneof"`
+}
+
+type Metadatum_Map struct {
+	Map *MetadatumMap `protobuf:"bytes,5,opt,name=map,proto3,oneof"`
+}
+
+type Metadatum_BigInt struct {
+	BigInt *BigInt `protobuf:"bytes,6,opt,name=big_int,json=bigInt,proto3,oneof"` // Integer beyond the int64 range.
+}
+
+func (*Metadatum_Int) isMetadatum_Metadatum() {}
+
+func (*Metadatum_Bytes) isMetadatum_Metadatum() {}
+
+func (*Metadatum_Text) isMetadatum_Metadatum() {}
+
+func (*Metadatum_Array) isMetadatum_Metadatum() {}
+
+func (*Metadatum_Map) isMetadatum_Metadatum() {}
+
+func (*Metadatum_BigInt) isMetadatum_Metadatum() {}
+
+type MetadatumArray struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Slot          uint64                 `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`     // Slot number.
-	Hash          []byte                 `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`      // Block hash.
-	Height        uint64                 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"` // Block height.
+	Items         []*Metadatum           `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BlockHeader) Reset() {
-	*x = BlockHeader{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[24]
+func (x *MetadatumArray) Reset() {
+	*x = MetadatumArray{}
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BlockHeader) String() string {
+func (x *MetadatumArray) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlockHeader) ProtoMessage() {}
+func (*MetadatumArray) ProtoMessage() {}
 
-func (x *BlockHeader) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[24]
+func (x *MetadatumArray) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1787,55 +5734,40 @@ func (x *BlockHeader) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlockHeader.ProtoReflect.Descriptor instead.
-func (*BlockHeader) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{24}
-}
-
-func (x *BlockHeader) GetSlot() uint64 {
-	if x != nil {
-		return x.Slot
-	}
-	return 0
+// Deprecated: Use MetadatumArray.ProtoReflect.Descriptor instead.
+func (*MetadatumArray) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *BlockHeader) GetHash() []byte {
+func (x *MetadatumArray) GetItems() []*Metadatum {
 	if x != nil {
-		return x.Hash
+		return x.Items
 	}
 	return nil
 }
 
-func (x *BlockHeader) GetHeight() uint64 {
-	if x != nil {
-		return x.Height
-	}
-	return 0
-}
-
-// Contains the transaction data for a block.
-type BlockBody struct {
+type MetadatumMap struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tx            []*Tx                  `protobuf:"bytes,1,rep,name=tx,proto3" json:"tx,omitempty"` // List of transactions.
+	Pairs         []*MetadatumPair       `protobuf:"bytes,1,rep,name=pairs,proto3" json:"pairs,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BlockBody) Reset() {
-	*x = BlockBody{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[25]
+func (x *MetadatumMap) Reset() {
+	*x = MetadatumMap{}
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BlockBody) String() string {
+func (x *MetadatumMap) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlockBody) ProtoMessage() {}
+func (*MetadatumMap) ProtoMessage() {}
 
-func (x *BlockBody) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[25]
+func (x *MetadatumMap) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1846,43 +5778,41 @@ func (x *BlockBody) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlockBody.ProtoReflect.Descriptor instead.
-func (*BlockBody) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use MetadatumMap.ProtoReflect.Descriptor instead.
+func (*MetadatumMap) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *BlockBody) GetTx() []*Tx {
+func (x *MetadatumMap) GetPairs() []*MetadatumPair {
 	if x != nil {
-		return x.Tx
+		return x.Pairs
 	}
 	return nil
 }
 
-// Represents a complete block, including header and body.
-type Block struct {
+type MetadatumPair struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Header        *BlockHeader           `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`        // Block header.
-	Body          *BlockBody             `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`            // Block body.
-	Timestamp     uint64                 `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // Block ms timestamp
+	Key           *Metadatum             `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         *Metadatum             `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Block) Reset() {
-	*x = Block{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[26]
+func (x *MetadatumPair) Reset() {
+	*x = MetadatumPair{}
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Block) String() string {
+func (x *MetadatumPair) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Block) ProtoMessage() {}
+func (*MetadatumPair) ProtoMessage() {}
 
-func (x *Block) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[26]
+func (x *MetadatumPair) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1893,56 +5823,48 @@ func (x *Block) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Block.ProtoReflect.Descriptor instead.
-func (*Block) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use MetadatumPair.ProtoReflect.Descriptor instead.
+func (*MetadatumPair) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *Block) GetHeader() *BlockHeader {
+func (x *MetadatumPair) GetKey() *Metadatum {
 	if x != nil {
-		return x.Header
+		return x.Key
 	}
 	return nil
 }
 
-func (x *Block) GetBody() *BlockBody {
+func (x *MetadatumPair) GetValue() *Metadatum {
 	if x != nil {
-		return x.Body
+		return x.Value
 	}
 	return nil
 }
 
-func (x *Block) GetTimestamp() uint64 {
-	if x != nil {
-		return x.Timestamp
-	}
-	return 0
-}
-
-// Represents a VKey witness used to sign a transaction.
-type VKeyWitness struct {
+type Metadata struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Vkey          []byte                 `protobuf:"bytes,1,opt,name=vkey,proto3" json:"vkey,omitempty"`           // Verification key.
-	Signature     []byte                 `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"` // Signature generated using the associated private key.
+	Label         uint64                 `protobuf:"varint,1,opt,name=label,proto3" json:"label,omitempty"`
+	Value         *Metadatum             `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VKeyWitness) Reset() {
-	*x = VKeyWitness{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[27]
+func (x *Metadata) Reset() {
+	*x = Metadata{}
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VKeyWitness) String() string {
+func (x *Metadata) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VKeyWitness) ProtoMessage() {}
+func (*Metadata) ProtoMessage() {}
 
-func (x *VKeyWitness) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[27]
+func (x *Metadata) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1953,56 +5875,55 @@ func (x *VKeyWitness) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VKeyWitness.ProtoReflect.Descriptor instead.
-func (*VKeyWitness) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use Metadata.ProtoReflect.Descriptor instead.
+func (*Metadata) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *VKeyWitness) GetVkey() []byte {
+func (x *Metadata) GetLabel() uint64 {
 	if x != nil {
-		return x.Vkey
+		return x.Label
 	}
-	return nil
+	return 0
 }
 
-func (x *VKeyWitness) GetSignature() []byte {
+func (x *Metadata) GetValue() *Metadatum {
 	if x != nil {
-		return x.Signature
+		return x.Value
 	}
 	return nil
 }
 
-// Represents a native script in Cardano.
-type NativeScript struct {
+// Transaction metadata decoded according to the CIP of its label.
+type DecodedMetadata struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to NativeScript:
+	Label uint64                 `protobuf:"varint,1,opt,name=label,proto3" json:"label,omitempty"` // Label of the metadata.
+	// Types that are valid to be assigned to Record:
 	//
-	//	*NativeScript_ScriptPubkey
-	//	*NativeScript_ScriptAll
-	//	*NativeScript_ScriptAny
-	//	*NativeScript_ScriptNOfK
-	//	*NativeScript_InvalidBefore
-	//	*NativeScript_InvalidHereafter
-	NativeScript  isNativeScript_NativeScript `protobuf_oneof:"native_script"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	//	*DecodedMetadata_Cip25
+	//	*DecodedMetadata_Cip20
+	//	*DecodedMetadata_Cip36
+	Record           isDecodedMetadata_Record `protobuf_oneof:"record"`
+	ValidationErrors []string                 `protobuf:"bytes,5,rep,name=validation_errors,json=validationErrors,proto3" json:"validation_errors,omitempty"` // Violations of the CIP; the record holds what could be decoded.
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *NativeScript) Reset() {
-	*x = NativeScript{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[28]
+func (x *DecodedMetadata) Reset() {
+	*x = DecodedMetadata{}
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NativeScript) String() string {
+func (x *DecodedMetadata) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NativeScript) ProtoMessage() {}
+func (*DecodedMetadata) ProtoMessage() {}
 
-func (x *NativeScript) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[28]
+func (x *DecodedMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2013,135 +5934,105 @@ func (x *NativeScript) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NativeScript.ProtoReflect.Descriptor instead.
-func (*NativeScript) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use DecodedMetadata.ProtoReflect.Descriptor instead.
+func (*DecodedMetadata) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *NativeScript) GetNativeScript() isNativeScript_NativeScript {
+func (x *DecodedMetadata) GetLabel() uint64 {
 	if x != nil {
-		return x.NativeScript
+		return x.Label
 	}
-	return nil
+	return 0
 }
 
-func (x *NativeScript) GetScriptPubkey() []byte {
+func (x *DecodedMetadata) GetRecord() isDecodedMetadata_Record {
 	if x != nil {
-		if x, ok := x.NativeScript.(*NativeScript_ScriptPubkey); ok {
-			return x.ScriptPubkey
-		}
+		return x.Record
 	}
 	return nil
 }
 
-func (x *NativeScript) GetScriptAll() *NativeScriptList {
+func (x *DecodedMetadata) GetCip25() *Cip25Metadata {
 	if x != nil {
-		if x, ok := x.NativeScript.(*NativeScript_ScriptAll); ok {
-			return x.ScriptAll
+		if x, ok := x.Record.(*DecodedMetadata_Cip25); ok {
+			return x.Cip25
 		}
 	}
 	return nil
 }
 
-func (x *NativeScript) GetScriptAny() *NativeScriptList {
+func (x *DecodedMetadata) GetCip20() *Cip20Message {
 	if x != nil {
-		if x, ok := x.NativeScript.(*NativeScript_ScriptAny); ok {
-			return x.ScriptAny
+		if x, ok := x.Record.(*DecodedMetadata_Cip20); ok {
+			return x.Cip20
 		}
 	}
 	return nil
 }
 
-func (x *NativeScript) GetScriptNOfK() *ScriptNOfK {
+func (x *DecodedMetadata) GetCip36() *Cip36Registration {
 	if x != nil {
-		if x, ok := x.NativeScript.(*NativeScript_ScriptNOfK); ok {
-			return x.ScriptNOfK
+		if x, ok := x.Record.(*DecodedMetadata_Cip36); ok {
+			return x.Cip36
 		}
 	}
 	return nil
 }
 
-func (x *NativeScript) GetInvalidBefore() uint64 {
-	if x != nil {
-		if x, ok := x.NativeScript.(*NativeScript_InvalidBefore); ok {
-			return x.InvalidBefore
-		}
-	}
-	return 0
-}
-
-func (x *NativeScript) GetInvalidHereafter() uint64 {
+func (x *DecodedMetadata) GetValidationErrors() []string {
 	if x != nil {
-		if x, ok := x.NativeScript.(*NativeScript_InvalidHereafter); ok {
-			return x.InvalidHereafter
-		}
+		return x.ValidationErrors
 	}
-	return 0
-}
-
-type isNativeScript_NativeScript interface {
-	isNativeScript_NativeScript()
-}
-
-type NativeScript_ScriptPubkey struct {
-	ScriptPubkey []byte `protobuf:"bytes,1,opt,name=script_pubkey,json=scriptPubkey,proto3,oneof"` // Script based on an address key hash.
-}
-
-type NativeScript_ScriptAll struct {
-	ScriptAll *NativeScriptList `protobuf:"bytes,2,opt,name=script_all,json=scriptAll,proto3,oneof"` // Script that requires all nested scripts to be satisfied.
+	return nil
 }
 
-type NativeScript_ScriptAny struct {
-	ScriptAny *NativeScriptList `protobuf:"bytes,3,opt,name=script_any,json=scriptAny,proto3,oneof"` // Script that requires any of the nested scripts to be satisfied.
+type isDecodedMetadata_Record interface {
+	isDecodedMetadata_Record()
 }
 
-type NativeScript_ScriptNOfK struct {
-	ScriptNOfK *ScriptNOfK `protobuf:"bytes,4,opt,name=script_n_of_k,json=scriptNOfK,proto3,oneof"` // Script that requires k out of n nested scripts to be satisfied.
+type DecodedMetadata_Cip25 struct {
+	Cip25 *Cip25Metadata `protobuf:"bytes,2,opt,name=cip25,proto3,oneof"` // NFT metadata, label 721.
 }
 
-type NativeScript_InvalidBefore struct {
-	InvalidBefore uint64 `protobuf:"varint,5,opt,name=invalid_before,json=invalidBefore,proto3,oneof"` // Slot number before which the script is invalid.
+type DecodedMetadata_Cip20 struct {
+	Cip20 *Cip20Message `protobuf:"bytes,3,opt,name=cip20,proto3,oneof"` // Transaction message, label 674.
 }
 
-type NativeScript_InvalidHereafter struct {
-	InvalidHereafter uint64 `protobuf:"varint,6,opt,name=invalid_hereafter,json=invalidHereafter,proto3,oneof"` // Slot number after which the script is invalid.
+type DecodedMetadata_Cip36 struct {
+	Cip36 *Cip36Registration `protobuf:"bytes,4,opt,name=cip36,proto3,oneof"` // Vote registration, labels 61284/61285.
 }
 
-func (*NativeScript_ScriptPubkey) isNativeScript_NativeScript() {}
-
-func (*NativeScript_ScriptAll) isNativeScript_NativeScript() {}
-
-func (*NativeScript_ScriptAny) isNativeScript_NativeScript() {}
-
-func (*NativeScript_ScriptNOfK) isNativeScript_NativeScript() {}
+func (*DecodedMetadata_Cip25) isDecodedMetadata_Record() {}
 
-func (*NativeScript_InvalidBefore) isNativeScript_NativeScript() {}
+func (*DecodedMetadata_Cip20) isDecodedMetadata_Record() {}
 
-func (*NativeScript_InvalidHereafter) isNativeScript_NativeScript() {}
+func (*DecodedMetadata_Cip36) isDecodedMetadata_Record() {}
 
-// Represents a list of native scripts.
-type NativeScriptList struct {
+// CIP-25 NFT metadata.
+type Cip25Metadata struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*NativeScript        `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"` // List of native scripts.
+	Version       uint32                 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"` // Metadata version, 1 or 2.
+	Assets        []*Cip25Asset          `protobuf:"bytes,2,rep,name=assets,proto3" json:"assets,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NativeScriptList) Reset() {
-	*x = NativeScriptList{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[29]
+func (x *Cip25Metadata) Reset() {
+	*x = Cip25Metadata{}
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NativeScriptList) String() string {
+func (x *Cip25Metadata) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NativeScriptList) ProtoMessage() {}
+func (*Cip25Metadata) ProtoMessage() {}
 
-func (x *NativeScriptList) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[29]
+func (x *Cip25Metadata) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2152,42 +6043,54 @@ func (x *NativeScriptList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NativeScriptList.ProtoReflect.Descriptor instead.
-func (*NativeScriptList) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use Cip25Metadata.ProtoReflect.Descriptor instead.
+func (*Cip25Metadata) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *NativeScriptList) GetItems() []*NativeScript {
+func (x *Cip25Metadata) GetVersion() uint32 {
 	if x != nil {
-		return x.Items
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Cip25Metadata) GetAssets() []*Cip25Asset {
+	if x != nil {
+		return x.Assets
 	}
 	return nil
 }
 
-// Represents a "k out of n" native script.
-type ScriptNOfK struct {
+type Cip25Asset struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	K             uint32                 `protobuf:"varint,1,opt,name=k,proto3" json:"k,omitempty"`            // The number of required satisfied scripts.
-	Scripts       []*NativeScript        `protobuf:"bytes,2,rep,name=scripts,proto3" json:"scripts,omitempty"` // List of native scripts.
+	PolicyId      []byte                 `protobuf:"bytes,1,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+	AssetName     []byte                 `protobuf:"bytes,2,opt,name=asset_name,json=assetName,proto3" json:"asset_name,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Image         string                 `protobuf:"bytes,4,opt,name=image,proto3" json:"image,omitempty"`                          // Image URI, chunks joined.
+	MediaType     string                 `protobuf:"bytes,5,opt,name=media_type,json=mediaType,proto3" json:"media_type,omitempty"` // Media type of the image.
+	Description   string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`              // Description, chunks joined.
+	Files         []*Cip25File           `protobuf:"bytes,7,rep,name=files,proto3" json:"files,omitempty"`
+	Properties    []*MetadatumPair       `protobuf:"bytes,8,rep,name=properties,proto3" json:"properties,omitempty"` // Other properties of the asset.
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ScriptNOfK) Reset() {
-	*x = ScriptNOfK{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[30]
+func (x *Cip25Asset) Reset() {
+	*x = Cip25Asset{}
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ScriptNOfK) String() string {
+func (x *Cip25Asset) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ScriptNOfK) ProtoMessage() {}
+func (*Cip25Asset) ProtoMessage() {}
 
-func (x *ScriptNOfK) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[30]
+func (x *Cip25Asset) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2198,114 +6101,92 @@ func (x *ScriptNOfK) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ScriptNOfK.ProtoReflect.Descriptor instead.
-func (*ScriptNOfK) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use Cip25Asset.ProtoReflect.Descriptor instead.
+func (*Cip25Asset) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *ScriptNOfK) GetK() uint32 {
+func (x *Cip25Asset) GetPolicyId() []byte {
 	if x != nil {
-		return x.K
+		return x.PolicyId
 	}
-	return 0
+	return nil
 }
 
-func (x *ScriptNOfK) GetScripts() []*NativeScript {
+func (x *Cip25Asset) GetAssetName() []byte {
 	if x != nil {
-		return x.Scripts
+		return x.AssetName
 	}
 	return nil
 }
 
-// Represents a constructor for Plutus data in Cardano.
-type Constr struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Tag            uint32                 `protobuf:"varint,1,opt,name=tag,proto3" json:"tag,omitempty"`
-	AnyConstructor uint64                 `protobuf:"varint,2,opt,name=any_constructor,json=anyConstructor,proto3" json:"any_constructor,omitempty"`
-	Fields         []*PlutusData          `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
-}
-
-func (x *Constr) Reset() {
-	*x = Constr{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[31]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *Constr) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Cip25Asset) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (*Constr) ProtoMessage() {}
-
-func (x *Constr) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[31]
+func (x *Cip25Asset) GetImage() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Image
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use Constr.ProtoReflect.Descriptor instead.
-func (*Constr) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{31}
+func (x *Cip25Asset) GetMediaType() string {
+	if x != nil {
+		return x.MediaType
+	}
+	return ""
 }
 
-func (x *Constr) GetTag() uint32 {
+func (x *Cip25Asset) GetDescription() string {
 	if x != nil {
-		return x.Tag
+		return x.Description
 	}
-	return 0
+	return ""
 }
 
-func (x *Constr) GetAnyConstructor() uint64 {
+func (x *Cip25Asset) GetFiles() []*Cip25File {
 	if x != nil {
-		return x.AnyConstructor
+		return x.Files
 	}
-	return 0
+	return nil
 }
 
-func (x *Constr) GetFields() []*PlutusData {
+func (x *Cip25Asset) GetProperties() []*MetadatumPair {
 	if x != nil {
-		return x.Fields
+		return x.Properties
 	}
 	return nil
 }
 
-// Represents a big integer for Plutus data in Cardano.
-type BigInt struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to BigInt:
-	//
-	//	*BigInt_Int
-	//	*BigInt_BigUInt
-	//	*BigInt_BigNInt
-	BigInt        isBigInt_BigInt `protobuf_oneof:"big_int"`
+type Cip25File struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	MediaType     string                 `protobuf:"bytes,2,opt,name=media_type,json=mediaType,proto3" json:"media_type,omitempty"`
+	Src           string                 `protobuf:"bytes,3,opt,name=src,proto3" json:"src,omitempty"`               // File URI, chunks joined.
+	Properties    []*MetadatumPair       `protobuf:"bytes,4,rep,name=properties,proto3" json:"properties,omitempty"` // Other properties of the file.
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BigInt) Reset() {
-	*x = BigInt{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[32]
+func (x *Cip25File) Reset() {
+	*x = Cip25File{}
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BigInt) String() string {
+func (x *Cip25File) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BigInt) ProtoMessage() {}
+func (*Cip25File) ProtoMessage() {}
 
-func (x *BigInt) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[32]
+func (x *Cip25File) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2316,91 +6197,62 @@ func (x *BigInt) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BigInt.ProtoReflect.Descriptor instead.
-func (*BigInt) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use Cip25File.ProtoReflect.Descriptor instead.
+func (*Cip25File) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *BigInt) GetBigInt() isBigInt_BigInt {
+func (x *Cip25File) GetName() string {
 	if x != nil {
-		return x.BigInt
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *BigInt) GetInt() int64 {
+func (x *Cip25File) GetMediaType() string {
 	if x != nil {
-		if x, ok := x.BigInt.(*BigInt_Int); ok {
-			return x.Int
-		}
+		return x.MediaType
 	}
-	return 0
+	return ""
 }
 
-func (x *BigInt) GetBigUInt() []byte {
+func (x *Cip25File) GetSrc() string {
 	if x != nil {
-		if x, ok := x.BigInt.(*BigInt_BigUInt); ok {
-			return x.BigUInt
-		}
+		return x.Src
 	}
-	return nil
+	return ""
 }
 
-func (x *BigInt) GetBigNInt() []byte {
+func (x *Cip25File) GetProperties() []*MetadatumPair {
 	if x != nil {
-		if x, ok := x.BigInt.(*BigInt_BigNInt); ok {
-			return x.BigNInt
-		}
+		return x.Properties
 	}
 	return nil
 }
 
-type isBigInt_BigInt interface {
-	isBigInt_BigInt()
-}
-
-type BigInt_Int struct {
-	Int int64 `protobuf:"varint,1,opt,name=int,proto3,oneof"`
-}
-
-type BigInt_BigUInt struct {
-	BigUInt []byte `protobuf:"bytes,2,opt,name=big_u_int,json=bigUInt,proto3,oneof"`
-}
-
-type BigInt_BigNInt struct {
-	BigNInt []byte `protobuf:"bytes,3,opt,name=big_n_int,json=bigNInt,proto3,oneof"`
-}
-
-func (*BigInt_Int) isBigInt_BigInt() {}
-
-func (*BigInt_BigUInt) isBigInt_BigInt() {}
-
-func (*BigInt_BigNInt) isBigInt_BigInt() {}
-
-// Represents a key-value pair for Plutus data in Cardano.
-type PlutusDataPair struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Key           *PlutusData            `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`     // Key of the pair.
-	Value         *PlutusData            `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"` // Value of the pair.
+// CIP-20 transaction message.
+type Cip20Message struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lines         []string               `protobuf:"bytes,1,rep,name=lines,proto3" json:"lines,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PlutusDataPair) Reset() {
-	*x = PlutusDataPair{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[33]
+func (x *Cip20Message) Reset() {
+	*x = Cip20Message{}
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PlutusDataPair) String() string {
+func (x *Cip20Message) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlutusDataPair) ProtoMessage() {}
+func (*Cip20Message) ProtoMessage() {}
 
-func (x *PlutusDataPair) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[33]
+func (x *Cip20Message) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2411,55 +6263,47 @@ func (x *PlutusDataPair) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlutusDataPair.ProtoReflect.Descriptor instead.
-func (*PlutusDataPair) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{33}
-}
-
-func (x *PlutusDataPair) GetKey() *PlutusData {
-	if x != nil {
-		return x.Key
-	}
-	return nil
+// Deprecated: Use Cip20Message.ProtoReflect.Descriptor instead.
+func (*Cip20Message) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *PlutusDataPair) GetValue() *PlutusData {
+func (x *Cip20Message) GetLines() []string {
 	if x != nil {
-		return x.Value
+		return x.Lines
 	}
 	return nil
 }
 
-// Represents a Plutus data item in Cardano.
-type PlutusData struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to PlutusData:
-	//
-	//	*PlutusData_Constr
-	//	*PlutusData_Map
-	//	*PlutusData_BigInt
-	//	*PlutusData_BoundedBytes
-	//	*PlutusData_Array
-	PlutusData    isPlutusData_PlutusData `protobuf_oneof:"plutus_data"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// CIP-36 (and CIP-15) vote registration.
+type Cip36Registration struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Delegations     []*Cip36Delegation     `protobuf:"bytes,1,rep,name=delegations,proto3" json:"delegations,omitempty"`                                   // Voting keys and weights; a single weight 1 key for CIP-15.
+	LegacyVotingKey bool                   `protobuf:"varint,2,opt,name=legacy_voting_key,json=legacyVotingKey,proto3" json:"legacy_voting_key,omitempty"` // The voting key uses the CIP-15 format.
+	StakeKey        []byte                 `protobuf:"bytes,3,opt,name=stake_key,json=stakeKey,proto3" json:"stake_key,omitempty"`                         // Stake public key.
+	PaymentAddress  []byte                 `protobuf:"bytes,4,opt,name=payment_address,json=paymentAddress,proto3" json:"payment_address,omitempty"`       // Address receiving the voting rewards.
+	Nonce           uint64                 `protobuf:"varint,5,opt,name=nonce,proto3" json:"nonce,omitempty"`                                              // Ordering nonce, usually a slot number.
+	VotingPurpose   uint64                 `protobuf:"varint,6,opt,name=voting_purpose,json=votingPurpose,proto3" json:"voting_purpose,omitempty"`         // Voting purpose, 0 for Catalyst.
+	Signature       []byte                 `protobuf:"bytes,7,opt,name=signature,proto3" json:"signature,omitempty"`                                       // Signature of the registration (61285).
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *PlutusData) Reset() {
-	*x = PlutusData{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[34]
+func (x *Cip36Registration) Reset() {
+	*x = Cip36Registration{}
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PlutusData) String() string {
+func (x *Cip36Registration) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlutusData) ProtoMessage() {}
+func (*Cip36Registration) ProtoMessage() {}
 
-func (x *PlutusData) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[34]
+func (x *Cip36Registration) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2470,120 +6314,83 @@ func (x *PlutusData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlutusData.ProtoReflect.Descriptor instead.
-func (*PlutusData) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use Cip36Registration.ProtoReflect.Descriptor instead.
+func (*Cip36Registration) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *PlutusData) GetPlutusData() isPlutusData_PlutusData {
+func (x *Cip36Registration) GetDelegations() []*Cip36Delegation {
 	if x != nil {
-		return x.PlutusData
+		return x.Delegations
 	}
 	return nil
 }
 
-func (x *PlutusData) GetConstr() *Constr {
+func (x *Cip36Registration) GetLegacyVotingKey() bool {
 	if x != nil {
-		if x, ok := x.PlutusData.(*PlutusData_Constr); ok {
-			return x.Constr
-		}
+		return x.LegacyVotingKey
 	}
-	return nil
+	return false
 }
 
-func (x *PlutusData) GetMap() *PlutusDataMap {
+func (x *Cip36Registration) GetStakeKey() []byte {
 	if x != nil {
-		if x, ok := x.PlutusData.(*PlutusData_Map); ok {
-			return x.Map
-		}
+		return x.StakeKey
 	}
 	return nil
 }
 
-func (x *PlutusData) GetBigInt() *BigInt {
+func (x *Cip36Registration) GetPaymentAddress() []byte {
 	if x != nil {
-		if x, ok := x.PlutusData.(*PlutusData_BigInt); ok {
-			return x.BigInt
-		}
+		return x.PaymentAddress
 	}
 	return nil
 }
 
-func (x *PlutusData) GetBoundedBytes() []byte {
+func (x *Cip36Registration) GetNonce() uint64 {
 	if x != nil {
-		if x, ok := x.PlutusData.(*PlutusData_BoundedBytes); ok {
-			return x.BoundedBytes
-		}
+		return x.Nonce
 	}
-	return nil
+	return 0
 }
 
-func (x *PlutusData) GetArray() *PlutusDataArray {
+func (x *Cip36Registration) GetVotingPurpose() uint64 {
 	if x != nil {
-		if x, ok := x.PlutusData.(*PlutusData_Array); ok {
-			return x.Array
-		}
+		return x.VotingPurpose
 	}
-	return nil
-}
-
-type isPlutusData_PlutusData interface {
-	isPlutusData_PlutusData()
-}
-
-type PlutusData_Constr struct {
-	Constr *Constr `protobuf:"bytes,2,opt,name=constr,proto3,oneof"` // Constructor.
-}
-
-type PlutusData_Map struct {
-	Map *PlutusDataMap `protobuf:"bytes,3,opt,name=map,proto3,oneof"` // Map of Plutus data.
-}
-
-type PlutusData_BigInt struct {
-	BigInt *BigInt `protobuf:"bytes,4,opt,name=big_int,json=bigInt,proto3,oneof"` // Big integer.
-}
-
-type PlutusData_BoundedBytes struct {
-	BoundedBytes []byte `protobuf:"bytes,5,opt,name=bounded_bytes,json=boundedBytes,proto3,oneof"` // Bounded bytes.
+	return 0
 }
 
-type PlutusData_Array struct {
-	Array *PlutusDataArray `protobuf:"bytes,6,opt,name=array,proto3,oneof"` // Array of Plutus data.
+func (x *Cip36Registration) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
 }
 
-func (*PlutusData_Constr) isPlutusData_PlutusData() {}
-
-func (*PlutusData_Map) isPlutusData_PlutusData() {}
-
-func (*PlutusData_BigInt) isPlutusData_PlutusData() {}
-
-func (*PlutusData_BoundedBytes) isPlutusData_PlutusData() {}
-
-func (*PlutusData_Array) isPlutusData_PlutusData() {}
-
-// Represents a map of Plutus data in Cardano.
-type PlutusDataMap struct {
+type Cip36Delegation struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Pairs         []*PlutusDataPair      `protobuf:"bytes,1,rep,name=pairs,proto3" json:"pairs,omitempty"` // List of key-value pairs.
+	VotingKey     []byte                 `protobuf:"bytes,1,opt,name=voting_key,json=votingKey,proto3" json:"voting_key,omitempty"`
+	Weight        uint32                 `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PlutusDataMap) Reset() {
-	*x = PlutusDataMap{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[35]
+func (x *Cip36Delegation) Reset() {
+	*x = Cip36Delegation{}
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PlutusDataMap) String() string {
+func (x *Cip36Delegation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlutusDataMap) ProtoMessage() {}
+func (*Cip36Delegation) ProtoMessage() {}
 
-func (x *PlutusDataMap) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[35]
+func (x *Cip36Delegation) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2594,92 +6401,53 @@ func (x *PlutusDataMap) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlutusDataMap.ProtoReflect.Descriptor instead.
-func (*PlutusDataMap) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use Cip36Delegation.ProtoReflect.Descriptor instead.
+func (*Cip36Delegation) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *PlutusDataMap) GetPairs() []*PlutusDataPair {
+func (x *Cip36Delegation) GetVotingKey() []byte {
 	if x != nil {
-		return x.Pairs
+		return x.VotingKey
 	}
 	return nil
 }
 
-// Represents an array of Plutus data in Cardano.
-type PlutusDataArray struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*PlutusData          `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"` // List of Plutus data items.
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *PlutusDataArray) Reset() {
-	*x = PlutusDataArray{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[36]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *PlutusDataArray) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*PlutusDataArray) ProtoMessage() {}
-
-func (x *PlutusDataArray) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[36]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use PlutusDataArray.ProtoReflect.Descriptor instead.
-func (*PlutusDataArray) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{36}
-}
-
-func (x *PlutusDataArray) GetItems() []*PlutusData {
+func (x *Cip36Delegation) GetWeight() uint32 {
 	if x != nil {
-		return x.Items
+		return x.Weight
 	}
-	return nil
+	return 0
 }
 
-// Represents a script in Cardano.
-type Script struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Script:
-	//
-	//	*Script_Native
-	//	*Script_PlutusV1
-	//	*Script_PlutusV2
-	//	*Script_PlutusV3
-	Script        isScript_Script `protobuf_oneof:"script"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// A CIP-68 token, spotted by the CIP-67 label prefixing its asset name.
+type Cip68Token struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	PolicyId           []byte                 `protobuf:"bytes,1,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+	AssetName          []byte                 `protobuf:"bytes,2,opt,name=asset_name,json=assetName,proto3" json:"asset_name,omitempty"`                              // Asset name, label prefix included.
+	Label              uint32                 `protobuf:"varint,3,opt,name=label,proto3" json:"label,omitempty"`                                                      // 100 (reference NFT), 222 (NFT), 333 (FT) or 444 (RFT).
+	ReferenceAssetName []byte                 `protobuf:"bytes,4,opt,name=reference_asset_name,json=referenceAssetName,proto3" json:"reference_asset_name,omitempty"` // Name of the reference NFT holding the metadata.
+	MintCoin           int64                  `protobuf:"varint,5,opt,name=mint_coin,json=mintCoin,proto3" json:"mint_coin,omitempty"`                                // Quantity minted, negative when burnt.
+	OutputIndexes      []uint32               `protobuf:"varint,6,rep,packed,name=output_indexes,json=outputIndexes,proto3" json:"output_indexes,omitempty"`          // Outputs holding the token.
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *Script) Reset() {
-	*x = Script{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[37]
+func (x *Cip68Token) Reset() {
+	*x = Cip68Token{}
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Script) String() string {
+func (x *Cip68Token) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Script) ProtoMessage() {}
+func (*Cip68Token) ProtoMessage() {}
 
-func (x *Script) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[37]
+func (x *Cip68Token) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2690,111 +6458,84 @@ func (x *Script) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Script.ProtoReflect.Descriptor instead.
-func (*Script) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use Cip68Token.ProtoReflect.Descriptor instead.
+func (*Cip68Token) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *Script) GetScript() isScript_Script {
+func (x *Cip68Token) GetPolicyId() []byte {
 	if x != nil {
-		return x.Script
+		return x.PolicyId
 	}
 	return nil
 }
 
-func (x *Script) GetNative() *NativeScript {
+func (x *Cip68Token) GetAssetName() []byte {
 	if x != nil {
-		if x, ok := x.Script.(*Script_Native); ok {
-			return x.Native
-		}
+		return x.AssetName
 	}
 	return nil
 }
 
-func (x *Script) GetPlutusV1() []byte {
+func (x *Cip68Token) GetLabel() uint32 {
 	if x != nil {
-		if x, ok := x.Script.(*Script_PlutusV1); ok {
-			return x.PlutusV1
-		}
+		return x.Label
 	}
-	return nil
+	return 0
 }
 
-func (x *Script) GetPlutusV2() []byte {
+func (x *Cip68Token) GetReferenceAssetName() []byte {
 	if x != nil {
-		if x, ok := x.Script.(*Script_PlutusV2); ok {
-			return x.PlutusV2
-		}
+		return x.ReferenceAssetName
 	}
 	return nil
 }
 
-func (x *Script) GetPlutusV3() []byte {
+func (x *Cip68Token) GetMintCoin() int64 {
 	if x != nil {
-		if x, ok := x.Script.(*Script_PlutusV3); ok {
-			return x.PlutusV3
-		}
+		return x.MintCoin
 	}
-	return nil
-}
-
-type isScript_Script interface {
-	isScript_Script()
-}
-
-type Script_Native struct {
-	Native *NativeScript `protobuf:"bytes,1,opt,name=native,proto3,oneof"` // Native script.
-}
-
-type Script_PlutusV1 struct {
-	PlutusV1 []byte `protobuf:"bytes,2,opt,name=plutus_v1,json=plutusV1,proto3,oneof"` // Plutus V1 script.
-}
-
-type Script_PlutusV2 struct {
-	PlutusV2 []byte `protobuf:"bytes,3,opt,name=plutus_v2,json=plutusV2,proto3,oneof"` // Plutus V2 script.
-}
-
-type Script_PlutusV3 struct {
-	PlutusV3 []byte `protobuf:"bytes,4,opt,name=plutus_v3,json=plutusV3,proto3,oneof"` // Plutus V3 script.
+	return 0
 }
 
-func (*Script_Native) isScript_Script() {}
-
-func (*Script_PlutusV1) isScript_Script() {}
-
-func (*Script_PlutusV2) isScript_Script() {}
-
-func (*Script_PlutusV3) isScript_Script() {}
+func (x *Cip68Token) GetOutputIndexes() []uint32 {
+	if x != nil {
+		return x.OutputIndexes
+	}
+	return nil
+}
 
-type Metadatum struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Metadatum:
-	//
-	//	*Metadatum_Int
-	//	*Metadatum_Bytes
-	//	*Metadatum_Text
-	//	*Metadatum_Array
-	//	*Metadatum_Map
-	Metadatum     isMetadatum_Metadatum `protobuf_oneof:"metadatum"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// The reference datum of a CIP-68 token, set by the output a reference NFT
+// moves to.
+type Cip68MetadataUpdate struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	PolicyId         []byte                 `protobuf:"bytes,1,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+	AssetName        []byte                 `protobuf:"bytes,2,opt,name=asset_name,json=assetName,proto3" json:"asset_name,omitempty"`        // Name of the reference NFT.
+	OutputIndex      uint32                 `protobuf:"varint,3,opt,name=output_index,json=outputIndex,proto3" json:"output_index,omitempty"` // Output holding the reference NFT.
+	TokenLabel       uint32                 `protobuf:"varint,4,opt,name=token_label,json=tokenLabel,proto3" json:"token_label,omitempty"`    // Label of the tokens minted along, used for validation; 0 if none.
+	Minted           bool                   `protobuf:"varint,5,opt,name=minted,proto3" json:"minted,omitempty"`                              // The reference NFT is minted by the transaction.
+	DatumHash        []byte                 `protobuf:"bytes,6,opt,name=datum_hash,json=datumHash,proto3" json:"datum_hash,omitempty"`
+	Metadata         *Cip68Metadata         `protobuf:"bytes,7,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	ValidationErrors []string               `protobuf:"bytes,8,rep,name=validation_errors,json=validationErrors,proto3" json:"validation_errors,omitempty"` // Violations of the CIP; the metadata holds what could be decoded.
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *Metadatum) Reset() {
-	*x = Metadatum{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[38]
+func (x *Cip68MetadataUpdate) Reset() {
+	*x = Cip68MetadataUpdate{}
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Metadatum) String() string {
+func (x *Cip68MetadataUpdate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Metadatum) ProtoMessage() {}
+func (*Cip68MetadataUpdate) ProtoMessage() {}
 
-func (x *Metadatum) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[38]
+func (x *Cip68MetadataUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2805,119 +6546,101 @@ func (x *Metadatum) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Metadatum.ProtoReflect.Descriptor instead.
-func (*Metadatum) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use Cip68MetadataUpdate.ProtoReflect.Descriptor instead.
+func (*Cip68MetadataUpdate) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *Metadatum) GetMetadatum() isMetadatum_Metadatum {
+func (x *Cip68MetadataUpdate) GetPolicyId() []byte {
 	if x != nil {
-		return x.Metadatum
+		return x.PolicyId
 	}
 	return nil
 }
 
-func (x *Metadatum) GetInt() int64 {
+func (x *Cip68MetadataUpdate) GetAssetName() []byte {
 	if x != nil {
-		if x, ok := x.Metadatum.(*Metadatum_Int); ok {
-			return x.Int
-		}
+		return x.AssetName
 	}
-	return 0
+	return nil
 }
 
-func (x *Metadatum) GetBytes() []byte {
+func (x *Cip68MetadataUpdate) GetOutputIndex() uint32 {
 	if x != nil {
-		if x, ok := x.Metadatum.(*Metadatum_Bytes); ok {
-			return x.Bytes
-		}
+		return x.OutputIndex
 	}
-	return nil
+	return 0
 }
 
-func (x *Metadatum) GetText() string {
+func (x *Cip68MetadataUpdate) GetTokenLabel() uint32 {
 	if x != nil {
-		if x, ok := x.Metadatum.(*Metadatum_Text); ok {
-			return x.Text
-		}
+		return x.TokenLabel
 	}
-	return ""
+	return 0
 }
 
-func (x *Metadatum) GetArray() *MetadatumArray {
+func (x *Cip68MetadataUpdate) GetMinted() bool {
 	if x != nil {
-		if x, ok := x.Metadatum.(*Metadatum_Array); ok {
-			return x.Array
-		}
+		return x.Minted
 	}
-	return nil
+	return false
 }
 
-func (x *Metadatum) GetMap() *MetadatumMap {
+func (x *Cip68MetadataUpdate) GetDatumHash() []byte {
 	if x != nil {
-		if x, ok := x.Metadatum.(*Metadatum_Map); ok {
-			return x.Map
-		}
+		return x.DatumHash
 	}
 	return nil
 }
 
-type isMetadatum_Metadatum interface {
-	isMetadatum_Metadatum()
-}
-
-type Metadatum_Int struct {
-	Int int64 `protobuf:"varint,1,opt,name=int,proto3,oneof"`
-}
-
-type Metadatum_Bytes struct {
-	Bytes []byte `protobuf:"bytes,2,opt,name=bytes,proto3,oneof"`
-}
-
-type Metadatum_Text struct {
-	Text string `protobuf:"bytes,3,opt,name=text,proto3,oneof"`
-}
-
-type Metadatum_Array struct {
-	Array *MetadatumArray `protobuf:"bytes,4,opt,name=array,proto3,oneof"`
+func (x *Cip68MetadataUpdate) GetMetadata() *Cip68Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
 }
 
-type Metadatum_Map struct {
-	Map *MetadatumMap `protobuf:"bytes,5,opt,name=map,proto3,oneof"`
+func (x *Cip68MetadataUpdate) GetValidationErrors() []string {
+	if x != nil {
+		return x.ValidationErrors
+	}
+	return nil
 }
 
-func (*Metadatum_Int) isMetadatum_Metadatum() {}
-
-func (*Metadatum_Bytes) isMetadatum_Metadatum() {}
-
-func (*Metadatum_Text) isMetadatum_Metadatum() {}
-
-func (*Metadatum_Array) isMetadatum_Metadatum() {}
-
-func (*Metadatum_Map) isMetadatum_Metadatum() {}
-
-type MetadatumArray struct {
+// CIP-68 token metadata, decoded from a reference datum.
+type Cip68Metadata struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*Metadatum           `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Version       uint64                 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Image         string                 `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"` // Image URI (222, 444).
+	MediaType     string                 `protobuf:"bytes,4,opt,name=media_type,json=mediaType,proto3" json:"media_type,omitempty"`
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Files         []*Cip68File           `protobuf:"bytes,6,rep,name=files,proto3" json:"files,omitempty"`
+	Ticker        string                 `protobuf:"bytes,7,opt,name=ticker,proto3" json:"ticker,omitempty"`          // Ticker (333).
+	Url           string                 `protobuf:"bytes,8,opt,name=url,proto3" json:"url,omitempty"`                // Project URL (333).
+	Logo          string                 `protobuf:"bytes,9,opt,name=logo,proto3" json:"logo,omitempty"`              // Logo URI (333).
+	Decimals      uint64                 `protobuf:"varint,10,opt,name=decimals,proto3" json:"decimals,omitempty"`    // Decimals (333, 444).
+	Properties    []*PlutusDataPair      `protobuf:"bytes,11,rep,name=properties,proto3" json:"properties,omitempty"` // Other metadata entries.
+	Extra         *PlutusData            `protobuf:"bytes,12,opt,name=extra,proto3" json:"extra,omitempty"`           // Custom data following the version.
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MetadatumArray) Reset() {
-	*x = MetadatumArray{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[39]
+func (x *Cip68Metadata) Reset() {
+	*x = Cip68Metadata{}
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MetadatumArray) String() string {
+func (x *Cip68Metadata) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MetadatumArray) ProtoMessage() {}
+func (*Cip68Metadata) ProtoMessage() {}
 
-func (x *MetadatumArray) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[39]
+func (x *Cip68Metadata) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2928,137 +6651,120 @@ func (x *MetadatumArray) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MetadatumArray.ProtoReflect.Descriptor instead.
-func (*MetadatumArray) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use Cip68Metadata.ProtoReflect.Descriptor instead.
+func (*Cip68Metadata) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *MetadatumArray) GetItems() []*Metadatum {
+func (x *Cip68Metadata) GetVersion() uint64 {
 	if x != nil {
-		return x.Items
+		return x.Version
 	}
-	return nil
-}
-
-type MetadatumMap struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Pairs         []*MetadatumPair       `protobuf:"bytes,1,rep,name=pairs,proto3" json:"pairs,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return 0
 }
 
-func (x *MetadatumMap) Reset() {
-	*x = MetadatumMap{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[40]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Cip68Metadata) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (x *MetadatumMap) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Cip68Metadata) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
 }
 
-func (*MetadatumMap) ProtoMessage() {}
-
-func (x *MetadatumMap) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[40]
+func (x *Cip68Metadata) GetMediaType() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.MediaType
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use MetadatumMap.ProtoReflect.Descriptor instead.
-func (*MetadatumMap) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{40}
+func (x *Cip68Metadata) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
 }
 
-func (x *MetadatumMap) GetPairs() []*MetadatumPair {
+func (x *Cip68Metadata) GetFiles() []*Cip68File {
 	if x != nil {
-		return x.Pairs
+		return x.Files
 	}
 	return nil
 }
 
-type MetadatumPair struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Key           *Metadatum             `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value         *Metadatum             `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *MetadatumPair) Reset() {
-	*x = MetadatumPair{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[41]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Cip68Metadata) GetTicker() string {
+	if x != nil {
+		return x.Ticker
+	}
+	return ""
 }
 
-func (x *MetadatumPair) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Cip68Metadata) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
 }
 
-func (*MetadatumPair) ProtoMessage() {}
-
-func (x *MetadatumPair) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[41]
+func (x *Cip68Metadata) GetLogo() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Logo
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use MetadatumPair.ProtoReflect.Descriptor instead.
-func (*MetadatumPair) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{41}
+func (x *Cip68Metadata) GetDecimals() uint64 {
+	if x != nil {
+		return x.Decimals
+	}
+	return 0
 }
 
-func (x *MetadatumPair) GetKey() *Metadatum {
+func (x *Cip68Metadata) GetProperties() []*PlutusDataPair {
 	if x != nil {
-		return x.Key
+		return x.Properties
 	}
 	return nil
 }
 
-func (x *MetadatumPair) GetValue() *Metadatum {
+func (x *Cip68Metadata) GetExtra() *PlutusData {
 	if x != nil {
-		return x.Value
+		return x.Extra
 	}
 	return nil
 }
 
-type Metadata struct {
+type Cip68File struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Label         uint64                 `protobuf:"varint,1,opt,name=label,proto3" json:"label,omitempty"`
-	Value         *Metadatum             `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	MediaType     string                 `protobuf:"bytes,2,opt,name=media_type,json=mediaType,proto3" json:"media_type,omitempty"`
+	Src           string                 `protobuf:"bytes,3,opt,name=src,proto3" json:"src,omitempty"`
+	Properties    []*PlutusDataPair      `protobuf:"bytes,4,rep,name=properties,proto3" json:"properties,omitempty"` // Other properties of the file.
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Metadata) Reset() {
-	*x = Metadata{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[42]
+func (x *Cip68File) Reset() {
+	*x = Cip68File{}
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Metadata) String() string {
+func (x *Cip68File) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Metadata) ProtoMessage() {}
+func (*Cip68File) ProtoMessage() {}
 
-func (x *Metadata) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[42]
+func (x *Cip68File) ProtoReflect() protoreflect.Message {
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3069,21 +6775,35 @@ func (x *Metadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Metadata.ProtoReflect.Descriptor instead.
-func (*Metadata) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use Cip68File.ProtoReflect.Descriptor instead.
+func (*Cip68File) Descriptor() ([]byte, []int) {
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *Metadata) GetLabel() uint64 {
+func (x *Cip68File) GetName() string {
 	if x != nil {
-		return x.Label
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *Metadata) GetValue() *Metadatum {
+func (x *Cip68File) GetMediaType() string {
 	if x != nil {
-		return x.Value
+		return x.MediaType
+	}
+	return ""
+}
+
+func (x *Cip68File) GetSrc() string {
+	if x != nil {
+		return x.Src
+	}
+	return ""
+}
+
+func (x *Cip68File) GetProperties() []*PlutusDataPair {
+	if x != nil {
+		return x.Properties
 	}
 	return nil
 }
@@ -3102,7 +6822,7 @@ type StakeCredential struct {
 
 func (x *StakeCredential) Reset() {
 	*x = StakeCredential{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[43]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3114,7 +6834,7 @@ func (x *StakeCredential) String() string {
 func (*StakeCredential) ProtoMessage() {}
 
 func (x *StakeCredential) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[43]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3127,7 +6847,7 @@ func (x *StakeCredential) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StakeCredential.ProtoReflect.Descriptor instead.
 func (*StakeCredential) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{43}
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{81}
 }
 
 func (x *StakeCredential) GetStakeCredential() isStakeCredential_StakeCredential {
@@ -3182,7 +6902,7 @@ type RationalNumber struct {
 
 func (x *RationalNumber) Reset() {
 	*x = RationalNumber{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[44]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3194,7 +6914,7 @@ func (x *RationalNumber) String() string {
 func (*RationalNumber) ProtoMessage() {}
 
 func (x *RationalNumber) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[44]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3207,7 +6927,7 @@ func (x *RationalNumber) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RationalNumber.ProtoReflect.Descriptor instead.
 func (*RationalNumber) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{44}
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{82}
 }
 
 func (x *RationalNumber) GetNumerator() int32 {
@@ -3237,7 +6957,7 @@ type Relay struct {
 
 func (x *Relay) Reset() {
 	*x = Relay{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[45]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3249,7 +6969,7 @@ func (x *Relay) String() string {
 func (*Relay) ProtoMessage() {}
 
 func (x *Relay) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[45]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3262,7 +6982,7 @@ func (x *Relay) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Relay.ProtoReflect.Descriptor instead.
 func (*Relay) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{45}
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{83}
 }
 
 func (x *Relay) GetIpV4() []byte {
@@ -3304,7 +7024,7 @@ type PoolMetadata struct {
 
 func (x *PoolMetadata) Reset() {
 	*x = PoolMetadata{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[46]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3316,7 +7036,7 @@ func (x *PoolMetadata) String() string {
 func (*PoolMetadata) ProtoMessage() {}
 
 func (x *PoolMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[46]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3329,7 +7049,7 @@ func (x *PoolMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PoolMetadata.ProtoReflect.Descriptor instead.
 func (*PoolMetadata) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{46}
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{84}
 }
 
 func (x *PoolMetadata) GetUrl() string {
@@ -3378,7 +7098,7 @@ type Certificate struct {
 
 func (x *Certificate) Reset() {
 	*x = Certificate{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[47]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3390,7 +7110,7 @@ func (x *Certificate) String() string {
 func (*Certificate) ProtoMessage() {}
 
 func (x *Certificate) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[47]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3403,7 +7123,7 @@ func (x *Certificate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Certificate.ProtoReflect.Descriptor instead.
 func (*Certificate) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{47}
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{85}
 }
 
 func (x *Certificate) GetCertificate() isCertificate_Certificate {
@@ -3720,7 +7440,7 @@ type StakeDelegationCert struct {
 
 func (x *StakeDelegationCert) Reset() {
 	*x = StakeDelegationCert{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[48]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3732,7 +7452,7 @@ func (x *StakeDelegationCert) String() string {
 func (*StakeDelegationCert) ProtoMessage() {}
 
 func (x *StakeDelegationCert) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[48]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3745,7 +7465,7 @@ func (x *StakeDelegationCert) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StakeDelegationCert.ProtoReflect.Descriptor instead.
 func (*StakeDelegationCert) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{48}
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{86}
 }
 
 func (x *StakeDelegationCert) GetStakeCredential() *StakeCredential {
@@ -3780,7 +7500,7 @@ type PoolRegistrationCert struct {
 
 func (x *PoolRegistrationCert) Reset() {
 	*x = PoolRegistrationCert{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[49]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3792,7 +7512,7 @@ func (x *PoolRegistrationCert) String() string {
 func (*PoolRegistrationCert) ProtoMessage() {}
 
 func (x *PoolRegistrationCert) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[49]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3805,7 +7525,7 @@ func (x *PoolRegistrationCert) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PoolRegistrationCert.ProtoReflect.Descriptor instead.
 func (*PoolRegistrationCert) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{49}
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{87}
 }
 
 func (x *PoolRegistrationCert) GetOperator() []byte {
@@ -3882,7 +7602,7 @@ type PoolRetirementCert struct {
 
 func (x *PoolRetirementCert) Reset() {
 	*x = PoolRetirementCert{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[50]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3894,7 +7614,7 @@ func (x *PoolRetirementCert) String() string {
 func (*PoolRetirementCert) ProtoMessage() {}
 
 func (x *PoolRetirementCert) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[50]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3907,7 +7627,7 @@ func (x *PoolRetirementCert) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PoolRetirementCert.ProtoReflect.Descriptor instead.
 func (*PoolRetirementCert) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{50}
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{88}
 }
 
 func (x *PoolRetirementCert) GetPoolKeyhash() []byte {
@@ -3936,7 +7656,7 @@ type GenesisKeyDelegationCert struct {
 
 func (x *GenesisKeyDelegationCert) Reset() {
 	*x = GenesisKeyDelegationCert{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[51]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3948,7 +7668,7 @@ func (x *GenesisKeyDelegationCert) String() string {
 func (*GenesisKeyDelegationCert) ProtoMessage() {}
 
 func (x *GenesisKeyDelegationCert) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[51]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3961,7 +7681,7 @@ func (x *GenesisKeyDelegationCert) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenesisKeyDelegationCert.ProtoReflect.Descriptor instead.
 func (*GenesisKeyDelegationCert) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{51}
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{89}
 }
 
 func (x *GenesisKeyDelegationCert) GetGenesisHash() []byte {
@@ -3995,7 +7715,7 @@ type MirTarget struct {
 
 func (x *MirTarget) Reset() {
 	*x = MirTarget{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[52]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4007,7 +7727,7 @@ func (x *MirTarget) String() string {
 func (*MirTarget) ProtoMessage() {}
 
 func (x *MirTarget) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[52]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4020,7 +7740,7 @@ func (x *MirTarget) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MirTarget.ProtoReflect.Descriptor instead.
 func (*MirTarget) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{52}
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{90}
 }
 
 func (x *MirTarget) GetStakeCredential() *StakeCredential {
@@ -4049,7 +7769,7 @@ type MirCert struct {
 
 func (x *MirCert) Reset() {
 	*x = MirCert{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[53]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4061,7 +7781,7 @@ func (x *MirCert) String() string {
 func (*MirCert) ProtoMessage() {}
 
 func (x *MirCert) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[53]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4074,7 +7794,7 @@ func (x *MirCert) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MirCert.ProtoReflect.Descriptor instead.
 func (*MirCert) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{53}
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{91}
 }
 
 func (x *MirCert) GetFrom() MirSource {
@@ -4108,7 +7828,7 @@ type RegCert struct {
 
 func (x *RegCert) Reset() {
 	*x = RegCert{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[54]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4120,7 +7840,7 @@ func (x *RegCert) String() string {
 func (*RegCert) ProtoMessage() {}
 
 func (x *RegCert) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[54]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4133,7 +7853,7 @@ func (x *RegCert) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegCert.ProtoReflect.Descriptor instead.
 func (*RegCert) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{54}
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{92}
 }
 
 func (x *RegCert) GetStakeCredential() *StakeCredential {
@@ -4160,7 +7880,7 @@ type UnRegCert struct {
 
 func (x *UnRegCert) Reset() {
 	*x = UnRegCert{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[55]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4172,7 +7892,7 @@ func (x *UnRegCert) String() string {
 func (*UnRegCert) ProtoMessage() {}
 
 func (x *UnRegCert) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[55]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4185,7 +7905,7 @@ func (x *UnRegCert) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnRegCert.ProtoReflect.Descriptor instead.
 func (*UnRegCert) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{55}
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{93}
 }
 
 func (x *UnRegCert) GetStakeCredential() *StakeCredential {
@@ -4217,7 +7937,7 @@ type DRep struct {
 
 func (x *DRep) Reset() {
 	*x = DRep{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[56]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4229,7 +7949,7 @@ func (x *DRep) String() string {
 func (*DRep) ProtoMessage() {}
 
 func (x *DRep) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[56]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4242,7 +7962,7 @@ func (x *DRep) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DRep.ProtoReflect.Descriptor instead.
 func (*DRep) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{56}
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{94}
 }
 
 func (x *DRep) GetDrep() isDRep_Drep {
@@ -4326,7 +8046,7 @@ type VoteDelegCert struct {
 
 func (x *VoteDelegCert) Reset() {
 	*x = VoteDelegCert{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[57]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4338,7 +8058,7 @@ func (x *VoteDelegCert) String() string {
 func (*VoteDelegCert) ProtoMessage() {}
 
 func (x *VoteDelegCert) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[57]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4351,7 +8071,7 @@ func (x *VoteDelegCert) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VoteDelegCert.ProtoReflect.Descriptor instead.
 func (*VoteDelegCert) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{57}
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{95}
 }
 
 func (x *VoteDelegCert) GetStakeCredential() *StakeCredential {
@@ -4379,7 +8099,7 @@ type StakeVoteDelegCert struct {
 
 func (x *StakeVoteDelegCert) Reset() {
 	*x = StakeVoteDelegCert{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[58]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4391,7 +8111,7 @@ func (x *StakeVoteDelegCert) String() string {
 func (*StakeVoteDelegCert) ProtoMessage() {}
 
 func (x *StakeVoteDelegCert) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[58]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4404,7 +8124,7 @@ func (x *StakeVoteDelegCert) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StakeVoteDelegCert.ProtoReflect.Descriptor instead.
 func (*StakeVoteDelegCert) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{58}
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{96}
 }
 
 func (x *StakeVoteDelegCert) GetStakeCredential() *StakeCredential {
@@ -4439,7 +8159,7 @@ type StakeRegDelegCert struct {
 
 func (x *StakeRegDelegCert) Reset() {
 	*x = StakeRegDelegCert{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[59]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4451,7 +8171,7 @@ func (x *StakeRegDelegCert) String() string {
 func (*StakeRegDelegCert) ProtoMessage() {}
 
 func (x *StakeRegDelegCert) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[59]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4464,7 +8184,7 @@ func (x *StakeRegDelegCert) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StakeRegDelegCert.ProtoReflect.Descriptor instead.
 func (*StakeRegDelegCert) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{59}
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{97}
 }
 
 func (x *StakeRegDelegCert) GetStakeCredential() *StakeCredential {
@@ -4499,7 +8219,7 @@ type VoteRegDelegCert struct {
 
 func (x *VoteRegDelegCert) Reset() {
 	*x = VoteRegDelegCert{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[60]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4511,7 +8231,7 @@ func (x *VoteRegDelegCert) String() string {
 func (*VoteRegDelegCert) ProtoMessage() {}
 
 func (x *VoteRegDelegCert) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[60]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4524,7 +8244,7 @@ func (x *VoteRegDelegCert) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VoteRegDelegCert.ProtoReflect.Descriptor instead.
 func (*VoteRegDelegCert) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{60}
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{98}
 }
 
 func (x *VoteRegDelegCert) GetStakeCredential() *StakeCredential {
@@ -4560,7 +8280,7 @@ type StakeVoteRegDelegCert struct {
 
 func (x *StakeVoteRegDelegCert) Reset() {
 	*x = StakeVoteRegDelegCert{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[61]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4572,7 +8292,7 @@ func (x *StakeVoteRegDelegCert) String() string {
 func (*StakeVoteRegDelegCert) ProtoMessage() {}
 
 func (x *StakeVoteRegDelegCert) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[61]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4585,7 +8305,7 @@ func (x *StakeVoteRegDelegCert) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StakeVoteRegDelegCert.ProtoReflect.Descriptor instead.
 func (*StakeVoteRegDelegCert) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{61}
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{99}
 }
 
 func (x *StakeVoteRegDelegCert) GetStakeCredential() *StakeCredential {
@@ -4626,7 +8346,7 @@ type AuthCommitteeHotCert struct {
 
 func (x *AuthCommitteeHotCert) Reset() {
 	*x = AuthCommitteeHotCert{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[62]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4638,7 +8358,7 @@ func (x *AuthCommitteeHotCert) String() string {
 func (*AuthCommitteeHotCert) ProtoMessage() {}
 
 func (x *AuthCommitteeHotCert) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[62]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4651,7 +8371,7 @@ func (x *AuthCommitteeHotCert) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthCommitteeHotCert.ProtoReflect.Descriptor instead.
 func (*AuthCommitteeHotCert) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{62}
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{100}
 }
 
 func (x *AuthCommitteeHotCert) GetCommitteeColdCredential() *StakeCredential {
@@ -4678,7 +8398,7 @@ type Anchor struct {
 
 func (x *Anchor) Reset() {
 	*x = Anchor{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[63]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4690,7 +8410,7 @@ func (x *Anchor) String() string {
 func (*Anchor) ProtoMessage() {}
 
 func (x *Anchor) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[63]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4703,7 +8423,7 @@ func (x *Anchor) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Anchor.ProtoReflect.Descriptor instead.
 func (*Anchor) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{63}
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{101}
 }
 
 func (x *Anchor) GetUrl() string {
@@ -4730,7 +8450,7 @@ type ResignCommitteeColdCert struct {
 
 func (x *ResignCommitteeColdCert) Reset() {
 	*x = ResignCommitteeColdCert{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[64]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4742,7 +8462,7 @@ func (x *ResignCommitteeColdCert) String() string {
 func (*ResignCommitteeColdCert) ProtoMessage() {}
 
 func (x *ResignCommitteeColdCert) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[64]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4755,7 +8475,7 @@ func (x *ResignCommitteeColdCert) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResignCommitteeColdCert.ProtoReflect.Descriptor instead.
 func (*ResignCommitteeColdCert) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{64}
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{102}
 }
 
 func (x *ResignCommitteeColdCert) GetCommitteeColdCredential() *StakeCredential {
@@ -4783,7 +8503,7 @@ type RegDRepCert struct {
 
 func (x *RegDRepCert) Reset() {
 	*x = RegDRepCert{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[65]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4795,7 +8515,7 @@ func (x *RegDRepCert) String() string {
 func (*RegDRepCert) ProtoMessage() {}
 
 func (x *RegDRepCert) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[65]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4808,7 +8528,7 @@ func (x *RegDRepCert) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegDRepCert.ProtoReflect.Descriptor instead.
 func (*RegDRepCert) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{65}
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{103}
 }
 
 func (x *RegDRepCert) GetDrepCredential() *StakeCredential {
@@ -4842,7 +8562,7 @@ type UnRegDRepCert struct {
 
 func (x *UnRegDRepCert) Reset() {
 	*x = UnRegDRepCert{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[66]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4854,7 +8574,7 @@ func (x *UnRegDRepCert) String() string {
 func (*UnRegDRepCert) ProtoMessage() {}
 
 func (x *UnRegDRepCert) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[66]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4867,7 +8587,7 @@ func (x *UnRegDRepCert) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnRegDRepCert.ProtoReflect.Descriptor instead.
 func (*UnRegDRepCert) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{66}
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{104}
 }
 
 func (x *UnRegDRepCert) GetDrepCredential() *StakeCredential {
@@ -4894,7 +8614,7 @@ type UpdateDRepCert struct {
 
 func (x *UpdateDRepCert) Reset() {
 	*x = UpdateDRepCert{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[67]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4906,7 +8626,7 @@ func (x *UpdateDRepCert) String() string {
 func (*UpdateDRepCert) ProtoMessage() {}
 
 func (x *UpdateDRepCert) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[67]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4919,7 +8639,7 @@ func (x *UpdateDRepCert) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateDRepCert.ProtoReflect.Descriptor instead.
 func (*UpdateDRepCert) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{67}
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{105}
 }
 
 func (x *UpdateDRepCert) GetDrepCredential() *StakeCredential {
@@ -4948,7 +8668,7 @@ type AddressPattern struct {
 
 func (x *AddressPattern) Reset() {
 	*x = AddressPattern{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[68]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4960,7 +8680,7 @@ func (x *AddressPattern) String() string {
 func (*AddressPattern) ProtoMessage() {}
 
 func (x *AddressPattern) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[68]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4973,7 +8693,7 @@ func (x *AddressPattern) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddressPattern.ProtoReflect.Descriptor instead.
 func (*AddressPattern) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{68}
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{106}
 }
 
 func (x *AddressPattern) GetExactAddress() []byte {
@@ -5008,7 +8728,7 @@ type AssetPattern struct {
 
 func (x *AssetPattern) Reset() {
 	*x = AssetPattern{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[69]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5020,7 +8740,7 @@ func (x *AssetPattern) String() string {
 func (*AssetPattern) ProtoMessage() {}
 
 func (x *AssetPattern) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[69]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5033,7 +8753,7 @@ func (x *AssetPattern) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AssetPattern.ProtoReflect.Descriptor instead.
 func (*AssetPattern) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{69}
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{107}
 }
 
 func (x *AssetPattern) GetPolicyId() []byte {
@@ -5061,7 +8781,7 @@ type TxOutputPattern struct {
 
 func (x *TxOutputPattern) Reset() {
 	*x = TxOutputPattern{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[70]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5073,7 +8793,7 @@ func (x *TxOutputPattern) String() string {
 func (*TxOutputPattern) ProtoMessage() {}
 
 func (x *TxOutputPattern) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[70]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5086,7 +8806,7 @@ func (x *TxOutputPattern) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TxOutputPattern.ProtoReflect.Descriptor instead.
 func (*TxOutputPattern) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{70}
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{108}
 }
 
 func (x *TxOutputPattern) GetAddress() *AddressPattern {
@@ -5118,7 +8838,7 @@ type TxPattern struct {
 
 func (x *TxPattern) Reset() {
 	*x = TxPattern{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[71]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5130,7 +8850,7 @@ func (x *TxPattern) String() string {
 func (*TxPattern) ProtoMessage() {}
 
 func (x *TxPattern) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[71]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5143,7 +8863,7 @@ func (x *TxPattern) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TxPattern.ProtoReflect.Descriptor instead.
 func (*TxPattern) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{71}
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{109}
 }
 
 func (x *TxPattern) GetConsumes() *TxOutputPattern {
@@ -5191,7 +8911,7 @@ type ExUnits struct {
 
 func (x *ExUnits) Reset() {
 	*x = ExUnits{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[72]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5203,7 +8923,7 @@ func (x *ExUnits) String() string {
 func (*ExUnits) ProtoMessage() {}
 
 func (x *ExUnits) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[72]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5216,7 +8936,7 @@ func (x *ExUnits) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExUnits.ProtoReflect.Descriptor instead.
 func (*ExUnits) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{72}
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{110}
 }
 
 func (x *ExUnits) GetSteps() uint64 {
@@ -5243,7 +8963,7 @@ type ExPrices struct {
 
 func (x *ExPrices) Reset() {
 	*x = ExPrices{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[73]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5255,7 +8975,7 @@ func (x *ExPrices) String() string {
 func (*ExPrices) ProtoMessage() {}
 
 func (x *ExPrices) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[73]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5268,7 +8988,7 @@ func (x *ExPrices) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExPrices.ProtoReflect.Descriptor instead.
 func (*ExPrices) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{73}
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{111}
 }
 
 func (x *ExPrices) GetSteps() *RationalNumber {
@@ -5295,7 +9015,7 @@ type ProtocolVersion struct {
 
 func (x *ProtocolVersion) Reset() {
 	*x = ProtocolVersion{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[74]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5307,7 +9027,7 @@ func (x *ProtocolVersion) String() string {
 func (*ProtocolVersion) ProtoMessage() {}
 
 func (x *ProtocolVersion) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[74]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5320,7 +9040,7 @@ func (x *ProtocolVersion) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtocolVersion.ProtoReflect.Descriptor instead.
 func (*ProtocolVersion) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{74}
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{112}
 }
 
 func (x *ProtocolVersion) GetMajor() uint32 {
@@ -5346,7 +9066,7 @@ type CostModel struct {
 
 func (x *CostModel) Reset() {
 	*x = CostModel{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[75]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5358,7 +9078,7 @@ func (x *CostModel) String() string {
 func (*CostModel) ProtoMessage() {}
 
 func (x *CostModel) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[75]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5371,7 +9091,7 @@ func (x *CostModel) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CostModel.ProtoReflect.Descriptor instead.
 func (*CostModel) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{75}
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{113}
 }
 
 func (x *CostModel) GetValues() []int64 {
@@ -5392,7 +9112,7 @@ type CostModels struct {
 
 func (x *CostModels) Reset() {
 	*x = CostModels{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[76]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5404,7 +9124,7 @@ func (x *CostModels) String() string {
 func (*CostModels) ProtoMessage() {}
 
 func (x *CostModels) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[76]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5417,7 +9137,7 @@ func (x *CostModels) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CostModels.ProtoReflect.Descriptor instead.
 func (*CostModels) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{76}
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{114}
 }
 
 func (x *CostModels) GetPlutusV1() *CostModel {
@@ -5450,7 +9170,7 @@ type VotingThresholds struct {
 
 func (x *VotingThresholds) Reset() {
 	*x = VotingThresholds{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[77]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5462,7 +9182,7 @@ func (x *VotingThresholds) String() string {
 func (*VotingThresholds) ProtoMessage() {}
 
 func (x *VotingThresholds) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[77]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5475,7 +9195,7 @@ func (x *VotingThresholds) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VotingThresholds.ProtoReflect.Descriptor instead.
 func (*VotingThresholds) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{77}
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{115}
 }
 
 func (x *VotingThresholds) GetThresholds() []*RationalNumber {
@@ -5518,13 +9238,17 @@ type PParams struct {
 	GovernanceActionDeposit         uint64                 `protobuf:"varint,29,opt,name=governance_action_deposit,json=governanceActionDeposit,proto3" json:"governance_action_deposit,omitempty"`                            // The governance action deposit.
 	DrepDeposit                     uint64                 `protobuf:"varint,30,opt,name=drep_deposit,json=drepDeposit,proto3" json:"drep_deposit,omitempty"`                                                                  // The drep deposit.
 	DrepInactivityPeriod            uint64                 `protobuf:"varint,31,opt,name=drep_inactivity_period,json=drepInactivityPeriod,proto3" json:"drep_inactivity_period,omitempty"`                                     // The drep inactivity period.
+	Decentralization                *RationalNumber        `protobuf:"bytes,32,opt,name=decentralization,proto3" json:"decentralization,omitempty"`                                                                            // Up to Alonzo
+	ExtraEntropy                    []byte                 `protobuf:"bytes,33,opt,name=extra_entropy,json=extraEntropy,proto3" json:"extra_entropy,omitempty"`                                                                // Up to Alonzo, empty for the neutral nonce
+	MinUtxoValue                    uint64                 `protobuf:"varint,34,opt,name=min_utxo_value,json=minUtxoValue,proto3" json:"min_utxo_value,omitempty"`                                                             // Shelley to Mary
+	CoinsPerUtxoWord                uint64                 `protobuf:"varint,35,opt,name=coins_per_utxo_word,json=coinsPerUtxoWord,proto3" json:"coins_per_utxo_word,omitempty"`                                               // Alonzo
 	unknownFields                   protoimpl.UnknownFields
 	sizeCache                       protoimpl.SizeCache
 }
 
 func (x *PParams) Reset() {
 	*x = PParams{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[78]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5536,7 +9260,7 @@ func (x *PParams) String() string {
 func (*PParams) ProtoMessage() {}
 
 func (x *PParams) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[78]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5549,7 +9273,7 @@ func (x *PParams) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PParams.ProtoReflect.Descriptor instead.
 func (*PParams) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{78}
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{116}
 }
 
 func (x *PParams) GetCoinsPerUtxoByte() uint64 {
@@ -5769,6 +9493,34 @@ func (x *PParams) GetDrepInactivityPeriod() uint64 {
 	return 0
 }
 
+func (x *PParams) GetDecentralization() *RationalNumber {
+	if x != nil {
+		return x.Decentralization
+	}
+	return nil
+}
+
+func (x *PParams) GetExtraEntropy() []byte {
+	if x != nil {
+		return x.ExtraEntropy
+	}
+	return nil
+}
+
+func (x *PParams) GetMinUtxoValue() uint64 {
+	if x != nil {
+		return x.MinUtxoValue
+	}
+	return 0
+}
+
+func (x *PParams) GetCoinsPerUtxoWord() uint64 {
+	if x != nil {
+		return x.CoinsPerUtxoWord
+	}
+	return 0
+}
+
 type EraBoundary struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Time          uint64                 `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`   // ms timestamp
@@ -5780,7 +9532,7 @@ type EraBoundary struct {
 
 func (x *EraBoundary) Reset() {
 	*x = EraBoundary{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[79]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5792,7 +9544,7 @@ func (x *EraBoundary) String() string {
 func (*EraBoundary) ProtoMessage() {}
 
 func (x *EraBoundary) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[79]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5805,7 +9557,7 @@ func (x *EraBoundary) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EraBoundary.ProtoReflect.Descriptor instead.
 func (*EraBoundary) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{79}
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{117}
 }
 
 func (x *EraBoundary) GetTime() uint64 {
@@ -5841,7 +9593,7 @@ type EraSummary struct {
 
 func (x *EraSummary) Reset() {
 	*x = EraSummary{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[80]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5853,7 +9605,7 @@ func (x *EraSummary) String() string {
 func (*EraSummary) ProtoMessage() {}
 
 func (x *EraSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[80]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5866,7 +9618,7 @@ func (x *EraSummary) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EraSummary.ProtoReflect.Descriptor instead.
 func (*EraSummary) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{80}
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{118}
 }
 
 func (x *EraSummary) GetName() string {
@@ -5906,7 +9658,7 @@ type EraSummaries struct {
 
 func (x *EraSummaries) Reset() {
 	*x = EraSummaries{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[81]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5918,7 +9670,7 @@ func (x *EraSummaries) String() string {
 func (*EraSummaries) ProtoMessage() {}
 
 func (x *EraSummaries) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[81]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5931,7 +9683,7 @@ func (x *EraSummaries) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EraSummaries.ProtoReflect.Descriptor instead.
 func (*EraSummaries) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{81}
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{119}
 }
 
 func (x *EraSummaries) GetSummaries() []*EraSummary {
@@ -5950,7 +9702,7 @@ type EvalError struct {
 
 func (x *EvalError) Reset() {
 	*x = EvalError{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[82]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5962,7 +9714,7 @@ func (x *EvalError) String() string {
 func (*EvalError) ProtoMessage() {}
 
 func (x *EvalError) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[82]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[120]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5975,7 +9727,7 @@ func (x *EvalError) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EvalError.ProtoReflect.Descriptor instead.
 func (*EvalError) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{82}
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{120}
 }
 
 func (x *EvalError) GetMsg() string {
@@ -5994,7 +9746,7 @@ type EvalTrace struct {
 
 func (x *EvalTrace) Reset() {
 	*x = EvalTrace{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[83]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[121]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6006,7 +9758,7 @@ func (x *EvalTrace) String() string {
 func (*EvalTrace) ProtoMessage() {}
 
 func (x *EvalTrace) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[83]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6019,7 +9771,7 @@ func (x *EvalTrace) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EvalTrace.ProtoReflect.Descriptor instead.
 func (*EvalTrace) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{83}
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{121}
 }
 
 func (x *EvalTrace) GetMsg() string {
@@ -6042,7 +9794,7 @@ type TxEval struct {
 
 func (x *TxEval) Reset() {
 	*x = TxEval{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[84]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6054,7 +9806,7 @@ func (x *TxEval) String() string {
 func (*TxEval) ProtoMessage() {}
 
 func (x *TxEval) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[84]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6067,7 +9819,7 @@ func (x *TxEval) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TxEval.ProtoReflect.Descriptor instead.
 func (*TxEval) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{84}
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{122}
 }
 
 func (x *TxEval) GetFee() uint64 {
@@ -6114,7 +9866,7 @@ type ExtraEntropy struct {
 
 func (x *ExtraEntropy) Reset() {
 	*x = ExtraEntropy{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[85]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6126,7 +9878,7 @@ func (x *ExtraEntropy) String() string {
 func (*ExtraEntropy) ProtoMessage() {}
 
 func (x *ExtraEntropy) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[85]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6139,7 +9891,7 @@ func (x *ExtraEntropy) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExtraEntropy.ProtoReflect.Descriptor instead.
 func (*ExtraEntropy) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{85}
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{123}
 }
 
 func (x *ExtraEntropy) GetTag() string {
@@ -6171,7 +9923,7 @@ type BlockVersionData struct {
 
 func (x *BlockVersionData) Reset() {
 	*x = BlockVersionData{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[86]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[124]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6183,7 +9935,7 @@ func (x *BlockVersionData) String() string {
 func (*BlockVersionData) ProtoMessage() {}
 
 func (x *BlockVersionData) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[86]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[124]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6196,7 +9948,7 @@ func (x *BlockVersionData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BlockVersionData.ProtoReflect.Descriptor instead.
 func (*BlockVersionData) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{86}
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{124}
 }
 
 func (x *BlockVersionData) GetScriptVersion() uint32 {
@@ -6308,7 +10060,7 @@ type SoftforkRule struct {
 
 func (x *SoftforkRule) Reset() {
 	*x = SoftforkRule{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[87]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[125]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6320,7 +10072,7 @@ func (x *SoftforkRule) String() string {
 func (*SoftforkRule) ProtoMessage() {}
 
 func (x *SoftforkRule) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[87]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[125]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6333,7 +10085,7 @@ func (x *SoftforkRule) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SoftforkRule.ProtoReflect.Descriptor instead.
 func (*SoftforkRule) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{87}
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{125}
 }
 
 func (x *SoftforkRule) GetInitThd() string {
@@ -6367,7 +10119,7 @@ type TxFeePolicy struct {
 
 func (x *TxFeePolicy) Reset() {
 	*x = TxFeePolicy{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[88]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[126]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6379,7 +10131,7 @@ func (x *TxFeePolicy) String() string {
 func (*TxFeePolicy) ProtoMessage() {}
 
 func (x *TxFeePolicy) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[88]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[126]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6392,7 +10144,7 @@ func (x *TxFeePolicy) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TxFeePolicy.ProtoReflect.Descriptor instead.
 func (*TxFeePolicy) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{88}
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{126}
 }
 
 func (x *TxFeePolicy) GetMultiplier() string {
@@ -6421,7 +10173,7 @@ type ProtocolConsts struct {
 
 func (x *ProtocolConsts) Reset() {
 	*x = ProtocolConsts{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[89]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[127]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6433,7 +10185,7 @@ func (x *ProtocolConsts) String() string {
 func (*ProtocolConsts) ProtoMessage() {}
 
 func (x *ProtocolConsts) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[89]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[127]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6446,7 +10198,7 @@ func (x *ProtocolConsts) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtocolConsts.ProtoReflect.Descriptor instead.
 func (*ProtocolConsts) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{89}
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{127}
 }
 
 func (x *ProtocolConsts) GetK() uint32 {
@@ -6489,7 +10241,7 @@ type HeavyDelegation struct {
 
 func (x *HeavyDelegation) Reset() {
 	*x = HeavyDelegation{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[90]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[128]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6501,7 +10253,7 @@ func (x *HeavyDelegation) String() string {
 func (*HeavyDelegation) ProtoMessage() {}
 
 func (x *HeavyDelegation) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[90]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[128]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6514,7 +10266,7 @@ func (x *HeavyDelegation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HeavyDelegation.ProtoReflect.Descriptor instead.
 func (*HeavyDelegation) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{90}
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{128}
 }
 
 func (x *HeavyDelegation) GetCert() string {
@@ -6557,7 +10309,7 @@ type VssCert struct {
 
 func (x *VssCert) Reset() {
 	*x = VssCert{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[91]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[129]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6569,7 +10321,7 @@ func (x *VssCert) String() string {
 func (*VssCert) ProtoMessage() {}
 
 func (x *VssCert) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[91]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[129]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6582,7 +10334,7 @@ func (x *VssCert) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VssCert.ProtoReflect.Descriptor instead.
 func (*VssCert) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{91}
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{129}
 }
 
 func (x *VssCert) GetExpiryEpoch() uint32 {
@@ -6623,7 +10375,7 @@ type GenDelegs struct {
 
 func (x *GenDelegs) Reset() {
 	*x = GenDelegs{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[92]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[130]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6635,7 +10387,7 @@ func (x *GenDelegs) String() string {
 func (*GenDelegs) ProtoMessage() {}
 
 func (x *GenDelegs) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[92]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[130]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6648,7 +10400,7 @@ func (x *GenDelegs) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenDelegs.ProtoReflect.Descriptor instead.
 func (*GenDelegs) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{92}
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{130}
 }
 
 func (x *GenDelegs) GetDelegate() string {
@@ -6678,7 +10430,7 @@ type PoolVotingThresholds struct {
 
 func (x *PoolVotingThresholds) Reset() {
 	*x = PoolVotingThresholds{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[93]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[131]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6690,7 +10442,7 @@ func (x *PoolVotingThresholds) String() string {
 func (*PoolVotingThresholds) ProtoMessage() {}
 
 func (x *PoolVotingThresholds) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[93]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[131]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6703,7 +10455,7 @@ func (x *PoolVotingThresholds) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PoolVotingThresholds.ProtoReflect.Descriptor instead.
 func (*PoolVotingThresholds) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{93}
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{131}
 }
 
 func (x *PoolVotingThresholds) GetMotionNoConfidence() *RationalNumber {
@@ -6759,7 +10511,7 @@ type DRepVotingThresholds struct {
 
 func (x *DRepVotingThresholds) Reset() {
 	*x = DRepVotingThresholds{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[94]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[132]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6771,7 +10523,7 @@ func (x *DRepVotingThresholds) String() string {
 func (*DRepVotingThresholds) ProtoMessage() {}
 
 func (x *DRepVotingThresholds) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[94]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[132]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6784,7 +10536,7 @@ func (x *DRepVotingThresholds) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DRepVotingThresholds.ProtoReflect.Descriptor instead.
 func (*DRepVotingThresholds) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{94}
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{132}
 }
 
 func (x *DRepVotingThresholds) GetMotionNoConfidence() *RationalNumber {
@@ -6867,7 +10619,7 @@ type Committee struct {
 
 func (x *Committee) Reset() {
 	*x = Committee{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[95]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[133]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6879,7 +10631,7 @@ func (x *Committee) String() string {
 func (*Committee) ProtoMessage() {}
 
 func (x *Committee) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[95]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[133]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6892,7 +10644,7 @@ func (x *Committee) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Committee.ProtoReflect.Descriptor instead.
 func (*Committee) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{95}
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{133}
 }
 
 func (x *Committee) GetMembers() map[string]uint64 {
@@ -6920,7 +10672,7 @@ type CostModelMap struct {
 
 func (x *CostModelMap) Reset() {
 	*x = CostModelMap{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[96]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[134]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6932,7 +10684,7 @@ func (x *CostModelMap) String() string {
 func (*CostModelMap) ProtoMessage() {}
 
 func (x *CostModelMap) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[96]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[134]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6945,7 +10697,7 @@ func (x *CostModelMap) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CostModelMap.ProtoReflect.Descriptor instead.
 func (*CostModelMap) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{96}
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{134}
 }
 
 func (x *CostModelMap) GetPlutusV1() *CostModel {
@@ -7024,7 +10776,7 @@ type Genesis struct {
 
 func (x *Genesis) Reset() {
 	*x = Genesis{}
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[97]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[135]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7036,7 +10788,7 @@ func (x *Genesis) String() string {
 func (*Genesis) ProtoMessage() {}
 
 func (x *Genesis) ProtoReflect() protoreflect.Message {
-	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[97]
+	mi := &file_sf_cardano_type_v1_type_proto_msgTypes[135]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7049,7 +10801,7 @@ func (x *Genesis) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Genesis.ProtoReflect.Descriptor instead.
 func (*Genesis) Descriptor() ([]byte, []int) {
-	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{97}
+	return file_sf_cardano_type_v1_type_proto_rawDescGZIP(), []int{135}
 }
 
 func (x *Genesis) GetAvvmDistr() map[string]string {
@@ -7350,24 +11102,28 @@ var File_sf_cardano_type_v1_type_proto protoreflect.FileDescriptor
 
 const file_sf_cardano_type_v1_type_proto_rawDesc = "" +
 	"\n" +
-	"\x1dsf/cardano/type/v1/type.proto\x12\x12sf.cardano.type.v1\"\xf6\x01\n" +
+	"\x1dsf/cardano/type/v1/type.proto\x12\x12sf.cardano.type.v1\"\xe4\x02\n" +
 	"\bRedeemer\x12=\n" +
 	"\apurpose\x18\x01 \x01(\x0e2#.sf.cardano.type.v1.RedeemerPurposeR\apurpose\x128\n" +
 	"\apayload\x18\x02 \x01(\v2\x1e.sf.cardano.type.v1.PlutusDataR\apayload\x12\x14\n" +
 	"\x05index\x18\x03 \x01(\rR\x05index\x126\n" +
 	"\bex_units\x18\x04 \x01(\v2\x1b.sf.cardano.type.v1.ExUnitsR\aexUnits\x12#\n" +
-	"\roriginal_cbor\x18\x05 \x01(\fR\foriginalCbor\"\xba\x01\n" +
+	"\roriginal_cbor\x18\x05 \x01(\fR\foriginalCbor\x12\x1f\n" +
+	"\vscript_hash\x18\x06 \x01(\fR\n" +
+	"scriptHash\x12K\n" +
+	"\x0fscript_language\x18\a \x01(\x0e2\".sf.cardano.type.v1.ScriptLanguageR\x0escriptLanguage\"\xba\x01\n" +
 	"\aTxInput\x12\x17\n" +
 	"\atx_hash\x18\x01 \x01(\fR\x06txHash\x12!\n" +
 	"\foutput_index\x18\x02 \x01(\rR\voutputIndex\x129\n" +
 	"\tas_output\x18\x03 \x01(\v2\x1c.sf.cardano.type.v1.TxOutputR\basOutput\x128\n" +
-	"\bredeemer\x18\x04 \x01(\v2\x1c.sf.cardano.type.v1.RedeemerR\bredeemer\"\xd5\x01\n" +
+	"\bredeemer\x18\x04 \x01(\v2\x1c.sf.cardano.type.v1.RedeemerR\bredeemer\"\x9c\x02\n" +
 	"\bTxOutput\x12\x18\n" +
 	"\aaddress\x18\x01 \x01(\fR\aaddress\x12\x12\n" +
 	"\x04coin\x18\x02 \x01(\x04R\x04coin\x126\n" +
 	"\x06assets\x18\x03 \x03(\v2\x1e.sf.cardano.type.v1.MultiassetR\x06assets\x12/\n" +
 	"\x05datum\x18\x04 \x01(\v2\x19.sf.cardano.type.v1.DatumR\x05datum\x122\n" +
-	"\x06script\x18\x05 \x01(\v2\x1a.sf.cardano.type.v1.ScriptR\x06script\"z\n" +
+	"\x06script\x18\x05 \x01(\v2\x1a.sf.cardano.type.v1.ScriptR\x06script\x12E\n" +
+	"\rbyron_address\x18\x06 \x01(\v2 .sf.cardano.type.v1.ByronAddressR\fbyronAddress\"z\n" +
 	"\x05Datum\x12\x12\n" +
 	"\x04hash\x18\x01 \x01(\fR\x04hash\x128\n" +
 	"\apayload\x18\x02 \x01(\v2\x1e.sf.cardano.type.v1.PlutusDataR\apayload\x12#\n" +
@@ -7381,31 +11137,48 @@ const file_sf_cardano_type_v1_type_proto_rawDesc = "" +
 	"Multiasset\x12\x1b\n" +
 	"\tpolicy_id\x18\x01 \x01(\fR\bpolicyId\x121\n" +
 	"\x06assets\x18\x02 \x03(\v2\x19.sf.cardano.type.v1.AssetR\x06assets\x128\n" +
-	"\bredeemer\x18\x03 \x01(\v2\x1c.sf.cardano.type.v1.RedeemerR\bredeemer\"4\n" +
+	"\bredeemer\x18\x03 \x01(\v2\x1c.sf.cardano.type.v1.RedeemerR\bredeemer\"\xc3\x01\n" +
 	"\n" +
-	"TxValidity\x12\x14\n" +
-	"\x05start\x18\x01 \x01(\x04R\x05start\x12\x10\n" +
-	"\x03ttl\x18\x02 \x01(\x04R\x03ttl\"\xbf\x01\n" +
+	"TxValidity\x12\x18\n" +
+	"\x05start\x18\x01 \x01(\x04B\x02\x18\x01R\x05start\x12\x14\n" +
+	"\x03ttl\x18\x02 \x01(\x04B\x02\x18\x01R\x03ttl\x12*\n" +
+	"\x0einvalid_before\x18\x03 \x01(\x04H\x00R\rinvalidBefore\x88\x01\x01\x120\n" +
+	"\x11invalid_hereafter\x18\x04 \x01(\x04H\x01R\x10invalidHereafter\x88\x01\x01B\x11\n" +
+	"\x0f_invalid_beforeB\x14\n" +
+	"\x12_invalid_hereafter\"\xa2\x02\n" +
 	"\n" +
 	"Collateral\x12;\n" +
 	"\n" +
 	"collateral\x18\x01 \x03(\v2\x1b.sf.cardano.type.v1.TxInputR\n" +
 	"collateral\x12I\n" +
-	"\x11collateral_return\x18\x02 \x01(\v2\x1c.sf.cardano.type.v1.TxOutputR\x10collateralReturn\x12)\n" +
-	"\x10total_collateral\x18\x03 \x01(\x04R\x0ftotalCollateral\"\x81\x01\n" +
+	"\x11collateral_return\x18\x02 \x01(\v2\x1c.sf.cardano.type.v1.TxOutputR\x10collateralReturn\x12-\n" +
+	"\x10total_collateral\x18\x03 \x01(\x04B\x02\x18\x01R\x0ftotalCollateral\x12?\n" +
+	"\x19declared_total_collateral\x18\x04 \x01(\x04H\x00R\x17declaredTotalCollateral\x88\x01\x01B\x1c\n" +
+	"\x1a_declared_total_collateral\"\x81\x01\n" +
 	"\n" +
 	"Withdrawal\x12%\n" +
 	"\x0ereward_account\x18\x01 \x01(\fR\rrewardAccount\x12\x12\n" +
 	"\x04coin\x18\x02 \x01(\x04R\x04coin\x128\n" +
-	"\bredeemer\x18\x03 \x01(\v2\x1c.sf.cardano.type.v1.RedeemerR\bredeemer\"\xc8\x01\n" +
+	"\bredeemer\x18\x03 \x01(\v2\x1c.sf.cardano.type.v1.RedeemerR\bredeemer\"\xdb\x02\n" +
 	"\n" +
 	"WitnessSet\x12A\n" +
 	"\vvkeywitness\x18\x01 \x03(\v2\x1f.sf.cardano.type.v1.VKeyWitnessR\vvkeywitness\x122\n" +
 	"\x06script\x18\x02 \x03(\v2\x1a.sf.cardano.type.v1.ScriptR\x06script\x12C\n" +
-	"\rplutus_datums\x18\x03 \x03(\v2\x1e.sf.cardano.type.v1.PlutusDataR\fplutusDatums\"y\n" +
+	"\rplutus_datums\x18\x03 \x03(\v2\x1e.sf.cardano.type.v1.PlutusDataR\fplutusDatums\x12:\n" +
+	"\tredeemers\x18\x04 \x03(\v2\x1c.sf.cardano.type.v1.RedeemerR\tredeemers\x12U\n" +
+	"\x13bootstrap_witnesses\x18\x05 \x03(\v2$.sf.cardano.type.v1.BootstrapWitnessR\x12bootstrapWitnesses\"\x9b\x01\n" +
+	"\x10BootstrapWitness\x12\x12\n" +
+	"\x04vkey\x18\x01 \x01(\fR\x04vkey\x12\x1c\n" +
+	"\tsignature\x18\x02 \x01(\fR\tsignature\x12\x1d\n" +
+	"\n" +
+	"chain_code\x18\x03 \x01(\fR\tchainCode\x12\x1e\n" +
+	"\n" +
+	"attributes\x18\x04 \x01(\fR\n" +
+	"attributes\x12\x16\n" +
+	"\x06redeem\x18\x05 \x01(\bR\x06redeem\"y\n" +
 	"\aAuxData\x128\n" +
 	"\bmetadata\x18\x01 \x03(\v2\x1c.sf.cardano.type.v1.MetadataR\bmetadata\x124\n" +
-	"\ascripts\x18\x02 \x03(\v2\x1a.sf.cardano.type.v1.ScriptR\ascripts\"\xfb\x05\n" +
+	"\ascripts\x18\x02 \x03(\v2\x1a.sf.cardano.type.v1.ScriptR\ascripts\"\x96\x0e\n" +
 	"\x02Tx\x123\n" +
 	"\x06inputs\x18\x01 \x03(\v2\x1b.sf.cardano.type.v1.TxInputR\x06inputs\x126\n" +
 	"\aoutputs\x18\x02 \x03(\v2\x1c.sf.cardano.type.v1.TxOutputR\aoutputs\x12C\n" +
@@ -7425,7 +11198,112 @@ const file_sf_cardano_type_v1_type_proto_rawDesc = "" +
 	"successful\x129\n" +
 	"\tauxiliary\x18\f \x01(\v2\x1b.sf.cardano.type.v1.AuxDataR\tauxiliary\x12\x12\n" +
 	"\x04hash\x18\r \x01(\fR\x04hash\x12J\n" +
-	"\tproposals\x18\x0e \x03(\v2,.sf.cardano.type.v1.GovernanceActionProposalR\tproposals\"\xd4\x01\n" +
+	"\tproposals\x18\x0e \x03(\v2,.sf.cardano.type.v1.GovernanceActionProposalR\tproposals\x12P\n" +
+	"\x11voting_procedures\x18\x0f \x03(\v2#.sf.cardano.type.v1.VotingProcedureR\x10votingProcedures\x12)\n" +
+	"\x10required_signers\x18\x10 \x03(\fR\x0frequiredSigners\x12(\n" +
+	"\x10script_data_hash\x18\x11 \x01(\fR\x0escriptDataHash\x12\"\n" +
+	"\n" +
+	"network_id\x18\x12 \x01(\rH\x00R\tnetworkId\x88\x01\x01\x129\n" +
+	"\x16current_treasury_value\x18\x13 \x01(\x04H\x01R\x14currentTreasuryValue\x88\x01\x01\x120\n" +
+	"\x11treasury_donation\x18\x14 \x01(\x04H\x02R\x10treasuryDonation\x88\x01\x01\x12.\n" +
+	"\x13auxiliary_data_hash\x18\x15 \x01(\fR\x11auxiliaryDataHash\x12\x14\n" +
+	"\x05index\x18\x16 \x01(\rR\x05index\x12\x12\n" +
+	"\x04size\x18\x17 \x01(\x04R\x04size\x12,\n" +
+	"\x12original_body_cbor\x18\x18 \x01(\fR\x10originalBodyCbor\x126\n" +
+	"\x17original_witnesses_cbor\x18\x19 \x01(\fR\x15originalWitnessesCbor\x126\n" +
+	"\x17original_auxiliary_cbor\x18\x1a \x01(\fR\x15originalAuxiliaryCbor\x12M\n" +
+	"\x10executed_scripts\x18\x1b \x03(\v2\".sf.cardano.type.v1.ExecutedScriptR\x0fexecutedScripts\x12N\n" +
+	"\x10decoded_metadata\x18\x1c \x03(\v2#.sf.cardano.type.v1.DecodedMetadataR\x0fdecodedMetadata\x12A\n" +
+	"\fcip68_tokens\x18\x1d \x03(\v2\x1e.sf.cardano.type.v1.Cip68TokenR\vcip68Tokens\x12L\n" +
+	"\rcip68_updates\x18\x1e \x03(\v2'.sf.cardano.type.v1.Cip68MetadataUpdateR\fcip68Updates\x128\n" +
+	"\x06effect\x18\x1f \x01(\v2 .sf.cardano.type.v1.LedgerEffectR\x06effect\x12?\n" +
+	"\x06update\x18  \x01(\v2'.sf.cardano.type.v1.ProtocolParamUpdateR\x06updateB\r\n" +
+	"\v_network_idB\x19\n" +
+	"\x17_current_treasury_valueB\x14\n" +
+	"\x12_treasury_donation\"\xc1\x01\n" +
+	"\fLedgerEffect\x124\n" +
+	"\x05spent\x18\x01 \x03(\v2\x1e.sf.cardano.type.v1.TxInputRefR\x05spent\x129\n" +
+	"\acreated\x18\x02 \x03(\v2\x1f.sf.cardano.type.v1.TxOutputRefR\acreated\x12\x15\n" +
+	"\x03fee\x18\x03 \x01(\x04H\x00R\x03fee\x88\x01\x01\x12!\n" +
+	"\fbody_applied\x18\x04 \x01(\bR\vbodyAppliedB\x06\n" +
+	"\x04_fee\"B\n" +
+	"\n" +
+	"TxInputRef\x12\x1e\n" +
+	"\n" +
+	"collateral\x18\x01 \x01(\bR\n" +
+	"collateral\x12\x14\n" +
+	"\x05index\x18\x02 \x01(\rR\x05index\"P\n" +
+	"\vTxOutputRef\x12+\n" +
+	"\x11collateral_return\x18\x01 \x01(\bR\x10collateralReturn\x12\x14\n" +
+	"\x05index\x18\x02 \x01(\rR\x05index\"q\n" +
+	"\x13ProtocolParamUpdate\x12\x14\n" +
+	"\x05epoch\x18\x01 \x01(\x04R\x05epoch\x12D\n" +
+	"\tproposals\x18\x02 \x03(\v2&.sf.cardano.type.v1.GenesisParamUpdateR\tproposals\"r\n" +
+	"\x12GenesisParamUpdate\x12!\n" +
+	"\fgenesis_hash\x18\x01 \x01(\fR\vgenesisHash\x129\n" +
+	"\x06params\x18\x02 \x01(\v2!.sf.cardano.type.v1.PParamsUpdateR\x06params\"\xd3\x15\n" +
+	"\rPParamsUpdate\x123\n" +
+	"\x13min_fee_coefficient\x18\x01 \x01(\x04H\x00R\x11minFeeCoefficient\x88\x01\x01\x12-\n" +
+	"\x10min_fee_constant\x18\x02 \x01(\x04H\x01R\x0eminFeeConstant\x88\x01\x01\x122\n" +
+	"\x13max_block_body_size\x18\x03 \x01(\x04H\x02R\x10maxBlockBodySize\x88\x01\x01\x12#\n" +
+	"\vmax_tx_size\x18\x04 \x01(\x04H\x03R\tmaxTxSize\x88\x01\x01\x126\n" +
+	"\x15max_block_header_size\x18\x05 \x01(\x04H\x04R\x12maxBlockHeaderSize\x88\x01\x01\x12/\n" +
+	"\x11stake_key_deposit\x18\x06 \x01(\x04H\x05R\x0fstakeKeyDeposit\x88\x01\x01\x12&\n" +
+	"\fpool_deposit\x18\a \x01(\x04H\x06R\vpoolDeposit\x88\x01\x01\x12B\n" +
+	"\x1bpool_retirement_epoch_bound\x18\b \x01(\x04H\aR\x18poolRetirementEpochBound\x88\x01\x01\x12:\n" +
+	"\x17desired_number_of_pools\x18\t \x01(\x04H\bR\x14desiredNumberOfPools\x88\x01\x01\x12I\n" +
+	"\x0epool_influence\x18\n" +
+	" \x01(\v2\".sf.cardano.type.v1.RationalNumberR\rpoolInfluence\x12Q\n" +
+	"\x12monetary_expansion\x18\v \x01(\v2\".sf.cardano.type.v1.RationalNumberR\x11monetaryExpansion\x12Q\n" +
+	"\x12treasury_expansion\x18\f \x01(\v2\".sf.cardano.type.v1.RationalNumberR\x11treasuryExpansion\x12N\n" +
+	"\x10decentralization\x18\r \x01(\v2\".sf.cardano.type.v1.RationalNumberR\x10decentralization\x12(\n" +
+	"\rextra_entropy\x18\x0e \x01(\fH\tR\fextraEntropy\x88\x01\x01\x12N\n" +
+	"\x10protocol_version\x18\x0f \x01(\v2#.sf.cardano.type.v1.ProtocolVersionR\x0fprotocolVersion\x12)\n" +
+	"\x0emin_utxo_value\x18\x10 \x01(\x04H\n" +
+	"R\fminUtxoValue\x88\x01\x01\x12'\n" +
+	"\rmin_pool_cost\x18\x11 \x01(\x04H\vR\vminPoolCost\x88\x01\x01\x122\n" +
+	"\x13coins_per_utxo_word\x18\x12 \x01(\x04H\fR\x10coinsPerUtxoWord\x88\x01\x01\x122\n" +
+	"\x13coins_per_utxo_byte\x18\x13 \x01(\x04H\rR\x10coinsPerUtxoByte\x88\x01\x01\x12?\n" +
+	"\vcost_models\x18\x14 \x01(\v2\x1e.sf.cardano.type.v1.CostModelsR\n" +
+	"costModels\x124\n" +
+	"\x06prices\x18\x15 \x01(\v2\x1c.sf.cardano.type.v1.ExPricesR\x06prices\x12i\n" +
+	"#max_execution_units_per_transaction\x18\x16 \x01(\v2\x1b.sf.cardano.type.v1.ExUnitsR\x1fmaxExecutionUnitsPerTransaction\x12]\n" +
+	"\x1dmax_execution_units_per_block\x18\x17 \x01(\v2\x1b.sf.cardano.type.v1.ExUnitsR\x19maxExecutionUnitsPerBlock\x12)\n" +
+	"\x0emax_value_size\x18\x18 \x01(\x04H\x0eR\fmaxValueSize\x88\x01\x01\x128\n" +
+	"\x15collateral_percentage\x18\x19 \x01(\x04H\x0fR\x14collateralPercentage\x88\x01\x01\x127\n" +
+	"\x15max_collateral_inputs\x18\x1a \x01(\x04H\x10R\x13maxCollateralInputs\x88\x01\x01\x12Z\n" +
+	"\x16pool_voting_thresholds\x18\x1b \x01(\v2$.sf.cardano.type.v1.VotingThresholdsR\x14poolVotingThresholds\x12Z\n" +
+	"\x16drep_voting_thresholds\x18\x1c \x01(\v2$.sf.cardano.type.v1.VotingThresholdsR\x14drepVotingThresholds\x121\n" +
+	"\x12min_committee_size\x18\x1d \x01(\rH\x11R\x10minCommitteeSize\x88\x01\x01\x125\n" +
+	"\x14committee_term_limit\x18\x1e \x01(\x04H\x12R\x12committeeTermLimit\x88\x01\x01\x12N\n" +
+	"!governance_action_validity_period\x18\x1f \x01(\x04H\x13R\x1egovernanceActionValidityPeriod\x88\x01\x01\x12?\n" +
+	"\x19governance_action_deposit\x18  \x01(\x04H\x14R\x17governanceActionDeposit\x88\x01\x01\x12&\n" +
+	"\fdrep_deposit\x18! \x01(\x04H\x15R\vdrepDeposit\x88\x01\x01\x129\n" +
+	"\x16drep_inactivity_period\x18\" \x01(\x04H\x16R\x14drepInactivityPeriod\x88\x01\x01\x12h\n" +
+	" min_fee_script_ref_cost_per_byte\x18# \x01(\v2\".sf.cardano.type.v1.RationalNumberR\x1aminFeeScriptRefCostPerByteB\x16\n" +
+	"\x14_min_fee_coefficientB\x13\n" +
+	"\x11_min_fee_constantB\x16\n" +
+	"\x14_max_block_body_sizeB\x0e\n" +
+	"\f_max_tx_sizeB\x18\n" +
+	"\x16_max_block_header_sizeB\x14\n" +
+	"\x12_stake_key_depositB\x0f\n" +
+	"\r_pool_depositB\x1e\n" +
+	"\x1c_pool_retirement_epoch_boundB\x1a\n" +
+	"\x18_desired_number_of_poolsB\x10\n" +
+	"\x0e_extra_entropyB\x11\n" +
+	"\x0f_min_utxo_valueB\x10\n" +
+	"\x0e_min_pool_costB\x16\n" +
+	"\x14_coins_per_utxo_wordB\x16\n" +
+	"\x14_coins_per_utxo_byteB\x11\n" +
+	"\x0f_max_value_sizeB\x18\n" +
+	"\x16_collateral_percentageB\x18\n" +
+	"\x16_max_collateral_inputsB\x15\n" +
+	"\x13_min_committee_sizeB\x17\n" +
+	"\x15_committee_term_limitB$\n" +
+	"\"_governance_action_validity_periodB\x1c\n" +
+	"\x1a_governance_action_depositB\x0f\n" +
+	"\r_drep_depositB\x19\n" +
+	"\x17_drep_inactivity_period\"\xd4\x01\n" +
 	"\x18GovernanceActionProposal\x12\x18\n" +
 	"\adeposit\x18\x01 \x01(\x04R\adeposit\x12%\n" +
 	"\x0ereward_account\x18\x02 \x01(\fR\rrewardAccount\x12C\n" +
@@ -7444,12 +11322,21 @@ const file_sf_cardano_type_v1_type_proto_rawDesc = "" +
 	"\x11governance_action\"s\n" +
 	"\x12GovernanceActionId\x12%\n" +
 	"\x0etransaction_id\x18\x01 \x01(\fR\rtransactionId\x126\n" +
-	"\x17governance_action_index\x18\x02 \x01(\rR\x15governanceActionIndex\"\xd5\x01\n" +
+	"\x17governance_action_index\x18\x02 \x01(\rR\x15governanceActionIndex\"N\n" +
+	"\x05Voter\x121\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1d.sf.cardano.type.v1.VoterTypeR\x04type\x12\x12\n" +
+	"\x04hash\x18\x02 \x01(\fR\x04hash\"\xf0\x01\n" +
+	"\x0fVotingProcedure\x12/\n" +
+	"\x05voter\x18\x01 \x01(\v2\x19.sf.cardano.type.v1.VoterR\x05voter\x12J\n" +
+	"\rgov_action_id\x18\x02 \x01(\v2&.sf.cardano.type.v1.GovernanceActionIdR\vgovActionId\x12,\n" +
+	"\x04vote\x18\x03 \x01(\x0e2\x18.sf.cardano.type.v1.VoteR\x04vote\x122\n" +
+	"\x06anchor\x18\x04 \x01(\v2\x1a.sf.cardano.type.v1.AnchorR\x06anchor\"\x90\x02\n" +
 	"\x15ParameterChangeAction\x12J\n" +
 	"\rgov_action_id\x18\x01 \x01(\v2&.sf.cardano.type.v1.GovernanceActionIdR\vgovActionId\x12O\n" +
 	"\x15protocol_param_update\x18\x02 \x01(\v2\x1b.sf.cardano.type.v1.PParamsR\x13protocolParamUpdate\x12\x1f\n" +
 	"\vpolicy_hash\x18\x03 \x01(\fR\n" +
-	"policyHash\"\xb6\x01\n" +
+	"policyHash\x129\n" +
+	"\x06update\x18\x04 \x01(\v2!.sf.cardano.type.v1.PParamsUpdateR\x06update\"\xb6\x01\n" +
 	"\x18HardForkInitiationAction\x12J\n" +
 	"\rgov_action_id\x18\x01 \x01(\v2&.sf.cardano.type.v1.GovernanceActionIdR\vgovActionId\x12N\n" +
 	"\x10protocol_version\x18\x02 \x01(\v2#.sf.cardano.type.v1.ProtocolVersionR\x0fprotocolVersion\"\x84\x01\n" +
@@ -7481,11 +11368,170 @@ const file_sf_cardano_type_v1_type_proto_rawDesc = "" +
 	"\x04hash\x18\x02 \x01(\fR\x04hash\x12\x16\n" +
 	"\x06height\x18\x03 \x01(\x04R\x06height\"3\n" +
 	"\tBlockBody\x12&\n" +
-	"\x02tx\x18\x01 \x03(\v2\x16.sf.cardano.type.v1.TxR\x02tx\"\x91\x01\n" +
+	"\x02tx\x18\x01 \x03(\v2\x16.sf.cardano.type.v1.TxR\x02tx\"\xf5\x04\n" +
 	"\x05Block\x127\n" +
 	"\x06header\x18\x01 \x01(\v2\x1f.sf.cardano.type.v1.BlockHeaderR\x06header\x121\n" +
 	"\x04body\x18\x02 \x01(\v2\x1d.sf.cardano.type.v1.BlockBodyR\x04body\x12\x1c\n" +
-	"\ttimestamp\x18\x03 \x01(\x04R\ttimestamp\"?\n" +
+	"\ttimestamp\x18\x03 \x01(\x04R\ttimestamp\x12#\n" +
+	"\roriginal_cbor\x18\x04 \x01(\fR\foriginalCbor\x124\n" +
+	"\x05stats\x18\x05 \x01(\v2\x1e.sf.cardano.type.v1.BlockStatsR\x05stats\x124\n" +
+	"\x05byron\x18\x06 \x01(\v2\x1e.sf.cardano.type.v1.ByronBlockR\x05byron\x12D\n" +
+	"\x0fprotocol_params\x18\a \x01(\v2\x1b.sf.cardano.type.v1.PParamsR\x0eprotocolParams\x120\n" +
+	"\x03era\x18\b \x01(\v2\x1e.sf.cardano.type.v1.EraSummaryR\x03era\x12N\n" +
+	"\x10epoch_transition\x18\t \x01(\v2#.sf.cardano.type.v1.EpochTransitionR\x0fepochTransition\x12K\n" +
+	"\x0fledger_snapshot\x18\n" +
+	" \x01(\v2\".sf.cardano.type.v1.LedgerSnapshotR\x0eledgerSnapshot\x12<\n" +
+	"\x1aprotocol_params_unreliable\x18\v \x01(\bR\x18protocolParamsUnreliable\"\x9b\x05\n" +
+	"\n" +
+	"ByronBlock\x12%\n" +
+	"\x0eepoch_boundary\x18\x01 \x01(\bR\repochBoundary\x12%\n" +
+	"\x0eprotocol_magic\x18\x02 \x01(\rR\rprotocolMagic\x12\x1b\n" +
+	"\tprev_hash\x18\x03 \x01(\fR\bprevHash\x12\x14\n" +
+	"\x05epoch\x18\x04 \x01(\x04R\x05epoch\x12\"\n" +
+	"\rslot_in_epoch\x18\x05 \x01(\rR\vslotInEpoch\x12\x1d\n" +
+	"\n" +
+	"issuer_key\x18\x06 \x01(\fR\tissuerKey\x12J\n" +
+	"\rblock_version\x18\a \x01(\v2%.sf.cardano.type.v1.ByronBlockVersionR\fblockVersion\x12S\n" +
+	"\x10software_version\x18\b \x01(\v2(.sf.cardano.type.v1.ByronSoftwareVersionR\x0fsoftwareVersion\x12g\n" +
+	"\x17delegation_certificates\x18\t \x03(\v2..sf.cardano.type.v1.ByronDelegationCertificateR\x16delegationCertificates\x12R\n" +
+	"\x10update_proposals\x18\n" +
+	" \x03(\v2'.sf.cardano.type.v1.ByronUpdateProposalR\x0fupdateProposals\x12F\n" +
+	"\fupdate_votes\x18\v \x03(\v2#.sf.cardano.type.v1.ByronUpdateVoteR\vupdateVotes\x12#\n" +
+	"\repoch_leaders\x18\f \x03(\fR\fepochLeaders\"Q\n" +
+	"\x11ByronBlockVersion\x12\x14\n" +
+	"\x05major\x18\x01 \x01(\rR\x05major\x12\x14\n" +
+	"\x05minor\x18\x02 \x01(\rR\x05minor\x12\x10\n" +
+	"\x03alt\x18\x03 \x01(\rR\x03alt\"[\n" +
+	"\x14ByronSoftwareVersion\x12)\n" +
+	"\x10application_name\x18\x01 \x01(\tR\x0fapplicationName\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\rR\aversion\"\x92\x01\n" +
+	"\x1aByronDelegationCertificate\x12\x14\n" +
+	"\x05epoch\x18\x01 \x01(\x04R\x05epoch\x12\x1d\n" +
+	"\n" +
+	"issuer_key\x18\x02 \x01(\fR\tissuerKey\x12!\n" +
+	"\fdelegate_key\x18\x03 \x01(\fR\vdelegateKey\x12\x1c\n" +
+	"\tsignature\x18\x04 \x01(\fR\tsignature\"\xb3\x03\n" +
+	"\x13ByronUpdateProposal\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\fR\x02id\x12J\n" +
+	"\rblock_version\x18\x02 \x01(\v2%.sf.cardano.type.v1.ByronBlockVersionR\fblockVersion\x12o\n" +
+	"\x1ablock_version_modification\x18\x03 \x01(\v21.sf.cardano.type.v1.ByronBlockVersionModificationR\x18blockVersionModification\x12S\n" +
+	"\x10software_version\x18\x04 \x01(\v2(.sf.cardano.type.v1.ByronSoftwareVersionR\x0fsoftwareVersion\x129\n" +
+	"\x04data\x18\x05 \x03(\v2%.sf.cardano.type.v1.ByronSystemUpdateR\x04data\x12!\n" +
+	"\fproposer_key\x18\x06 \x01(\fR\vproposerKey\x12\x1c\n" +
+	"\tsignature\x18\a \x01(\fR\tsignature\"\xaa\a\n" +
+	"\x1dByronBlockVersionModification\x12*\n" +
+	"\x0escript_version\x18\x01 \x01(\rH\x00R\rscriptVersion\x88\x01\x01\x12(\n" +
+	"\rslot_duration\x18\x02 \x01(\x04H\x01R\fslotDuration\x88\x01\x01\x12)\n" +
+	"\x0emax_block_size\x18\x03 \x01(\x04H\x02R\fmaxBlockSize\x88\x01\x01\x12+\n" +
+	"\x0fmax_header_size\x18\x04 \x01(\x04H\x03R\rmaxHeaderSize\x88\x01\x01\x12#\n" +
+	"\vmax_tx_size\x18\x05 \x01(\x04H\x04R\tmaxTxSize\x88\x01\x01\x12/\n" +
+	"\x11max_proposal_size\x18\x06 \x01(\x04H\x05R\x0fmaxProposalSize\x88\x01\x01\x12\x1c\n" +
+	"\ampc_thd\x18\a \x01(\x04H\x06R\x06mpcThd\x88\x01\x01\x12'\n" +
+	"\rheavy_del_thd\x18\b \x01(\x04H\aR\vheavyDelThd\x88\x01\x01\x12+\n" +
+	"\x0fupdate_vote_thd\x18\t \x01(\x04H\bR\rupdateVoteThd\x88\x01\x01\x123\n" +
+	"\x13update_proposal_thd\x18\n" +
+	" \x01(\x04H\tR\x11updateProposalThd\x88\x01\x01\x12,\n" +
+	"\x0fupdate_implicit\x18\v \x01(\x04H\n" +
+	"R\x0eupdateImplicit\x88\x01\x01\x12J\n" +
+	"\rsoftfork_rule\x18\f \x01(\v2%.sf.cardano.type.v1.ByronSoftforkRuleR\fsoftforkRule\x12H\n" +
+	"\rtx_fee_policy\x18\r \x01(\v2$.sf.cardano.type.v1.ByronTxFeePolicyR\vtxFeePolicy\x121\n" +
+	"\x12unlock_stake_epoch\x18\x0e \x01(\x04H\vR\x10unlockStakeEpoch\x88\x01\x01B\x11\n" +
+	"\x0f_script_versionB\x10\n" +
+	"\x0e_slot_durationB\x11\n" +
+	"\x0f_max_block_sizeB\x12\n" +
+	"\x10_max_header_sizeB\x0e\n" +
+	"\f_max_tx_sizeB\x14\n" +
+	"\x12_max_proposal_sizeB\n" +
+	"\n" +
+	"\b_mpc_thdB\x10\n" +
+	"\x0e_heavy_del_thdB\x12\n" +
+	"\x10_update_vote_thdB\x16\n" +
+	"\x14_update_proposal_thdB\x12\n" +
+	"\x10_update_implicitB\x15\n" +
+	"\x13_unlock_stake_epoch\"l\n" +
+	"\x11ByronSoftforkRule\x12\x19\n" +
+	"\binit_thd\x18\x01 \x01(\x04R\ainitThd\x12\x17\n" +
+	"\amin_thd\x18\x02 \x01(\x04R\x06minThd\x12#\n" +
+	"\rthd_decrement\x18\x03 \x01(\x04R\fthdDecrement\"L\n" +
+	"\x10ByronTxFeePolicy\x12\x18\n" +
+	"\asummand\x18\x01 \x01(\x04R\asummand\x12\x1e\n" +
+	"\n" +
+	"multiplier\x18\x02 \x01(\x04R\n" +
+	"multiplier\"\xb9\x01\n" +
+	"\x11ByronSystemUpdate\x12\x1d\n" +
+	"\n" +
+	"system_tag\x18\x01 \x01(\tR\tsystemTag\x12\"\n" +
+	"\rapp_diff_hash\x18\x02 \x01(\fR\vappDiffHash\x12\x19\n" +
+	"\bpkg_hash\x18\x03 \x01(\fR\apkgHash\x12!\n" +
+	"\fupdater_hash\x18\x04 \x01(\fR\vupdaterHash\x12#\n" +
+	"\rmetadata_hash\x18\x05 \x01(\fR\fmetadataHash\"\x87\x01\n" +
+	"\x0fByronUpdateVote\x12\x1b\n" +
+	"\tvoter_key\x18\x01 \x01(\fR\bvoterKey\x12\x1f\n" +
+	"\vproposal_id\x18\x02 \x01(\fR\n" +
+	"proposalId\x12\x18\n" +
+	"\aapprove\x18\x03 \x01(\bR\aapprove\x12\x1c\n" +
+	"\tsignature\x18\x04 \x01(\fR\tsignature\"\x9b\x01\n" +
+	"\fByronAddress\x12\x12\n" +
+	"\x04root\x18\x01 \x01(\fR\x04root\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\rR\x04type\x12'\n" +
+	"\x0fderivation_path\x18\x03 \x01(\fR\x0ederivationPath\x12(\n" +
+	"\rnetwork_magic\x18\x04 \x01(\rH\x00R\fnetworkMagic\x88\x01\x01B\x10\n" +
+	"\x0e_network_magic\"\x91\x04\n" +
+	"\n" +
+	"BlockStats\x12\x19\n" +
+	"\btx_count\x18\x01 \x01(\x04R\atxCount\x12(\n" +
+	"\x10invalid_tx_count\x18\x02 \x01(\x04R\x0einvalidTxCount\x12\x12\n" +
+	"\x04fees\x18\x03 \x01(\x04R\x04fees\x12'\n" +
+	"\x0foutput_lovelace\x18\x04 \x01(\x04R\x0eoutputLovelace\x12!\n" +
+	"\fscript_count\x18\x05 \x01(\x04R\vscriptCount\x12%\n" +
+	"\x0eredeemer_count\x18\x06 \x01(\x04R\rredeemerCount\x126\n" +
+	"\bex_units\x18\a \x01(\v2\x1b.sf.cardano.type.v1.ExUnitsR\aexUnits\x12T\n" +
+	"\fcertificates\x18\b \x03(\v20.sf.cardano.type.v1.BlockStats.CertificatesEntryR\fcertificates\x12\x1d\n" +
+	"\n" +
+	"mint_count\x18\t \x01(\x04R\tmintCount\x12\x1d\n" +
+	"\n" +
+	"burn_count\x18\n" +
+	" \x01(\x04R\tburnCount\x12*\n" +
+	"\x11unknown_fee_count\x18\v \x01(\x04R\x0funknownFeeCount\x1a?\n" +
+	"\x11CertificatesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x04R\x05value:\x028\x01\"\xfb\x01\n" +
+	"\x0fEpochTransition\x12%\n" +
+	"\x0eprevious_epoch\x18\x01 \x01(\x04R\rpreviousEpoch\x12\x14\n" +
+	"\x05epoch\x18\x02 \x01(\x04R\x05epoch\x12\x1d\n" +
+	"\n" +
+	"first_slot\x18\x03 \x01(\x04R\tfirstSlot\x12;\n" +
+	"\x1aprevious_epoch_block_count\x18\x04 \x01(\x04R\x17previousEpochBlockCount\x125\n" +
+	"\x17previous_epoch_tx_count\x18\x05 \x01(\x04R\x14previousEpochTxCount\x12\x18\n" +
+	"\apartial\x18\x06 \x01(\bR\apartial\"\xd4\x03\n" +
+	"\x0eLedgerSnapshot\x12\x14\n" +
+	"\x05epoch\x18\x01 \x01(\x04R\x05epoch\x12A\n" +
+	"\vera_history\x18\x02 \x01(\v2 .sf.cardano.type.v1.EraSummariesR\n" +
+	"eraHistory\x12D\n" +
+	"\x0fprotocol_params\x18\x03 \x01(\v2\x1b.sf.cardano.type.v1.PParamsR\x0eprotocolParams\x12L\n" +
+	"\x12stake_distribution\x18\x04 \x03(\v2\x1d.sf.cardano.type.v1.PoolStakeR\x11stakeDistribution\x124\n" +
+	"\x05pools\x18\x05 \x03(\v2\x1e.sf.cardano.type.v1.PoolParamsR\x05pools\x12\x1f\n" +
+	"\btreasury\x18\x06 \x01(\x04H\x00R\btreasury\x88\x01\x01\x12\x1f\n" +
+	"\breserves\x18\a \x01(\x04H\x01R\breserves\x88\x01\x01\x12C\n" +
+	"\n" +
+	"governance\x18\b \x01(\v2#.sf.cardano.type.v1.GovernanceStateR\n" +
+	"governanceB\v\n" +
+	"\t_treasuryB\v\n" +
+	"\t_reserves\"\xf2\x01\n" +
+	"\x0fGovernanceState\x12D\n" +
+	"\fconstitution\x18\x01 \x01(\v2 .sf.cardano.type.v1.ConstitutionR\fconstitution\x12S\n" +
+	"\x13committee_threshold\x18\x02 \x01(\v2\".sf.cardano.type.v1.RationalNumberR\x12committeeThreshold\x12D\n" +
+	"\tproposals\x18\x03 \x03(\v2&.sf.cardano.type.v1.GovernanceActionIdR\tproposals\"\x9b\x01\n" +
+	"\tPoolStake\x12\x17\n" +
+	"\apool_id\x18\x01 \x01(\fR\x06poolId\x12'\n" +
+	"\x0fstake_numerator\x18\x02 \x01(\x04R\x0estakeNumerator\x12+\n" +
+	"\x11stake_denominator\x18\x03 \x01(\x04R\x10stakeDenominator\x12\x1f\n" +
+	"\vvrf_keyhash\x18\x04 \x01(\fR\n" +
+	"vrfKeyhash\"g\n" +
+	"\n" +
+	"PoolParams\x12\x17\n" +
+	"\apool_id\x18\x01 \x01(\fR\x06poolId\x12@\n" +
+	"\x06params\x18\x02 \x01(\v2(.sf.cardano.type.v1.PoolRegistrationCertR\x06params\"?\n" +
 	"\vVKeyWitness\x12\x12\n" +
 	"\x04vkey\x18\x01 \x01(\fR\x04vkey\x12\x1c\n" +
 	"\tsignature\x18\x02 \x01(\fR\tsignature\"\xf1\x02\n" +
@@ -7529,19 +11575,28 @@ const file_sf_cardano_type_v1_type_proto_rawDesc = "" +
 	"\rPlutusDataMap\x128\n" +
 	"\x05pairs\x18\x01 \x03(\v2\".sf.cardano.type.v1.PlutusDataPairR\x05pairs\"G\n" +
 	"\x0fPlutusDataArray\x124\n" +
-	"\x05items\x18\x01 \x03(\v2\x1e.sf.cardano.type.v1.PlutusDataR\x05items\"\xab\x01\n" +
+	"\x05items\x18\x01 \x03(\v2\x1e.sf.cardano.type.v1.PlutusDataR\x05items\"\xbf\x01\n" +
 	"\x06Script\x12:\n" +
 	"\x06native\x18\x01 \x01(\v2 .sf.cardano.type.v1.NativeScriptH\x00R\x06native\x12\x1d\n" +
 	"\tplutus_v1\x18\x02 \x01(\fH\x00R\bplutusV1\x12\x1d\n" +
 	"\tplutus_v2\x18\x03 \x01(\fH\x00R\bplutusV2\x12\x1d\n" +
-	"\tplutus_v3\x18\x04 \x01(\fH\x00R\bplutusV3B\b\n" +
-	"\x06script\"\xcc\x01\n" +
+	"\tplutus_v3\x18\x04 \x01(\fH\x00R\bplutusV3\x12\x12\n" +
+	"\x04hash\x18\x05 \x01(\fR\x04hashB\b\n" +
+	"\x06script\"\x8e\x02\n" +
+	"\x0eExecutedScript\x12\x12\n" +
+	"\x04hash\x18\x01 \x01(\fR\x04hash\x12>\n" +
+	"\blanguage\x18\x02 \x01(\x0e2\".sf.cardano.type.v1.ScriptLanguageR\blanguage\x122\n" +
+	"\x06script\x18\x03 \x01(\v2\x1a.sf.cardano.type.v1.ScriptR\x06script\x128\n" +
+	"\x06origin\x18\x04 \x01(\x0e2 .sf.cardano.type.v1.ScriptOriginR\x06origin\x12\x17\n" +
+	"\atx_hash\x18\x05 \x01(\fR\x06txHash\x12!\n" +
+	"\foutput_index\x18\x06 \x01(\rR\voutputIndex\"\x83\x02\n" +
 	"\tMetadatum\x12\x12\n" +
 	"\x03int\x18\x01 \x01(\x03H\x00R\x03int\x12\x16\n" +
 	"\x05bytes\x18\x02 \x01(\fH\x00R\x05bytes\x12\x14\n" +
 	"\x04text\x18\x03 \x01(\tH\x00R\x04text\x12:\n" +
 	"\x05array\x18\x04 \x01(\v2\".sf.cardano.type.v1.MetadatumArrayH\x00R\x05array\x124\n" +
-	"\x03map\x18\x05 \x01(\v2 .sf.cardano.type.v1.MetadatumMapH\x00R\x03mapB\v\n" +
+	"\x03map\x18\x05 \x01(\v2 .sf.cardano.type.v1.MetadatumMapH\x00R\x03map\x125\n" +
+	"\abig_int\x18\x06 \x01(\v2\x1a.sf.cardano.type.v1.BigIntH\x00R\x06bigIntB\v\n" +
 	"\tmetadatum\"E\n" +
 	"\x0eMetadatumArray\x123\n" +
 	"\x05items\x18\x01 \x03(\v2\x1d.sf.cardano.type.v1.MetadatumR\x05items\"G\n" +
@@ -7552,7 +11607,99 @@ const file_sf_cardano_type_v1_type_proto_rawDesc = "" +
 	"\x05value\x18\x02 \x01(\v2\x1d.sf.cardano.type.v1.MetadatumR\x05value\"U\n" +
 	"\bMetadata\x12\x14\n" +
 	"\x05label\x18\x01 \x01(\x04R\x05label\x123\n" +
-	"\x05value\x18\x02 \x01(\v2\x1d.sf.cardano.type.v1.MetadatumR\x05value\"n\n" +
+	"\x05value\x18\x02 \x01(\v2\x1d.sf.cardano.type.v1.MetadatumR\x05value\"\x92\x02\n" +
+	"\x0fDecodedMetadata\x12\x14\n" +
+	"\x05label\x18\x01 \x01(\x04R\x05label\x129\n" +
+	"\x05cip25\x18\x02 \x01(\v2!.sf.cardano.type.v1.Cip25MetadataH\x00R\x05cip25\x128\n" +
+	"\x05cip20\x18\x03 \x01(\v2 .sf.cardano.type.v1.Cip20MessageH\x00R\x05cip20\x12=\n" +
+	"\x05cip36\x18\x04 \x01(\v2%.sf.cardano.type.v1.Cip36RegistrationH\x00R\x05cip36\x12+\n" +
+	"\x11validation_errors\x18\x05 \x03(\tR\x10validationErrorsB\b\n" +
+	"\x06record\"a\n" +
+	"\rCip25Metadata\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\rR\aversion\x126\n" +
+	"\x06assets\x18\x02 \x03(\v2\x1e.sf.cardano.type.v1.Cip25AssetR\x06assets\"\xab\x02\n" +
+	"\n" +
+	"Cip25Asset\x12\x1b\n" +
+	"\tpolicy_id\x18\x01 \x01(\fR\bpolicyId\x12\x1d\n" +
+	"\n" +
+	"asset_name\x18\x02 \x01(\fR\tassetName\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x14\n" +
+	"\x05image\x18\x04 \x01(\tR\x05image\x12\x1d\n" +
+	"\n" +
+	"media_type\x18\x05 \x01(\tR\tmediaType\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\x123\n" +
+	"\x05files\x18\a \x03(\v2\x1d.sf.cardano.type.v1.Cip25FileR\x05files\x12A\n" +
+	"\n" +
+	"properties\x18\b \x03(\v2!.sf.cardano.type.v1.MetadatumPairR\n" +
+	"properties\"\x93\x01\n" +
+	"\tCip25File\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"media_type\x18\x02 \x01(\tR\tmediaType\x12\x10\n" +
+	"\x03src\x18\x03 \x01(\tR\x03src\x12A\n" +
+	"\n" +
+	"properties\x18\x04 \x03(\v2!.sf.cardano.type.v1.MetadatumPairR\n" +
+	"properties\"$\n" +
+	"\fCip20Message\x12\x14\n" +
+	"\x05lines\x18\x01 \x03(\tR\x05lines\"\xa7\x02\n" +
+	"\x11Cip36Registration\x12E\n" +
+	"\vdelegations\x18\x01 \x03(\v2#.sf.cardano.type.v1.Cip36DelegationR\vdelegations\x12*\n" +
+	"\x11legacy_voting_key\x18\x02 \x01(\bR\x0flegacyVotingKey\x12\x1b\n" +
+	"\tstake_key\x18\x03 \x01(\fR\bstakeKey\x12'\n" +
+	"\x0fpayment_address\x18\x04 \x01(\fR\x0epaymentAddress\x12\x14\n" +
+	"\x05nonce\x18\x05 \x01(\x04R\x05nonce\x12%\n" +
+	"\x0evoting_purpose\x18\x06 \x01(\x04R\rvotingPurpose\x12\x1c\n" +
+	"\tsignature\x18\a \x01(\fR\tsignature\"H\n" +
+	"\x0fCip36Delegation\x12\x1d\n" +
+	"\n" +
+	"voting_key\x18\x01 \x01(\fR\tvotingKey\x12\x16\n" +
+	"\x06weight\x18\x02 \x01(\rR\x06weight\"\xd4\x01\n" +
+	"\n" +
+	"Cip68Token\x12\x1b\n" +
+	"\tpolicy_id\x18\x01 \x01(\fR\bpolicyId\x12\x1d\n" +
+	"\n" +
+	"asset_name\x18\x02 \x01(\fR\tassetName\x12\x14\n" +
+	"\x05label\x18\x03 \x01(\rR\x05label\x120\n" +
+	"\x14reference_asset_name\x18\x04 \x01(\fR\x12referenceAssetName\x12\x1b\n" +
+	"\tmint_coin\x18\x05 \x01(\x03R\bmintCoin\x12%\n" +
+	"\x0eoutput_indexes\x18\x06 \x03(\rR\routputIndexes\"\xb8\x02\n" +
+	"\x13Cip68MetadataUpdate\x12\x1b\n" +
+	"\tpolicy_id\x18\x01 \x01(\fR\bpolicyId\x12\x1d\n" +
+	"\n" +
+	"asset_name\x18\x02 \x01(\fR\tassetName\x12!\n" +
+	"\foutput_index\x18\x03 \x01(\rR\voutputIndex\x12\x1f\n" +
+	"\vtoken_label\x18\x04 \x01(\rR\n" +
+	"tokenLabel\x12\x16\n" +
+	"\x06minted\x18\x05 \x01(\bR\x06minted\x12\x1d\n" +
+	"\n" +
+	"datum_hash\x18\x06 \x01(\fR\tdatumHash\x12=\n" +
+	"\bmetadata\x18\a \x01(\v2!.sf.cardano.type.v1.Cip68MetadataR\bmetadata\x12+\n" +
+	"\x11validation_errors\x18\b \x03(\tR\x10validationErrors\"\x9d\x03\n" +
+	"\rCip68Metadata\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x04R\aversion\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05image\x18\x03 \x01(\tR\x05image\x12\x1d\n" +
+	"\n" +
+	"media_type\x18\x04 \x01(\tR\tmediaType\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x123\n" +
+	"\x05files\x18\x06 \x03(\v2\x1d.sf.cardano.type.v1.Cip68FileR\x05files\x12\x16\n" +
+	"\x06ticker\x18\a \x01(\tR\x06ticker\x12\x10\n" +
+	"\x03url\x18\b \x01(\tR\x03url\x12\x12\n" +
+	"\x04logo\x18\t \x01(\tR\x04logo\x12\x1a\n" +
+	"\bdecimals\x18\n" +
+	" \x01(\x04R\bdecimals\x12B\n" +
+	"\n" +
+	"properties\x18\v \x03(\v2\".sf.cardano.type.v1.PlutusDataPairR\n" +
+	"properties\x124\n" +
+	"\x05extra\x18\f \x01(\v2\x1e.sf.cardano.type.v1.PlutusDataR\x05extra\"\x94\x01\n" +
+	"\tCip68File\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"media_type\x18\x02 \x01(\tR\tmediaType\x12\x10\n" +
+	"\x03src\x18\x03 \x01(\tR\x03src\x12B\n" +
+	"\n" +
+	"properties\x18\x04 \x03(\v2\".sf.cardano.type.v1.PlutusDataPairR\n" +
+	"properties\"n\n" +
 	"\x0fStakeCredential\x12$\n" +
 	"\raddr_key_hash\x18\x01 \x01(\fH\x00R\vaddrKeyHash\x12!\n" +
 	"\vscript_hash\x18\x02 \x01(\fH\x00R\n" +
@@ -7715,7 +11862,7 @@ const file_sf_cardano_type_v1_type_proto_rawDesc = "" +
 	"\x10VotingThresholds\x12B\n" +
 	"\n" +
 	"thresholds\x18\x01 \x03(\v2\".sf.cardano.type.v1.RationalNumberR\n" +
-	"thresholds\"\xf0\x0e\n" +
+	"thresholds\"\xba\x10\n" +
 	"\aPParams\x12-\n" +
 	"\x13coins_per_utxo_byte\x18\x01 \x01(\x04R\x10coinsPerUtxoByte\x12\x1e\n" +
 	"\vmax_tx_size\x18\x02 \x01(\x04R\tmaxTxSize\x12.\n" +
@@ -7749,7 +11896,11 @@ const file_sf_cardano_type_v1_type_proto_rawDesc = "" +
 	"!governance_action_validity_period\x18\x1c \x01(\x04R\x1egovernanceActionValidityPeriod\x12:\n" +
 	"\x19governance_action_deposit\x18\x1d \x01(\x04R\x17governanceActionDeposit\x12!\n" +
 	"\fdrep_deposit\x18\x1e \x01(\x04R\vdrepDeposit\x124\n" +
-	"\x16drep_inactivity_period\x18\x1f \x01(\x04R\x14drepInactivityPeriod\"K\n" +
+	"\x16drep_inactivity_period\x18\x1f \x01(\x04R\x14drepInactivityPeriod\x12N\n" +
+	"\x10decentralization\x18  \x01(\v2\".sf.cardano.type.v1.RationalNumberR\x10decentralization\x12#\n" +
+	"\rextra_entropy\x18! \x01(\fR\fextraEntropy\x12$\n" +
+	"\x0emin_utxo_value\x18\" \x01(\x04R\fminUtxoValue\x12-\n" +
+	"\x13coins_per_utxo_word\x18# \x01(\x04R\x10coinsPerUtxoWord\"K\n" +
 	"\vEraBoundary\x12\x12\n" +
 	"\x04time\x18\x01 \x01(\x04R\x04time\x12\x12\n" +
 	"\x04slot\x18\x02 \x01(\x04R\x04slot\x12\x14\n" +
@@ -7926,7 +12077,31 @@ const file_sf_cardano_type_v1_type_proto_rawDesc = "" +
 	"\x15REDEEMER_PURPOSE_CERT\x10\x03\x12\x1b\n" +
 	"\x17REDEEMER_PURPOSE_REWARD\x10\x04\x12\x19\n" +
 	"\x15REDEEMER_PURPOSE_VOTE\x10\x05\x12\x1c\n" +
-	"\x18REDEEMER_PURPOSE_PROPOSE\x10\x06*Y\n" +
+	"\x18REDEEMER_PURPOSE_PROPOSE\x10\x06*\xf9\x01\n" +
+	"\tVoterType\x12\x1a\n" +
+	"\x16VOTER_TYPE_UNSPECIFIED\x10\x00\x124\n" +
+	"0VOTER_TYPE_CONSTITUTIONAL_COMMITTEE_HOT_KEY_HASH\x10\x01\x127\n" +
+	"3VOTER_TYPE_CONSTITUTIONAL_COMMITTEE_HOT_SCRIPT_HASH\x10\x02\x12\x1c\n" +
+	"\x18VOTER_TYPE_DREP_KEY_HASH\x10\x03\x12\x1f\n" +
+	"\x1bVOTER_TYPE_DREP_SCRIPT_HASH\x10\x04\x12\"\n" +
+	"\x1eVOTER_TYPE_STAKE_POOL_KEY_HASH\x10\x05*I\n" +
+	"\x04Vote\x12\x14\n" +
+	"\x10VOTE_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aVOTE_NO\x10\x01\x12\f\n" +
+	"\bVOTE_YES\x10\x02\x12\x10\n" +
+	"\fVOTE_ABSTAIN\x10\x03*\xaa\x01\n" +
+	"\x0eScriptLanguage\x12\x1f\n" +
+	"\x1bSCRIPT_LANGUAGE_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16SCRIPT_LANGUAGE_NATIVE\x10\x01\x12\x1d\n" +
+	"\x19SCRIPT_LANGUAGE_PLUTUS_V1\x10\x02\x12\x1d\n" +
+	"\x19SCRIPT_LANGUAGE_PLUTUS_V2\x10\x03\x12\x1d\n" +
+	"\x19SCRIPT_LANGUAGE_PLUTUS_V3\x10\x04*\xa4\x01\n" +
+	"\fScriptOrigin\x12\x1d\n" +
+	"\x19SCRIPT_ORIGIN_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19SCRIPT_ORIGIN_WITNESS_SET\x10\x01\x12!\n" +
+	"\x1dSCRIPT_ORIGIN_REFERENCE_INPUT\x10\x02\x12\x17\n" +
+	"\x13SCRIPT_ORIGIN_INPUT\x10\x03\x12\x1a\n" +
+	"\x16SCRIPT_ORIGIN_REGISTRY\x10\x04*Y\n" +
 	"\tMirSource\x12\x1a\n" +
 	"\x16MIR_SOURCE_UNSPECIFIED\x10\x00\x12\x17\n" +
 	"\x13MIR_SOURCE_RESERVES\x10\x01\x12\x17\n" +
@@ -7944,325 +12119,447 @@ func file_sf_cardano_type_v1_type_proto_rawDescGZIP() []byte {
 	return file_sf_cardano_type_v1_type_proto_rawDescData
 }
 
-var file_sf_cardano_type_v1_type_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_sf_cardano_type_v1_type_proto_msgTypes = make([]protoimpl.MessageInfo, 106)
+var file_sf_cardano_type_v1_type_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_sf_cardano_type_v1_type_proto_msgTypes = make([]protoimpl.MessageInfo, 145)
 var file_sf_cardano_type_v1_type_proto_goTypes = []any{
-	(RedeemerPurpose)(0),              // 0: sf.cardano.type.v1.RedeemerPurpose
-	(MirSource)(0),                    // 1: sf.cardano.type.v1.MirSource
-	(*Redeemer)(nil),                  // 2: sf.cardano.type.v1.Redeemer
-	(*TxInput)(nil),                   // 3: sf.cardano.type.v1.TxInput
-	(*TxOutput)(nil),                  // 4: sf.cardano.type.v1.TxOutput
-	(*Datum)(nil),                     // 5: sf.cardano.type.v1.Datum
-	(*Asset)(nil),                     // 6: sf.cardano.type.v1.Asset
-	(*Multiasset)(nil),                // 7: sf.cardano.type.v1.Multiasset
-	(*TxValidity)(nil),                // 8: sf.cardano.type.v1.TxValidity
-	(*Collateral)(nil),                // 9: sf.cardano.type.v1.Collateral
-	(*Withdrawal)(nil),                // 10: sf.cardano.type.v1.Withdrawal
-	(*WitnessSet)(nil),                // 11: sf.cardano.type.v1.WitnessSet
-	(*AuxData)(nil),                   // 12: sf.cardano.type.v1.AuxData
-	(*Tx)(nil),                        // 13: sf.cardano.type.v1.Tx
-	(*GovernanceActionProposal)(nil),  // 14: sf.cardano.type.v1.GovernanceActionProposal
-	(*GovernanceAction)(nil),          // 15: sf.cardano.type.v1.GovernanceAction
-	(*GovernanceActionId)(nil),        // 16: sf.cardano.type.v1.GovernanceActionId
-	(*ParameterChangeAction)(nil),     // 17: sf.cardano.type.v1.ParameterChangeAction
-	(*HardForkInitiationAction)(nil),  // 18: sf.cardano.type.v1.HardForkInitiationAction
-	(*TreasuryWithdrawalsAction)(nil), // 19: sf.cardano.type.v1.TreasuryWithdrawalsAction
-	(*WithdrawalAmount)(nil),          // 20: sf.cardano.type.v1.WithdrawalAmount
-	(*NoConfidenceAction)(nil),        // 21: sf.cardano.type.v1.NoConfidenceAction
-	(*UpdateCommitteeAction)(nil),     // 22: sf.cardano.type.v1.UpdateCommitteeAction
-	(*NewConstitutionAction)(nil),     // 23: sf.cardano.type.v1.NewConstitutionAction
-	(*Constitution)(nil),              // 24: sf.cardano.type.v1.Constitution
-	(*NewCommitteeCredentials)(nil),   // 25: sf.cardano.type.v1.NewCommitteeCredentials
-	(*BlockHeader)(nil),               // 26: sf.cardano.type.v1.BlockHeader
-	(*BlockBody)(nil),                 // 27: sf.cardano.type.v1.BlockBody
-	(*Block)(nil),                     // 28: sf.cardano.type.v1.Block
-	(*VKeyWitness)(nil),               // 29: sf.cardano.type.v1.VKeyWitness
-	(*NativeScript)(nil),              // 30: sf.cardano.type.v1.NativeScript
-	(*NativeScriptList)(nil),          // 31: sf.cardano.type.v1.NativeScriptList
-	(*ScriptNOfK)(nil),                // 32: sf.cardano.type.v1.ScriptNOfK
-	(*Constr)(nil),                    // 33: sf.cardano.type.v1.Constr
-	(*BigInt)(nil),                    // 34: sf.cardano.type.v1.BigInt
-	(*PlutusDataPair)(nil),            // 35: sf.cardano.type.v1.PlutusDataPair
-	(*PlutusData)(nil),                // 36: sf.cardano.type.v1.PlutusData
-	(*PlutusDataMap)(nil),             // 37: sf.cardano.type.v1.PlutusDataMap
-	(*PlutusDataArray)(nil),           // 38: sf.cardano.type.v1.PlutusDataArray
-	(*Script)(nil),                    // 39: sf.cardano.type.v1.Script
-	(*Metadatum)(nil),                 // 40: sf.cardano.type.v1.Metadatum
-	(*MetadatumArray)(nil),            // 41: sf.cardano.type.v1.MetadatumArray
-	(*MetadatumMap)(nil),              // 42: sf.cardano.type.v1.MetadatumMap
-	(*MetadatumPair)(nil),             // 43: sf.cardano.type.v1.MetadatumPair
-	(*Metadata)(nil),                  // 44: sf.cardano.type.v1.Metadata
-	(*StakeCredential)(nil),           // 45: sf.cardano.type.v1.StakeCredential
-	(*RationalNumber)(nil),            // 46: sf.cardano.type.v1.RationalNumber
-	(*Relay)(nil),                     // 47: sf.cardano.type.v1.Relay
-	(*PoolMetadata)(nil),              // 48: sf.cardano.type.v1.PoolMetadata
-	(*Certificate)(nil),               // 49: sf.cardano.type.v1.Certificate
-	(*StakeDelegationCert)(nil),       // 50: sf.cardano.type.v1.StakeDelegationCert
-	(*PoolRegistrationCert)(nil),      // 51: sf.cardano.type.v1.PoolRegistrationCert
-	(*PoolRetirementCert)(nil),        // 52: sf.cardano.type.v1.PoolRetirementCert
-	(*GenesisKeyDelegationCert)(nil),  // 53: sf.cardano.type.v1.GenesisKeyDelegationCert
-	(*MirTarget)(nil),                 // 54: sf.cardano.type.v1.MirTarget
-	(*MirCert)(nil),                   // 55: sf.cardano.type.v1.MirCert
-	(*RegCert)(nil),                   // 56: sf.cardano.type.v1.RegCert
-	(*UnRegCert)(nil),                 // 57: sf.cardano.type.v1.UnRegCert
-	(*DRep)(nil),                      // 58: sf.cardano.type.v1.DRep
-	(*VoteDelegCert)(nil),             // 59: sf.cardano.type.v1.VoteDelegCert
-	(*StakeVoteDelegCert)(nil),        // 60: sf.cardano.type.v1.StakeVoteDelegCert
-	(*StakeRegDelegCert)(nil),         // 61: sf.cardano.type.v1.StakeRegDelegCert
-	(*VoteRegDelegCert)(nil),          // 62: sf.cardano.type.v1.VoteRegDelegCert
-	(*StakeVoteRegDelegCert)(nil),     // 63: sf.cardano.type.v1.StakeVoteRegDelegCert
-	(*AuthCommitteeHotCert)(nil),      // 64: sf.cardano.type.v1.AuthCommitteeHotCert
-	(*Anchor)(nil),                    // 65: sf.cardano.type.v1.Anchor
-	(*ResignCommitteeColdCert)(nil),   // 66: sf.cardano.type.v1.ResignCommitteeColdCert
-	(*RegDRepCert)(nil),               // 67: sf.cardano.type.v1.RegDRepCert
-	(*UnRegDRepCert)(nil),             // 68: sf.cardano.type.v1.UnRegDRepCert
-	(*UpdateDRepCert)(nil),            // 69: sf.cardano.type.v1.UpdateDRepCert
-	(*AddressPattern)(nil),            // 70: sf.cardano.type.v1.AddressPattern
-	(*AssetPattern)(nil),              // 71: sf.cardano.type.v1.AssetPattern
-	(*TxOutputPattern)(nil),           // 72: sf.cardano.type.v1.TxOutputPattern
-	(*TxPattern)(nil),                 // 73: sf.cardano.type.v1.TxPattern
-	(*ExUnits)(nil),                   // 74: sf.cardano.type.v1.ExUnits
-	(*ExPrices)(nil),                  // 75: sf.cardano.type.v1.ExPrices
-	(*ProtocolVersion)(nil),           // 76: sf.cardano.type.v1.ProtocolVersion
-	(*CostModel)(nil),                 // 77: sf.cardano.type.v1.CostModel
-	(*CostModels)(nil),                // 78: sf.cardano.type.v1.CostModels
-	(*VotingThresholds)(nil),          // 79: sf.cardano.type.v1.VotingThresholds
-	(*PParams)(nil),                   // 80: sf.cardano.type.v1.PParams
-	(*EraBoundary)(nil),               // 81: sf.cardano.type.v1.EraBoundary
-	(*EraSummary)(nil),                // 82: sf.cardano.type.v1.EraSummary
-	(*EraSummaries)(nil),              // 83: sf.cardano.type.v1.EraSummaries
-	(*EvalError)(nil),                 // 84: sf.cardano.type.v1.EvalError
-	(*EvalTrace)(nil),                 // 85: sf.cardano.type.v1.EvalTrace
-	(*TxEval)(nil),                    // 86: sf.cardano.type.v1.TxEval
-	(*ExtraEntropy)(nil),              // 87: sf.cardano.type.v1.ExtraEntropy
-	(*BlockVersionData)(nil),          // 88: sf.cardano.type.v1.BlockVersionData
-	(*SoftforkRule)(nil),              // 89: sf.cardano.type.v1.SoftforkRule
-	(*TxFeePolicy)(nil),               // 90: sf.cardano.type.v1.TxFeePolicy
-	(*ProtocolConsts)(nil),            // 91: sf.cardano.type.v1.ProtocolConsts
-	(*HeavyDelegation)(nil),           // 92: sf.cardano.type.v1.HeavyDelegation
-	(*VssCert)(nil),                   // 93: sf.cardano.type.v1.VssCert
-	(*GenDelegs)(nil),                 // 94: sf.cardano.type.v1.GenDelegs
-	(*PoolVotingThresholds)(nil),      // 95: sf.cardano.type.v1.PoolVotingThresholds
-	(*DRepVotingThresholds)(nil),      // 96: sf.cardano.type.v1.DRepVotingThresholds
-	(*Committee)(nil),                 // 97: sf.cardano.type.v1.Committee
-	(*CostModelMap)(nil),              // 98: sf.cardano.type.v1.CostModelMap
-	(*Genesis)(nil),                   // 99: sf.cardano.type.v1.Genesis
-	nil,                               // 100: sf.cardano.type.v1.Committee.MembersEntry
-	nil,                               // 101: sf.cardano.type.v1.Genesis.AvvmDistrEntry
-	nil,                               // 102: sf.cardano.type.v1.Genesis.BootStakeholdersEntry
-	nil,                               // 103: sf.cardano.type.v1.Genesis.HeavyDelegationEntry
-	nil,                               // 104: sf.cardano.type.v1.Genesis.NonAvvmBalancesEntry
-	nil,                               // 105: sf.cardano.type.v1.Genesis.VssCertsEntry
-	nil,                               // 106: sf.cardano.type.v1.Genesis.GenDelegsEntry
-	nil,                               // 107: sf.cardano.type.v1.Genesis.InitialFundsEntry
+	(RedeemerPurpose)(0),                  // 0: sf.cardano.type.v1.RedeemerPurpose
+	(VoterType)(0),                        // 1: sf.cardano.type.v1.VoterType
+	(Vote)(0),                             // 2: sf.cardano.type.v1.Vote
+	(ScriptLanguage)(0),                   // 3: sf.cardano.type.v1.ScriptLanguage
+	(ScriptOrigin)(0),                     // 4: sf.cardano.type.v1.ScriptOrigin
+	(MirSource)(0),                        // 5: sf.cardano.type.v1.MirSource
+	(*Redeemer)(nil),                      // 6: sf.cardano.type.v1.Redeemer
+	(*TxInput)(nil),                       // 7: sf.cardano.type.v1.TxInput
+	(*TxOutput)(nil),                      // 8: sf.cardano.type.v1.TxOutput
+	(*Datum)(nil),                         // 9: sf.cardano.type.v1.Datum
+	(*Asset)(nil),                         // 10: sf.cardano.type.v1.Asset
+	(*Multiasset)(nil),                    // 11: sf.cardano.type.v1.Multiasset
+	(*TxValidity)(nil),                    // 12: sf.cardano.type.v1.TxValidity
+	(*Collateral)(nil),                    // 13: sf.cardano.type.v1.Collateral
+	(*Withdrawal)(nil),                    // 14: sf.cardano.type.v1.Withdrawal
+	(*WitnessSet)(nil),                    // 15: sf.cardano.type.v1.WitnessSet
+	(*BootstrapWitness)(nil),              // 16: sf.cardano.type.v1.BootstrapWitness
+	(*AuxData)(nil),                       // 17: sf.cardano.type.v1.AuxData
+	(*Tx)(nil),                            // 18: sf.cardano.type.v1.Tx
+	(*LedgerEffect)(nil),                  // 19: sf.cardano.type.v1.LedgerEffect
+	(*TxInputRef)(nil),                    // 20: sf.cardano.type.v1.TxInputRef
+	(*TxOutputRef)(nil),                   // 21: sf.cardano.type.v1.TxOutputRef
+	(*ProtocolParamUpdate)(nil),           // 22: sf.cardano.type.v1.ProtocolParamUpdate
+	(*GenesisParamUpdate)(nil),            // 23: sf.cardano.type.v1.GenesisParamUpdate
+	(*PParamsUpdate)(nil),                 // 24: sf.cardano.type.v1.PParamsUpdate
+	(*GovernanceActionProposal)(nil),      // 25: sf.cardano.type.v1.GovernanceActionProposal
+	(*GovernanceAction)(nil),              // 26: sf.cardano.type.v1.GovernanceAction
+	(*GovernanceActionId)(nil),            // 27: sf.cardano.type.v1.GovernanceActionId
+	(*Voter)(nil),                         // 28: sf.cardano.type.v1.Voter
+	(*VotingProcedure)(nil),               // 29: sf.cardano.type.v1.VotingProcedure
+	(*ParameterChangeAction)(nil),         // 30: sf.cardano.type.v1.ParameterChangeAction
+	(*HardForkInitiationAction)(nil),      // 31: sf.cardano.type.v1.HardForkInitiationAction
+	(*TreasuryWithdrawalsAction)(nil),     // 32: sf.cardano.type.v1.TreasuryWithdrawalsAction
+	(*WithdrawalAmount)(nil),              // 33: sf.cardano.type.v1.WithdrawalAmount
+	(*NoConfidenceAction)(nil),            // 34: sf.cardano.type.v1.NoConfidenceAction
+	(*UpdateCommitteeAction)(nil),         // 35: sf.cardano.type.v1.UpdateCommitteeAction
+	(*NewConstitutionAction)(nil),         // 36: sf.cardano.type.v1.NewConstitutionAction
+	(*Constitution)(nil),                  // 37: sf.cardano.type.v1.Constitution
+	(*NewCommitteeCredentials)(nil),       // 38: sf.cardano.type.v1.NewCommitteeCredentials
+	(*BlockHeader)(nil),                   // 39: sf.cardano.type.v1.BlockHeader
+	(*BlockBody)(nil),                     // 40: sf.cardano.type.v1.BlockBody
+	(*Block)(nil),                         // 41: sf.cardano.type.v1.Block
+	(*ByronBlock)(nil),                    // 42: sf.cardano.type.v1.ByronBlock
+	(*ByronBlockVersion)(nil),             // 43: sf.cardano.type.v1.ByronBlockVersion
+	(*ByronSoftwareVersion)(nil),          // 44: sf.cardano.type.v1.ByronSoftwareVersion
+	(*ByronDelegationCertificate)(nil),    // 45: sf.cardano.type.v1.ByronDelegationCertificate
+	(*ByronUpdateProposal)(nil),           // 46: sf.cardano.type.v1.ByronUpdateProposal
+	(*ByronBlockVersionModification)(nil), // 47: sf.cardano.type.v1.ByronBlockVersionModification
+	(*ByronSoftforkRule)(nil),             // 48: sf.cardano.type.v1.ByronSoftforkRule
+	(*ByronTxFeePolicy)(nil),              // 49: sf.cardano.type.v1.ByronTxFeePolicy
+	(*ByronSystemUpdate)(nil),             // 50: sf.cardano.type.v1.ByronSystemUpdate
+	(*ByronUpdateVote)(nil),               // 51: sf.cardano.type.v1.ByronUpdateVote
+	(*ByronAddress)(nil),                  // 52: sf.cardano.type.v1.ByronAddress
+	(*BlockStats)(nil),                    // 53: sf.cardano.type.v1.BlockStats
+	(*EpochTransition)(nil),               // 54: sf.cardano.type.v1.EpochTransition
+	(*LedgerSnapshot)(nil),                // 55: sf.cardano.type.v1.LedgerSnapshot
+	(*GovernanceState)(nil),               // 56: sf.cardano.type.v1.GovernanceState
+	(*PoolStake)(nil),                     // 57: sf.cardano.type.v1.PoolStake
+	(*PoolParams)(nil),                    // 58: sf.cardano.type.v1.PoolParams
+	(*VKeyWitness)(nil),                   // 59: sf.cardano.type.v1.VKeyWitness
+	(*NativeScript)(nil),                  // 60: sf.cardano.type.v1.NativeScript
+	(*NativeScriptList)(nil),              // 61: sf.cardano.type.v1.NativeScriptList
+	(*ScriptNOfK)(nil),                    // 62: sf.cardano.type.v1.ScriptNOfK
+	(*Constr)(nil),                        // 63: sf.cardano.type.v1.Constr
+	(*BigInt)(nil),                        // 64: sf.cardano.type.v1.BigInt
+	(*PlutusDataPair)(nil),                // 65: sf.cardano.type.v1.PlutusDataPair
+	(*PlutusData)(nil),                    // 66: sf.cardano.type.v1.PlutusData
+	(*PlutusDataMap)(nil),                 // 67: sf.cardano.type.v1.PlutusDataMap
+	(*PlutusDataArray)(nil),               // 68: sf.cardano.type.v1.PlutusDataArray
+	(*Script)(nil),                        // 69: sf.cardano.type.v1.Script
+	(*ExecutedScript)(nil),                // 70: sf.cardano.type.v1.ExecutedScript
+	(*Metadatum)(nil),                     // 71: sf.cardano.type.v1.Metadatum
+	(*MetadatumArray)(nil),                // 72: sf.cardano.type.v1.MetadatumArray
+	(*MetadatumMap)(nil),                  // 73: sf.cardano.type.v1.MetadatumMap
+	(*MetadatumPair)(nil),                 // 74: sf.cardano.type.v1.MetadatumPair
+	(*Metadata)(nil),                      // 75: sf.cardano.type.v1.Metadata
+	(*DecodedMetadata)(nil),               // 76: sf.cardano.type.v1.DecodedMetadata
+	(*Cip25Metadata)(nil),                 // 77: sf.cardano.type.v1.Cip25Metadata
+	(*Cip25Asset)(nil),                    // 78: sf.cardano.type.v1.Cip25Asset
+	(*Cip25File)(nil),                     // 79: sf.cardano.type.v1.Cip25File
+	(*Cip20Message)(nil),                  // 80: sf.cardano.type.v1.Cip20Message
+	(*Cip36Registration)(nil),             // 81: sf.cardano.type.v1.Cip36Registration
+	(*Cip36Delegation)(nil),               // 82: sf.cardano.type.v1.Cip36Delegation
+	(*Cip68Token)(nil),                    // 83: sf.cardano.type.v1.Cip68Token
+	(*Cip68MetadataUpdate)(nil),           // 84: sf.cardano.type.v1.Cip68MetadataUpdate
+	(*Cip68Metadata)(nil),                 // 85: sf.cardano.type.v1.Cip68Metadata
+	(*Cip68File)(nil),                     // 86: sf.cardano.type.v1.Cip68File
+	(*StakeCredential)(nil),               // 87: sf.cardano.type.v1.StakeCredential
+	(*RationalNumber)(nil),                // 88: sf.cardano.type.v1.RationalNumber
+	(*Relay)(nil),                         // 89: sf.cardano.type.v1.Relay
+	(*PoolMetadata)(nil),                  // 90: sf.cardano.type.v1.PoolMetadata
+	(*Certificate)(nil),                   // 91: sf.cardano.type.v1.Certificate
+	(*StakeDelegationCert)(nil),           // 92: sf.cardano.type.v1.StakeDelegationCert
+	(*PoolRegistrationCert)(nil),          // 93: sf.cardano.type.v1.PoolRegistrationCert
+	(*PoolRetirementCert)(nil),            // 94: sf.cardano.type.v1.PoolRetirementCert
+	(*GenesisKeyDelegationCert)(nil),      // 95: sf.cardano.type.v1.GenesisKeyDelegationCert
+	(*MirTarget)(nil),                     // 96: sf.cardano.type.v1.MirTarget
+	(*MirCert)(nil),                       // 97: sf.cardano.type.v1.MirCert
+	(*RegCert)(nil),                       // 98: sf.cardano.type.v1.RegCert
+	(*UnRegCert)(nil),                     // 99: sf.cardano.type.v1.UnRegCert
+	(*DRep)(nil),                          // 100: sf.cardano.type.v1.DRep
+	(*VoteDelegCert)(nil),                 // 101: sf.cardano.type.v1.VoteDelegCert
+	(*StakeVoteDelegCert)(nil),            // 102: sf.cardano.type.v1.StakeVoteDelegCert
+	(*StakeRegDelegCert)(nil),             // 103: sf.cardano.type.v1.StakeRegDelegCert
+	(*VoteRegDelegCert)(nil),              // 104: sf.cardano.type.v1.VoteRegDelegCert
+	(*StakeVoteRegDelegCert)(nil),         // 105: sf.cardano.type.v1.StakeVoteRegDelegCert
+	(*AuthCommitteeHotCert)(nil),          // 106: sf.cardano.type.v1.AuthCommitteeHotCert
+	(*Anchor)(nil),                        // 107: sf.cardano.type.v1.Anchor
+	(*ResignCommitteeColdCert)(nil),       // 108: sf.cardano.type.v1.ResignCommitteeColdCert
+	(*RegDRepCert)(nil),                   // 109: sf.cardano.type.v1.RegDRepCert
+	(*UnRegDRepCert)(nil),                 // 110: sf.cardano.type.v1.UnRegDRepCert
+	(*UpdateDRepCert)(nil),                // 111: sf.cardano.type.v1.UpdateDRepCert
+	(*AddressPattern)(nil),                // 112: sf.cardano.type.v1.AddressPattern
+	(*AssetPattern)(nil),                  // 113: sf.cardano.type.v1.AssetPattern
+	(*TxOutputPattern)(nil),               // 114: sf.cardano.type.v1.TxOutputPattern
+	(*TxPattern)(nil),                     // 115: sf.cardano.type.v1.TxPattern
+	(*ExUnits)(nil),                       // 116: sf.cardano.type.v1.ExUnits
+	(*ExPrices)(nil),                      // 117: sf.cardano.type.v1.ExPrices
+	(*ProtocolVersion)(nil),               // 118: sf.cardano.type.v1.ProtocolVersion
+	(*CostModel)(nil),                     // 119: sf.cardano.type.v1.CostModel
+	(*CostModels)(nil),                    // 120: sf.cardano.type.v1.CostModels
+	(*VotingThresholds)(nil),              // 121: sf.cardano.type.v1.VotingThresholds
+	(*PParams)(nil),                       // 122: sf.cardano.type.v1.PParams
+	(*EraBoundary)(nil),                   // 123: sf.cardano.type.v1.EraBoundary
+	(*EraSummary)(nil),                    // 124: sf.cardano.type.v1.EraSummary
+	(*EraSummaries)(nil),                  // 125: sf.cardano.type.v1.EraSummaries
+	(*EvalError)(nil),                     // 126: sf.cardano.type.v1.EvalError
+	(*EvalTrace)(nil),                     // 127: sf.cardano.type.v1.EvalTrace
+	(*TxEval)(nil),                        // 128: sf.cardano.type.v1.TxEval
+	(*ExtraEntropy)(nil),                  // 129: sf.cardano.type.v1.ExtraEntropy
+	(*BlockVersionData)(nil),              // 130: sf.cardano.type.v1.BlockVersionData
+	(*SoftforkRule)(nil),                  // 131: sf.cardano.type.v1.SoftforkRule
+	(*TxFeePolicy)(nil),                   // 132: sf.cardano.type.v1.TxFeePolicy
+	(*ProtocolConsts)(nil),                // 133: sf.cardano.type.v1.ProtocolConsts
+	(*HeavyDelegation)(nil),               // 134: sf.cardano.type.v1.HeavyDelegation
+	(*VssCert)(nil),                       // 135: sf.cardano.type.v1.VssCert
+	(*GenDelegs)(nil),                     // 136: sf.cardano.type.v1.GenDelegs
+	(*PoolVotingThresholds)(nil),          // 137: sf.cardano.type.v1.PoolVotingThresholds
+	(*DRepVotingThresholds)(nil),          // 138: sf.cardano.type.v1.DRepVotingThresholds
+	(*Committee)(nil),                     // 139: sf.cardano.type.v1.Committee
+	(*CostModelMap)(nil),                  // 140: sf.cardano.type.v1.CostModelMap
+	(*Genesis)(nil),                       // 141: sf.cardano.type.v1.Genesis
+	nil,                                   // 142: sf.cardano.type.v1.BlockStats.CertificatesEntry
+	nil,                                   // 143: sf.cardano.type.v1.Committee.MembersEntry
+	nil,                                   // 144: sf.cardano.type.v1.Genesis.AvvmDistrEntry
+	nil,                                   // 145: sf.cardano.type.v1.Genesis.BootStakeholdersEntry
+	nil,                                   // 146: sf.cardano.type.v1.Genesis.HeavyDelegationEntry
+	nil,                                   // 147: sf.cardano.type.v1.Genesis.NonAvvmBalancesEntry
+	nil,                                   // 148: sf.cardano.type.v1.Genesis.VssCertsEntry
+	nil,                                   // 149: sf.cardano.type.v1.Genesis.GenDelegsEntry
+	nil,                                   // 150: sf.cardano.type.v1.Genesis.InitialFundsEntry
 }
 var file_sf_cardano_type_v1_type_proto_depIdxs = []int32{
 	0,   // 0: sf.cardano.type.v1.Redeemer.purpose:type_name -> sf.cardano.type.v1.RedeemerPurpose
-	36,  // 1: sf.cardano.type.v1.Redeemer.payload:type_name -> sf.cardano.type.v1.PlutusData
-	74,  // 2: sf.cardano.type.v1.Redeemer.ex_units:type_name -> sf.cardano.type.v1.ExUnits
-	4,   // 3: sf.cardano.type.v1.TxInput.as_output:type_name -> sf.cardano.type.v1.TxOutput
-	2,   // 4: sf.cardano.type.v1.TxInput.redeemer:type_name -> sf.cardano.type.v1.Redeemer
-	7,   // 5: sf.cardano.type.v1.TxOutput.assets:type_name -> sf.cardano.type.v1.Multiasset
-	5,   // 6: sf.cardano.type.v1.TxOutput.datum:type_name -> sf.cardano.type.v1.Datum
-	39,  // 7: sf.cardano.type.v1.TxOutput.script:type_name -> sf.cardano.type.v1.Script
-	36,  // 8: sf.cardano.type.v1.Datum.payload:type_name -> sf.cardano.type.v1.PlutusData
-	6,   // 9: sf.cardano.type.v1.Multiasset.assets:type_name -> sf.cardano.type.v1.Asset
-	2,   // 10: sf.cardano.type.v1.Multiasset.redeemer:type_name -> sf.cardano.type.v1.Redeemer
-	3,   // 11: sf.cardano.type.v1.Collateral.collateral:type_name -> sf.cardano.type.v1.TxInput
-	4,   // 12: sf.cardano.type.v1.Collateral.collateral_return:type_name -> sf.cardano.type.v1.TxOutput
-	2,   // 13: sf.cardano.type.v1.Withdrawal.redeemer:type_name -> sf.cardano.type.v1.Redeemer
-	29,  // 14: sf.cardano.type.v1.WitnessSet.vkeywitness:type_name -> sf.cardano.type.v1.VKeyWitness
-	39,  // 15: sf.cardano.type.v1.WitnessSet.script:type_name -> sf.cardano.type.v1.Script
-	36,  // 16: sf.cardano.type.v1.WitnessSet.plutus_datums:type_name -> sf.cardano.type.v1.PlutusData
-	44,  // 17: sf.cardano.type.v1.AuxData.metadata:type_name -> sf.cardano.type.v1.Metadata
-	39,  // 18: sf.cardano.type.v1.AuxData.scripts:type_name -> sf.cardano.type.v1.Script
-	3,   // 19: sf.cardano.type.v1.Tx.inputs:type_name -> sf.cardano.type.v1.TxInput
-	4,   // 20: sf.cardano.type.v1.Tx.outputs:type_name -> sf.cardano.type.v1.TxOutput
-	49,  // 21: sf.cardano.type.v1.Tx.certificates:type_name -> sf.cardano.type.v1.Certificate
-	10,  // 22: sf.cardano.type.v1.Tx.withdrawals:type_name -> sf.cardano.type.v1.Withdrawal
-	7,   // 23: sf.cardano.type.v1.Tx.mint:type_name -> sf.cardano.type.v1.Multiasset
-	3,   // 24: sf.cardano.type.v1.Tx.reference_inputs:type_name -> sf.cardano.type.v1.TxInput
-	11,  // 25: sf.cardano.type.v1.Tx.witnesses:type_name -> sf.cardano.type.v1.WitnessSet
-	9,   // 26: sf.cardano.type.v1.Tx.collateral:type_name -> sf.cardano.type.v1.Collateral
-	8,   // 27: sf.cardano.type.v1.Tx.validity:type_name -> sf.cardano.type.v1.TxValidity
-	12,  // 28: sf.cardano.type.v1.Tx.auxiliary:type_name -> sf.cardano.type.v1.AuxData
-	14,  // 29: sf.cardano.type.v1.Tx.proposals:type_name -> sf.cardano.type.v1.GovernanceActionProposal
-	15,  // 30: sf.cardano.type.v1.GovernanceActionProposal.gov_action:type_name -> sf.cardano.type.v1.GovernanceAction
-	65,  // 31: sf.cardano.type.v1.GovernanceActionProposal.anchor:type_name -> sf.cardano.type.v1.Anchor
-	17,  // 32: sf.cardano.type.v1.GovernanceAction.parameter_change_action:type_name -> sf.cardano.type.v1.ParameterChangeAction
-	18,  // 33: sf.cardano.type.v1.GovernanceAction.hard_fork_initiation_action:type_name -> sf.cardano.type.v1.HardForkInitiationAction
-	19,  // 34: sf.cardano.type.v1.GovernanceAction.treasury_withdrawals_action:type_name -> sf.cardano.type.v1.TreasuryWithdrawalsAction
-	21,  // 35: sf.cardano.type.v1.GovernanceAction.no_confidence_action:type_name -> sf.cardano.type.v1.NoConfidenceAction
-	22,  // 36: sf.cardano.type.v1.GovernanceAction.update_committee_action:type_name -> sf.cardano.type.v1.UpdateCommitteeAction
-	23,  // 37: sf.cardano.type.v1.GovernanceAction.new_constitution_action:type_name -> sf.cardano.type.v1.NewConstitutionAction
-	16,  // 38: sf.cardano.type.v1.ParameterChangeAction.gov_action_id:type_name -> sf.cardano.type.v1.GovernanceActionId
-	80,  // 39: sf.cardano.type.v1.ParameterChangeAction.protocol_param_update:type_name -> sf.cardano.type.v1.PParams
-	16,  // 40: sf.cardano.type.v1.HardForkInitiationAction.gov_action_id:type_name -> sf.cardano.type.v1.GovernanceActionId
-	76,  // 41: sf.cardano.type.v1.HardForkInitiationAction.protocol_version:type_name -> sf.cardano.type.v1.ProtocolVersion
-	20,  // 42: sf.cardano.type.v1.TreasuryWithdrawalsAction.withdrawals:type_name -> sf.cardano.type.v1.WithdrawalAmount
-	16,  // 43: sf.cardano.type.v1.NoConfidenceAction.gov_action_id:type_name -> sf.cardano.type.v1.GovernanceActionId
-	16,  // 44: sf.cardano.type.v1.UpdateCommitteeAction.gov_action_id:type_name -> sf.cardano.type.v1.GovernanceActionId
-	45,  // 45: sf.cardano.type.v1.UpdateCommitteeAction.remove_committee_credentials:type_name -> sf.cardano.type.v1.StakeCredential
-	25,  // 46: sf.cardano.type.v1.UpdateCommitteeAction.new_committee_credentials:type_name -> sf.cardano.type.v1.NewCommitteeCredentials
-	46,  // 47: sf.cardano.type.v1.UpdateCommitteeAction.new_committee_threshold:type_name -> sf.cardano.type.v1.RationalNumber
-	16,  // 48: sf.cardano.type.v1.NewConstitutionAction.gov_action_id:type_name -> sf.cardano.type.v1.GovernanceActionId
-	24,  // 49: sf.cardano.type.v1.NewConstitutionAction.constitution:type_name -> sf.cardano.type.v1.Constitution
-	65,  // 50: sf.cardano.type.v1.Constitution.anchor:type_name -> sf.cardano.type.v1.Anchor
-	45,  // 51: sf.cardano.type.v1.NewCommitteeCredentials.committee_cold_credential:type_name -> sf.cardano.type.v1.StakeCredential
-	13,  // 52: sf.cardano.type.v1.BlockBody.tx:type_name -> sf.cardano.type.v1.Tx
-	26,  // 53: sf.cardano.type.v1.Block.header:type_name -> sf.cardano.type.v1.BlockHeader
-	27,  // 54: sf.cardano.type.v1.Block.body:type_name -> sf.cardano.type.v1.BlockBody
-	31,  // 55: sf.cardano.type.v1.NativeScript.script_all:type_name -> sf.cardano.type.v1.NativeScriptList
-	31,  // 56: sf.cardano.type.v1.NativeScript.script_any:type_name -> sf.cardano.type.v1.NativeScriptList
-	32,  // 57: sf.cardano.type.v1.NativeScript.script_n_of_k:type_name -> sf.cardano.type.v1.ScriptNOfK
-	30,  // 58: sf.cardano.type.v1.NativeScriptList.items:type_name -> sf.cardano.type.v1.NativeScript
-	30,  // 59: sf.cardano.type.v1.ScriptNOfK.scripts:type_name -> sf.cardano.type.v1.NativeScript
-	36,  // 60: sf.cardano.type.v1.Constr.fields:type_name -> sf.cardano.type.v1.PlutusData
-	36,  // 61: sf.cardano.type.v1.PlutusDataPair.key:type_name -> sf.cardano.type.v1.PlutusData
-	36,  // 62: sf.cardano.type.v1.PlutusDataPair.value:type_name -> sf.cardano.type.v1.PlutusData
-	33,  // 63: sf.cardano.type.v1.PlutusData.constr:type_name -> sf.cardano.type.v1.Constr
-	37,  // 64: sf.cardano.type.v1.PlutusData.map:type_name -> sf.cardano.type.v1.PlutusDataMap
-	34,  // 65: sf.cardano.type.v1.PlutusData.big_int:type_name -> sf.cardano.type.v1.BigInt
-	38,  // 66: sf.cardano.type.v1.PlutusData.array:type_name -> sf.cardano.type.v1.PlutusDataArray
-	35,  // 67: sf.cardano.type.v1.PlutusDataMap.pairs:type_name -> sf.cardano.type.v1.PlutusDataPair
-	36,  // 68: sf.cardano.type.v1.PlutusDataArray.items:type_name -> sf.cardano.type.v1.PlutusData
-	30,  // 69: sf.cardano.type.v1.Script.native:type_name -> sf.cardano.type.v1.NativeScript
-	41,  // 70: sf.cardano.type.v1.Metadatum.array:type_name -> sf.cardano.type.v1.MetadatumArray
-	42,  // 71: sf.cardano.type.v1.Metadatum.map:type_name -> sf.cardano.type.v1.MetadatumMap
-	40,  // 72: sf.cardano.type.v1.MetadatumArray.items:type_name -> sf.cardano.type.v1.Metadatum
-	43,  // 73: sf.cardano.type.v1.MetadatumMap.pairs:type_name -> sf.cardano.type.v1.MetadatumPair
-	40,  // 74: sf.cardano.type.v1.MetadatumPair.key:type_name -> sf.cardano.type.v1.Metadatum
-	40,  // 75: sf.cardano.type.v1.MetadatumPair.value:type_name -> sf.cardano.type.v1.Metadatum
-	40,  // 76: sf.cardano.type.v1.Metadata.value:type_name -> sf.cardano.type.v1.Metadatum
-	45,  // 77: sf.cardano.type.v1.Certificate.stake_registration:type_name -> sf.cardano.type.v1.StakeCredential
-	45,  // 78: sf.cardano.type.v1.Certificate.stake_deregistration:type_name -> sf.cardano.type.v1.StakeCredential
-	50,  // 79: sf.cardano.type.v1.Certificate.stake_delegation:type_name -> sf.cardano.type.v1.StakeDelegationCert
-	51,  // 80: sf.cardano.type.v1.Certificate.pool_registration:type_name -> sf.cardano.type.v1.PoolRegistrationCert
-	52,  // 81: sf.cardano.type.v1.Certificate.pool_retirement:type_name -> sf.cardano.type.v1.PoolRetirementCert
-	53,  // 82: sf.cardano.type.v1.Certificate.genesis_key_delegation:type_name -> sf.cardano.type.v1.GenesisKeyDelegationCert
-	55,  // 83: sf.cardano.type.v1.Certificate.mir_cert:type_name -> sf.cardano.type.v1.MirCert
-	56,  // 84: sf.cardano.type.v1.Certificate.reg_cert:type_name -> sf.cardano.type.v1.RegCert
-	57,  // 85: sf.cardano.type.v1.Certificate.unreg_cert:type_name -> sf.cardano.type.v1.UnRegCert
-	59,  // 86: sf.cardano.type.v1.Certificate.vote_deleg_cert:type_name -> sf.cardano.type.v1.VoteDelegCert
-	60,  // 87: sf.cardano.type.v1.Certificate.stake_vote_deleg_cert:type_name -> sf.cardano.type.v1.StakeVoteDelegCert
-	61,  // 88: sf.cardano.type.v1.Certificate.stake_reg_deleg_cert:type_name -> sf.cardano.type.v1.StakeRegDelegCert
-	62,  // 89: sf.cardano.type.v1.Certificate.vote_reg_deleg_cert:type_name -> sf.cardano.type.v1.VoteRegDelegCert
-	63,  // 90: sf.cardano.type.v1.Certificate.stake_vote_reg_deleg_cert:type_name -> sf.cardano.type.v1.StakeVoteRegDelegCert
-	64,  // 91: sf.cardano.type.v1.Certificate.auth_committee_hot_cert:type_name -> sf.cardano.type.v1.AuthCommitteeHotCert
-	66,  // 92: sf.cardano.type.v1.Certificate.resign_committee_cold_cert:type_name -> sf.cardano.type.v1.ResignCommitteeColdCert
-	67,  // 93: sf.cardano.type.v1.Certificate.reg_drep_cert:type_name -> sf.cardano.type.v1.RegDRepCert
-	68,  // 94: sf.cardano.type.v1.Certificate.unreg_drep_cert:type_name -> sf.cardano.type.v1.UnRegDRepCert
-	69,  // 95: sf.cardano.type.v1.Certificate.update_drep_cert:type_name -> sf.cardano.type.v1.UpdateDRepCert
-	2,   // 96: sf.cardano.type.v1.Certificate.redeemer:type_name -> sf.cardano.type.v1.Redeemer
-	45,  // 97: sf.cardano.type.v1.StakeDelegationCert.stake_credential:type_name -> sf.cardano.type.v1.StakeCredential
-	46,  // 98: sf.cardano.type.v1.PoolRegistrationCert.margin:type_name -> sf.cardano.type.v1.RationalNumber
-	47,  // 99: sf.cardano.type.v1.PoolRegistrationCert.relays:type_name -> sf.cardano.type.v1.Relay
-	48,  // 100: sf.cardano.type.v1.PoolRegistrationCert.pool_metadata:type_name -> sf.cardano.type.v1.PoolMetadata
-	45,  // 101: sf.cardano.type.v1.MirTarget.stake_credential:type_name -> sf.cardano.type.v1.StakeCredential
-	1,   // 102: sf.cardano.type.v1.MirCert.from:type_name -> sf.cardano.type.v1.MirSource
-	54,  // 103: sf.cardano.type.v1.MirCert.to:type_name -> sf.cardano.type.v1.MirTarget
-	45,  // 104: sf.cardano.type.v1.RegCert.stake_credential:type_name -> sf.cardano.type.v1.StakeCredential
-	45,  // 105: sf.cardano.type.v1.UnRegCert.stake_credential:type_name -> sf.cardano.type.v1.StakeCredential
-	45,  // 106: sf.cardano.type.v1.VoteDelegCert.stake_credential:type_name -> sf.cardano.type.v1.StakeCredential
-	58,  // 107: sf.cardano.type.v1.VoteDelegCert.drep:type_name -> sf.cardano.type.v1.DRep
-	45,  // 108: sf.cardano.type.v1.StakeVoteDelegCert.stake_credential:type_name -> sf.cardano.type.v1.StakeCredential
-	58,  // 109: sf.cardano.type.v1.StakeVoteDelegCert.drep:type_name -> sf.cardano.type.v1.DRep
-	45,  // 110: sf.cardano.type.v1.StakeRegDelegCert.stake_credential:type_name -> sf.cardano.type.v1.StakeCredential
-	45,  // 111: sf.cardano.type.v1.VoteRegDelegCert.stake_credential:type_name -> sf.cardano.type.v1.StakeCredential
-	58,  // 112: sf.cardano.type.v1.VoteRegDelegCert.drep:type_name -> sf.cardano.type.v1.DRep
-	45,  // 113: sf.cardano.type.v1.StakeVoteRegDelegCert.stake_credential:type_name -> sf.cardano.type.v1.StakeCredential
-	58,  // 114: sf.cardano.type.v1.StakeVoteRegDelegCert.drep:type_name -> sf.cardano.type.v1.DRep
-	45,  // 115: sf.cardano.type.v1.AuthCommitteeHotCert.committee_cold_credential:type_name -> sf.cardano.type.v1.StakeCredential
-	45,  // 116: sf.cardano.type.v1.AuthCommitteeHotCert.committee_hot_credential:type_name -> sf.cardano.type.v1.StakeCredential
-	45,  // 117: sf.cardano.type.v1.ResignCommitteeColdCert.committee_cold_credential:type_name -> sf.cardano.type.v1.StakeCredential
-	65,  // 118: sf.cardano.type.v1.ResignCommitteeColdCert.anchor:type_name -> sf.cardano.type.v1.Anchor
-	45,  // 119: sf.cardano.type.v1.RegDRepCert.drep_credential:type_name -> sf.cardano.type.v1.StakeCredential
-	65,  // 120: sf.cardano.type.v1.RegDRepCert.anchor:type_name -> sf.cardano.type.v1.Anchor
-	45,  // 121: sf.cardano.type.v1.UnRegDRepCert.drep_credential:type_name -> sf.cardano.type.v1.StakeCredential
-	45,  // 122: sf.cardano.type.v1.UpdateDRepCert.drep_credential:type_name -> sf.cardano.type.v1.StakeCredential
-	65,  // 123: sf.cardano.type.v1.UpdateDRepCert.anchor:type_name -> sf.cardano.type.v1.Anchor
-	70,  // 124: sf.cardano.type.v1.TxOutputPattern.address:type_name -> sf.cardano.type.v1.AddressPattern
-	71,  // 125: sf.cardano.type.v1.TxOutputPattern.asset:type_name -> sf.cardano.type.v1.AssetPattern
-	72,  // 126: sf.cardano.type.v1.TxPattern.consumes:type_name -> sf.cardano.type.v1.TxOutputPattern
-	72,  // 127: sf.cardano.type.v1.TxPattern.produces:type_name -> sf.cardano.type.v1.TxOutputPattern
-	70,  // 128: sf.cardano.type.v1.TxPattern.has_address:type_name -> sf.cardano.type.v1.AddressPattern
-	71,  // 129: sf.cardano.type.v1.TxPattern.moves_asset:type_name -> sf.cardano.type.v1.AssetPattern
-	71,  // 130: sf.cardano.type.v1.TxPattern.mints_asset:type_name -> sf.cardano.type.v1.AssetPattern
-	46,  // 131: sf.cardano.type.v1.ExPrices.steps:type_name -> sf.cardano.type.v1.RationalNumber
-	46,  // 132: sf.cardano.type.v1.ExPrices.memory:type_name -> sf.cardano.type.v1.RationalNumber
-	77,  // 133: sf.cardano.type.v1.CostModels.plutus_v1:type_name -> sf.cardano.type.v1.CostModel
-	77,  // 134: sf.cardano.type.v1.CostModels.plutus_v2:type_name -> sf.cardano.type.v1.CostModel
-	77,  // 135: sf.cardano.type.v1.CostModels.plutus_v3:type_name -> sf.cardano.type.v1.CostModel
-	46,  // 136: sf.cardano.type.v1.VotingThresholds.thresholds:type_name -> sf.cardano.type.v1.RationalNumber
-	46,  // 137: sf.cardano.type.v1.PParams.pool_influence:type_name -> sf.cardano.type.v1.RationalNumber
-	46,  // 138: sf.cardano.type.v1.PParams.monetary_expansion:type_name -> sf.cardano.type.v1.RationalNumber
-	46,  // 139: sf.cardano.type.v1.PParams.treasury_expansion:type_name -> sf.cardano.type.v1.RationalNumber
-	76,  // 140: sf.cardano.type.v1.PParams.protocol_version:type_name -> sf.cardano.type.v1.ProtocolVersion
-	78,  // 141: sf.cardano.type.v1.PParams.cost_models:type_name -> sf.cardano.type.v1.CostModels
-	75,  // 142: sf.cardano.type.v1.PParams.prices:type_name -> sf.cardano.type.v1.ExPrices
-	74,  // 143: sf.cardano.type.v1.PParams.max_execution_units_per_transaction:type_name -> sf.cardano.type.v1.ExUnits
-	74,  // 144: sf.cardano.type.v1.PParams.max_execution_units_per_block:type_name -> sf.cardano.type.v1.ExUnits
-	46,  // 145: sf.cardano.type.v1.PParams.min_fee_script_ref_cost_per_byte:type_name -> sf.cardano.type.v1.RationalNumber
-	79,  // 146: sf.cardano.type.v1.PParams.pool_voting_thresholds:type_name -> sf.cardano.type.v1.VotingThresholds
-	79,  // 147: sf.cardano.type.v1.PParams.drep_voting_thresholds:type_name -> sf.cardano.type.v1.VotingThresholds
-	81,  // 148: sf.cardano.type.v1.EraSummary.start:type_name -> sf.cardano.type.v1.EraBoundary
-	81,  // 149: sf.cardano.type.v1.EraSummary.end:type_name -> sf.cardano.type.v1.EraBoundary
-	80,  // 150: sf.cardano.type.v1.EraSummary.protocol_params:type_name -> sf.cardano.type.v1.PParams
-	82,  // 151: sf.cardano.type.v1.EraSummaries.summaries:type_name -> sf.cardano.type.v1.EraSummary
-	74,  // 152: sf.cardano.type.v1.TxEval.ex_units:type_name -> sf.cardano.type.v1.ExUnits
-	84,  // 153: sf.cardano.type.v1.TxEval.errors:type_name -> sf.cardano.type.v1.EvalError
-	85,  // 154: sf.cardano.type.v1.TxEval.traces:type_name -> sf.cardano.type.v1.EvalTrace
-	2,   // 155: sf.cardano.type.v1.TxEval.redeemers:type_name -> sf.cardano.type.v1.Redeemer
-	89,  // 156: sf.cardano.type.v1.BlockVersionData.softfork_rule:type_name -> sf.cardano.type.v1.SoftforkRule
-	90,  // 157: sf.cardano.type.v1.BlockVersionData.tx_fee_policy:type_name -> sf.cardano.type.v1.TxFeePolicy
-	46,  // 158: sf.cardano.type.v1.PoolVotingThresholds.motion_no_confidence:type_name -> sf.cardano.type.v1.RationalNumber
-	46,  // 159: sf.cardano.type.v1.PoolVotingThresholds.committee_normal:type_name -> sf.cardano.type.v1.RationalNumber
-	46,  // 160: sf.cardano.type.v1.PoolVotingThresholds.committee_no_confidence:type_name -> sf.cardano.type.v1.RationalNumber
-	46,  // 161: sf.cardano.type.v1.PoolVotingThresholds.hard_fork_initiation:type_name -> sf.cardano.type.v1.RationalNumber
-	46,  // 162: sf.cardano.type.v1.PoolVotingThresholds.pp_security_group:type_name -> sf.cardano.type.v1.RationalNumber
-	46,  // 163: sf.cardano.type.v1.DRepVotingThresholds.motion_no_confidence:type_name -> sf.cardano.type.v1.RationalNumber
-	46,  // 164: sf.cardano.type.v1.DRepVotingThresholds.committee_normal:type_name -> sf.cardano.type.v1.RationalNumber
-	46,  // 165: sf.cardano.type.v1.DRepVotingThresholds.committee_no_confidence:type_name -> sf.cardano.type.v1.RationalNumber
-	46,  // 166: sf.cardano.type.v1.DRepVotingThresholds.update_to_constitution:type_name -> sf.cardano.type.v1.RationalNumber
-	46,  // 167: sf.cardano.type.v1.DRepVotingThresholds.hard_fork_initiation:type_name -> sf.cardano.type.v1.RationalNumber
-	46,  // 168: sf.cardano.type.v1.DRepVotingThresholds.pp_network_group:type_name -> sf.cardano.type.v1.RationalNumber
-	46,  // 169: sf.cardano.type.v1.DRepVotingThresholds.pp_economic_group:type_name -> sf.cardano.type.v1.RationalNumber
-	46,  // 170: sf.cardano.type.v1.DRepVotingThresholds.pp_technical_group:type_name -> sf.cardano.type.v1.RationalNumber
-	46,  // 171: sf.cardano.type.v1.DRepVotingThresholds.pp_gov_group:type_name -> sf.cardano.type.v1.RationalNumber
-	46,  // 172: sf.cardano.type.v1.DRepVotingThresholds.treasury_withdrawal:type_name -> sf.cardano.type.v1.RationalNumber
-	100, // 173: sf.cardano.type.v1.Committee.members:type_name -> sf.cardano.type.v1.Committee.MembersEntry
-	46,  // 174: sf.cardano.type.v1.Committee.threshold:type_name -> sf.cardano.type.v1.RationalNumber
-	77,  // 175: sf.cardano.type.v1.CostModelMap.plutus_v1:type_name -> sf.cardano.type.v1.CostModel
-	77,  // 176: sf.cardano.type.v1.CostModelMap.plutus_v2:type_name -> sf.cardano.type.v1.CostModel
-	77,  // 177: sf.cardano.type.v1.CostModelMap.plutus_v3:type_name -> sf.cardano.type.v1.CostModel
-	101, // 178: sf.cardano.type.v1.Genesis.avvm_distr:type_name -> sf.cardano.type.v1.Genesis.AvvmDistrEntry
-	88,  // 179: sf.cardano.type.v1.Genesis.block_version_data:type_name -> sf.cardano.type.v1.BlockVersionData
-	91,  // 180: sf.cardano.type.v1.Genesis.protocol_consts:type_name -> sf.cardano.type.v1.ProtocolConsts
-	102, // 181: sf.cardano.type.v1.Genesis.boot_stakeholders:type_name -> sf.cardano.type.v1.Genesis.BootStakeholdersEntry
-	103, // 182: sf.cardano.type.v1.Genesis.heavy_delegation:type_name -> sf.cardano.type.v1.Genesis.HeavyDelegationEntry
-	104, // 183: sf.cardano.type.v1.Genesis.non_avvm_balances:type_name -> sf.cardano.type.v1.Genesis.NonAvvmBalancesEntry
-	105, // 184: sf.cardano.type.v1.Genesis.vss_certs:type_name -> sf.cardano.type.v1.Genesis.VssCertsEntry
-	46,  // 185: sf.cardano.type.v1.Genesis.active_slots_coeff:type_name -> sf.cardano.type.v1.RationalNumber
-	106, // 186: sf.cardano.type.v1.Genesis.gen_delegs:type_name -> sf.cardano.type.v1.Genesis.GenDelegsEntry
-	107, // 187: sf.cardano.type.v1.Genesis.initial_funds:type_name -> sf.cardano.type.v1.Genesis.InitialFundsEntry
-	80,  // 188: sf.cardano.type.v1.Genesis.protocol_params:type_name -> sf.cardano.type.v1.PParams
-	75,  // 189: sf.cardano.type.v1.Genesis.execution_prices:type_name -> sf.cardano.type.v1.ExPrices
-	74,  // 190: sf.cardano.type.v1.Genesis.max_tx_ex_units:type_name -> sf.cardano.type.v1.ExUnits
-	74,  // 191: sf.cardano.type.v1.Genesis.max_block_ex_units:type_name -> sf.cardano.type.v1.ExUnits
-	98,  // 192: sf.cardano.type.v1.Genesis.cost_models:type_name -> sf.cardano.type.v1.CostModelMap
-	97,  // 193: sf.cardano.type.v1.Genesis.committee:type_name -> sf.cardano.type.v1.Committee
-	24,  // 194: sf.cardano.type.v1.Genesis.constitution:type_name -> sf.cardano.type.v1.Constitution
-	46,  // 195: sf.cardano.type.v1.Genesis.min_fee_ref_script_cost_per_byte:type_name -> sf.cardano.type.v1.RationalNumber
-	96,  // 196: sf.cardano.type.v1.Genesis.drep_voting_thresholds:type_name -> sf.cardano.type.v1.DRepVotingThresholds
-	95,  // 197: sf.cardano.type.v1.Genesis.pool_voting_thresholds:type_name -> sf.cardano.type.v1.PoolVotingThresholds
-	92,  // 198: sf.cardano.type.v1.Genesis.HeavyDelegationEntry.value:type_name -> sf.cardano.type.v1.HeavyDelegation
-	93,  // 199: sf.cardano.type.v1.Genesis.VssCertsEntry.value:type_name -> sf.cardano.type.v1.VssCert
-	94,  // 200: sf.cardano.type.v1.Genesis.GenDelegsEntry.value:type_name -> sf.cardano.type.v1.GenDelegs
-	201, // [201:201] is the sub-list for method output_type
-	201, // [201:201] is the sub-list for method input_type
-	201, // [201:201] is the sub-list for extension type_name
-	201, // [201:201] is the sub-list for extension extendee
-	0,   // [0:201] is the sub-list for field type_name
+	66,  // 1: sf.cardano.type.v1.Redeemer.payload:type_name -> sf.cardano.type.v1.PlutusData
+	116, // 2: sf.cardano.type.v1.Redeemer.ex_units:type_name -> sf.cardano.type.v1.ExUnits
+	3,   // 3: sf.cardano.type.v1.Redeemer.script_language:type_name -> sf.cardano.type.v1.ScriptLanguage
+	8,   // 4: sf.cardano.type.v1.TxInput.as_output:type_name -> sf.cardano.type.v1.TxOutput
+	6,   // 5: sf.cardano.type.v1.TxInput.redeemer:type_name -> sf.cardano.type.v1.Redeemer
+	11,  // 6: sf.cardano.type.v1.TxOutput.assets:type_name -> sf.cardano.type.v1.Multiasset
+	9,   // 7: sf.cardano.type.v1.TxOutput.datum:type_name -> sf.cardano.type.v1.Datum
+	69,  // 8: sf.cardano.type.v1.TxOutput.script:type_name -> sf.cardano.type.v1.Script
+	52,  // 9: sf.cardano.type.v1.TxOutput.byron_address:type_name -> sf.cardano.type.v1.ByronAddress
+	66,  // 10: sf.cardano.type.v1.Datum.payload:type_name -> sf.cardano.type.v1.PlutusData
+	10,  // 11: sf.cardano.type.v1.Multiasset.assets:type_name -> sf.cardano.type.v1.Asset
+	6,   // 12: sf.cardano.type.v1.Multiasset.redeemer:type_name -> sf.cardano.type.v1.Redeemer
+	7,   // 13: sf.cardano.type.v1.Collateral.collateral:type_name -> sf.cardano.type.v1.TxInput
+	8,   // 14: sf.cardano.type.v1.Collateral.collateral_return:type_name -> sf.cardano.type.v1.TxOutput
+	6,   // 15: sf.cardano.type.v1.Withdrawal.redeemer:type_name -> sf.cardano.type.v1.Redeemer
+	59,  // 16: sf.cardano.type.v1.WitnessSet.vkeywitness:type_name -> sf.cardano.type.v1.VKeyWitness
+	69,  // 17: sf.cardano.type.v1.WitnessSet.script:type_name -> sf.cardano.type.v1.Script
+	66,  // 18: sf.cardano.type.v1.WitnessSet.plutus_datums:type_name -> sf.cardano.type.v1.PlutusData
+	6,   // 19: sf.cardano.type.v1.WitnessSet.redeemers:type_name -> sf.cardano.type.v1.Redeemer
+	16,  // 20: sf.cardano.type.v1.WitnessSet.bootstrap_witnesses:type_name -> sf.cardano.type.v1.BootstrapWitness
+	75,  // 21: sf.cardano.type.v1.AuxData.metadata:type_name -> sf.cardano.type.v1.Metadata
+	69,  // 22: sf.cardano.type.v1.AuxData.scripts:type_name -> sf.cardano.type.v1.Script
+	7,   // 23: sf.cardano.type.v1.Tx.inputs:type_name -> sf.cardano.type.v1.TxInput
+	8,   // 24: sf.cardano.type.v1.Tx.outputs:type_name -> sf.cardano.type.v1.TxOutput
+	91,  // 25: sf.cardano.type.v1.Tx.certificates:type_name -> sf.cardano.type.v1.Certificate
+	14,  // 26: sf.cardano.type.v1.Tx.withdrawals:type_name -> sf.cardano.type.v1.Withdrawal
+	11,  // 27: sf.cardano.type.v1.Tx.mint:type_name -> sf.cardano.type.v1.Multiasset
+	7,   // 28: sf.cardano.type.v1.Tx.reference_inputs:type_name -> sf.cardano.type.v1.TxInput
+	15,  // 29: sf.cardano.type.v1.Tx.witnesses:type_name -> sf.cardano.type.v1.WitnessSet
+	13,  // 30: sf.cardano.type.v1.Tx.collateral:type_name -> sf.cardano.type.v1.Collateral
+	12,  // 31: sf.cardano.type.v1.Tx.validity:type_name -> sf.cardano.type.v1.TxValidity
+	17,  // 32: sf.cardano.type.v1.Tx.auxiliary:type_name -> sf.cardano.type.v1.AuxData
+	25,  // 33: sf.cardano.type.v1.Tx.proposals:type_name -> sf.cardano.type.v1.GovernanceActionProposal
+	29,  // 34: sf.cardano.type.v1.Tx.voting_procedures:type_name -> sf.cardano.type.v1.VotingProcedure
+	70,  // 35: sf.cardano.type.v1.Tx.executed_scripts:type_name -> sf.cardano.type.v1.ExecutedScript
+	76,  // 36: sf.cardano.type.v1.Tx.decoded_metadata:type_name -> sf.cardano.type.v1.DecodedMetadata
+	83,  // 37: sf.cardano.type.v1.Tx.cip68_tokens:type_name -> sf.cardano.type.v1.Cip68Token
+	84,  // 38: sf.cardano.type.v1.Tx.cip68_updates:type_name -> sf.cardano.type.v1.Cip68MetadataUpdate
+	19,  // 39: sf.cardano.type.v1.Tx.effect:type_name -> sf.cardano.type.v1.LedgerEffect
+	22,  // 40: sf.cardano.type.v1.Tx.update:type_name -> sf.cardano.type.v1.ProtocolParamUpdate
+	20,  // 41: sf.cardano.type.v1.LedgerEffect.spent:type_name -> sf.cardano.type.v1.TxInputRef
+	21,  // 42: sf.cardano.type.v1.LedgerEffect.created:type_name -> sf.cardano.type.v1.TxOutputRef
+	23,  // 43: sf.cardano.type.v1.ProtocolParamUpdate.proposals:type_name -> sf.cardano.type.v1.GenesisParamUpdate
+	24,  // 44: sf.cardano.type.v1.GenesisParamUpdate.params:type_name -> sf.cardano.type.v1.PParamsUpdate
+	88,  // 45: sf.cardano.type.v1.PParamsUpdate.pool_influence:type_name -> sf.cardano.type.v1.RationalNumber
+	88,  // 46: sf.cardano.type.v1.PParamsUpdate.monetary_expansion:type_name -> sf.cardano.type.v1.RationalNumber
+	88,  // 47: sf.cardano.type.v1.PParamsUpdate.treasury_expansion:type_name -> sf.cardano.type.v1.RationalNumber
+	88,  // 48: sf.cardano.type.v1.PParamsUpdate.decentralization:type_name -> sf.cardano.type.v1.RationalNumber
+	118, // 49: sf.cardano.type.v1.PParamsUpdate.protocol_version:type_name -> sf.cardano.type.v1.ProtocolVersion
+	120, // 50: sf.cardano.type.v1.PParamsUpdate.cost_models:type_name -> sf.cardano.type.v1.CostModels
+	117, // 51: sf.cardano.type.v1.PParamsUpdate.prices:type_name -> sf.cardano.type.v1.ExPrices
+	116, // 52: sf.cardano.type.v1.PParamsUpdate.max_execution_units_per_transaction:type_name -> sf.cardano.type.v1.ExUnits
+	116, // 53: sf.cardano.type.v1.PParamsUpdate.max_execution_units_per_block:type_name -> sf.cardano.type.v1.ExUnits
+	121, // 54: sf.cardano.type.v1.PParamsUpdate.pool_voting_thresholds:type_name -> sf.cardano.type.v1.VotingThresholds
+	121, // 55: sf.cardano.type.v1.PParamsUpdate.drep_voting_thresholds:type_name -> sf.cardano.type.v1.VotingThresholds
+	88,  // 56: sf.cardano.type.v1.PParamsUpdate.min_fee_script_ref_cost_per_byte:type_name -> sf.cardano.type.v1.RationalNumber
+	26,  // 57: sf.cardano.type.v1.GovernanceActionProposal.gov_action:type_name -> sf.cardano.type.v1.GovernanceAction
+	107, // 58: sf.cardano.type.v1.GovernanceActionProposal.anchor:type_name -> sf.cardano.type.v1.Anchor
+	30,  // 59: sf.cardano.type.v1.GovernanceAction.parameter_change_action:type_name -> sf.cardano.type.v1.ParameterChangeAction
+	31,  // 60: sf.cardano.type.v1.GovernanceAction.hard_fork_initiation_action:type_name -> sf.cardano.type.v1.HardForkInitiationAction
+	32,  // 61: sf.cardano.type.v1.GovernanceAction.treasury_withdrawals_action:type_name -> sf.cardano.type.v1.TreasuryWithdrawalsAction
+	34,  // 62: sf.cardano.type.v1.GovernanceAction.no_confidence_action:type_name -> sf.cardano.type.v1.NoConfidenceAction
+	35,  // 63: sf.cardano.type.v1.GovernanceAction.update_committee_action:type_name -> sf.cardano.type.v1.UpdateCommitteeAction
+	36,  // 64: sf.cardano.type.v1.GovernanceAction.new_constitution_action:type_name -> sf.cardano.type.v1.NewConstitutionAction
+	1,   // 65: sf.cardano.type.v1.Voter.type:type_name -> sf.cardano.type.v1.VoterType
+	28,  // 66: sf.cardano.type.v1.VotingProcedure.voter:type_name -> sf.cardano.type.v1.Voter
+	27,  // 67: sf.cardano.type.v1.VotingProcedure.gov_action_id:type_name -> sf.cardano.type.v1.GovernanceActionId
+	2,   // 68: sf.cardano.type.v1.VotingProcedure.vote:type_name -> sf.cardano.type.v1.Vote
+	107, // 69: sf.cardano.type.v1.VotingProcedure.anchor:type_name -> sf.cardano.type.v1.Anchor
+	27,  // 70: sf.cardano.type.v1.ParameterChangeAction.gov_action_id:type_name -> sf.cardano.type.v1.GovernanceActionId
+	122, // 71: sf.cardano.type.v1.ParameterChangeAction.protocol_param_update:type_name -> sf.cardano.type.v1.PParams
+	24,  // 72: sf.cardano.type.v1.ParameterChangeAction.update:type_name -> sf.cardano.type.v1.PParamsUpdate
+	27,  // 73: sf.cardano.type.v1.HardForkInitiationAction.gov_action_id:type_name -> sf.cardano.type.v1.GovernanceActionId
+	118, // 74: sf.cardano.type.v1.HardForkInitiationAction.protocol_version:type_name -> sf.cardano.type.v1.ProtocolVersion
+	33,  // 75: sf.cardano.type.v1.TreasuryWithdrawalsAction.withdrawals:type_name -> sf.cardano.type.v1.WithdrawalAmount
+	27,  // 76: sf.cardano.type.v1.NoConfidenceAction.gov_action_id:type_name -> sf.cardano.type.v1.GovernanceActionId
+	27,  // 77: sf.cardano.type.v1.UpdateCommitteeAction.gov_action_id:type_name -> sf.cardano.type.v1.GovernanceActionId
+	87,  // 78: sf.cardano.type.v1.UpdateCommitteeAction.remove_committee_credentials:type_name -> sf.cardano.type.v1.StakeCredential
+	38,  // 79: sf.cardano.type.v1.UpdateCommitteeAction.new_committee_credentials:type_name -> sf.cardano.type.v1.NewCommitteeCredentials
+	88,  // 80: sf.cardano.type.v1.UpdateCommitteeAction.new_committee_threshold:type_name -> sf.cardano.type.v1.RationalNumber
+	27,  // 81: sf.cardano.type.v1.NewConstitutionAction.gov_action_id:type_name -> sf.cardano.type.v1.GovernanceActionId
+	37,  // 82: sf.cardano.type.v1.NewConstitutionAction.constitution:type_name -> sf.cardano.type.v1.Constitution
+	107, // 83: sf.cardano.type.v1.Constitution.anchor:type_name -> sf.cardano.type.v1.Anchor
+	87,  // 84: sf.cardano.type.v1.NewCommitteeCredentials.committee_cold_credential:type_name -> sf.cardano.type.v1.StakeCredential
+	18,  // 85: sf.cardano.type.v1.BlockBody.tx:type_name -> sf.cardano.type.v1.Tx
+	39,  // 86: sf.cardano.type.v1.Block.header:type_name -> sf.cardano.type.v1.BlockHeader
+	40,  // 87: sf.cardano.type.v1.Block.body:type_name -> sf.cardano.type.v1.BlockBody
+	53,  // 88: sf.cardano.type.v1.Block.stats:type_name -> sf.cardano.type.v1.BlockStats
+	42,  // 89: sf.cardano.type.v1.Block.byron:type_name -> sf.cardano.type.v1.ByronBlock
+	122, // 90: sf.cardano.type.v1.Block.protocol_params:type_name -> sf.cardano.type.v1.PParams
+	124, // 91: sf.cardano.type.v1.Block.era:type_name -> sf.cardano.type.v1.EraSummary
+	54,  // 92: sf.cardano.type.v1.Block.epoch_transition:type_name -> sf.cardano.type.v1.EpochTransition
+	55,  // 93: sf.cardano.type.v1.Block.ledger_snapshot:type_name -> sf.cardano.type.v1.LedgerSnapshot
+	43,  // 94: sf.cardano.type.v1.ByronBlock.block_version:type_name -> sf.cardano.type.v1.ByronBlockVersion
+	44,  // 95: sf.cardano.type.v1.ByronBlock.software_version:type_name -> sf.cardano.type.v1.ByronSoftwareVersion
+	45,  // 96: sf.cardano.type.v1.ByronBlock.delegation_certificates:type_name -> sf.cardano.type.v1.ByronDelegationCertificate
+	46,  // 97: sf.cardano.type.v1.ByronBlock.update_proposals:type_name -> sf.cardano.type.v1.ByronUpdateProposal
+	51,  // 98: sf.cardano.type.v1.ByronBlock.update_votes:type_name -> sf.cardano.type.v1.ByronUpdateVote
+	43,  // 99: sf.cardano.type.v1.ByronUpdateProposal.block_version:type_name -> sf.cardano.type.v1.ByronBlockVersion
+	47,  // 100: sf.cardano.type.v1.ByronUpdateProposal.block_version_modification:type_name -> sf.cardano.type.v1.ByronBlockVersionModification
+	44,  // 101: sf.cardano.type.v1.ByronUpdateProposal.software_version:type_name -> sf.cardano.type.v1.ByronSoftwareVersion
+	50,  // 102: sf.cardano.type.v1.ByronUpdateProposal.data:type_name -> sf.cardano.type.v1.ByronSystemUpdate
+	48,  // 103: sf.cardano.type.v1.ByronBlockVersionModification.softfork_rule:type_name -> sf.cardano.type.v1.ByronSoftforkRule
+	49,  // 104: sf.cardano.type.v1.ByronBlockVersionModification.tx_fee_policy:type_name -> sf.cardano.type.v1.ByronTxFeePolicy
+	116, // 105: sf.cardano.type.v1.BlockStats.ex_units:type_name -> sf.cardano.type.v1.ExUnits
+	142, // 106: sf.cardano.type.v1.BlockStats.certificates:type_name -> sf.cardano.type.v1.BlockStats.CertificatesEntry
+	125, // 107: sf.cardano.type.v1.LedgerSnapshot.era_history:type_name -> sf.cardano.type.v1.EraSummaries
+	122, // 108: sf.cardano.type.v1.LedgerSnapshot.protocol_params:type_name -> sf.cardano.type.v1.PParams
+	57,  // 109: sf.cardano.type.v1.LedgerSnapshot.stake_distribution:type_name -> sf.cardano.type.v1.PoolStake
+	58,  // 110: sf.cardano.type.v1.LedgerSnapshot.pools:type_name -> sf.cardano.type.v1.PoolParams
+	56,  // 111: sf.cardano.type.v1.LedgerSnapshot.governance:type_name -> sf.cardano.type.v1.GovernanceState
+	37,  // 112: sf.cardano.type.v1.GovernanceState.constitution:type_name -> sf.cardano.type.v1.Constitution
+	88,  // 113: sf.cardano.type.v1.GovernanceState.committee_threshold:type_name -> sf.cardano.type.v1.RationalNumber
+	27,  // 114: sf.cardano.type.v1.GovernanceState.proposals:type_name -> sf.cardano.type.v1.GovernanceActionId
+	93,  // 115: sf.cardano.type.v1.PoolParams.params:type_name -> sf.cardano.type.v1.PoolRegistrationCert
+	61,  // 116: sf.cardano.type.v1.NativeScript.script_all:type_name -> sf.cardano.type.v1.NativeScriptList
+	61,  // 117: sf.cardano.type.v1.NativeScript.script_any:type_name -> sf.cardano.type.v1.NativeScriptList
+	62,  // 118: sf.cardano.type.v1.NativeScript.script_n_of_k:type_name -> sf.cardano.type.v1.ScriptNOfK
+	60,  // 119: sf.cardano.type.v1.NativeScriptList.items:type_name -> sf.cardano.type.v1.NativeScript
+	60,  // 120: sf.cardano.type.v1.ScriptNOfK.scripts:type_name -> sf.cardano.type.v1.NativeScript
+	66,  // 121: sf.cardano.type.v1.Constr.fields:type_name -> sf.cardano.type.v1.PlutusData
+	66,  // 122: sf.cardano.type.v1.PlutusDataPair.key:type_name -> sf.cardano.type.v1.PlutusData
+	66,  // 123: sf.cardano.type.v1.PlutusDataPair.value:type_name -> sf.cardano.type.v1.PlutusData
+	63,  // 124: sf.cardano.type.v1.PlutusData.constr:type_name -> sf.cardano.type.v1.Constr
+	67,  // 125: sf.cardano.type.v1.PlutusData.map:type_name -> sf.cardano.type.v1.PlutusDataMap
+	64,  // 126: sf.cardano.type.v1.PlutusData.big_int:type_name -> sf.cardano.type.v1.BigInt
+	68,  // 127: sf.cardano.type.v1.PlutusData.array:type_name -> sf.cardano.type.v1.PlutusDataArray
+	65,  // 128: sf.cardano.type.v1.PlutusDataMap.pairs:type_name -> sf.cardano.type.v1.PlutusDataPair
+	66,  // 129: sf.cardano.type.v1.PlutusDataArray.items:type_name -> sf.cardano.type.v1.PlutusData
+	60,  // 130: sf.cardano.type.v1.Script.native:type_name -> sf.cardano.type.v1.NativeScript
+	3,   // 131: sf.cardano.type.v1.ExecutedScript.language:type_name -> sf.cardano.type.v1.ScriptLanguage
+	69,  // 132: sf.cardano.type.v1.ExecutedScript.script:type_name -> sf.cardano.type.v1.Script
+	4,   // 133: sf.cardano.type.v1.ExecutedScript.origin:type_name -> sf.cardano.type.v1.ScriptOrigin
+	72,  // 134: sf.cardano.type.v1.Metadatum.array:type_name -> sf.cardano.type.v1.MetadatumArray
+	73,  // 135: sf.cardano.type.v1.Metadatum.map:type_name -> sf.cardano.type.v1.MetadatumMap
+	64,  // 136: sf.cardano.type.v1.Metadatum.big_int:type_name -> sf.cardano.type.v1.BigInt
+	71,  // 137: sf.cardano.type.v1.MetadatumArray.items:type_name -> sf.cardano.type.v1.Metadatum
+	74,  // 138: sf.cardano.type.v1.MetadatumMap.pairs:type_name -> sf.cardano.type.v1.MetadatumPair
+	71,  // 139: sf.cardano.type.v1.MetadatumPair.key:type_name -> sf.cardano.type.v1.Metadatum
+	71,  // 140: sf.cardano.type.v1.MetadatumPair.value:type_name -> sf.cardano.type.v1.Metadatum
+	71,  // 141: sf.cardano.type.v1.Metadata.value:type_name -> sf.cardano.type.v1.Metadatum
+	77,  // 142: sf.cardano.type.v1.DecodedMetadata.cip25:type_name -> sf.cardano.type.v1.Cip25Metadata
+	80,  // 143: sf.cardano.type.v1.DecodedMetadata.cip20:type_name -> sf.cardano.type.v1.Cip20Message
+	81,  // 144: sf.cardano.type.v1.DecodedMetadata.cip36:type_name -> sf.cardano.type.v1.Cip36Registration
+	78,  // 145: sf.cardano.type.v1.Cip25Metadata.assets:type_name -> sf.cardano.type.v1.Cip25Asset
+	79,  // 146: sf.cardano.type.v1.Cip25Asset.files:type_name -> sf.cardano.type.v1.Cip25File
+	74,  // 147: sf.cardano.type.v1.Cip25Asset.properties:type_name -> sf.cardano.type.v1.MetadatumPair
+	74,  // 148: sf.cardano.type.v1.Cip25File.properties:type_name -> sf.cardano.type.v1.MetadatumPair
+	82,  // 149: sf.cardano.type.v1.Cip36Registration.delegations:type_name -> sf.cardano.type.v1.Cip36Delegation
+	85,  // 150: sf.cardano.type.v1.Cip68MetadataUpdate.metadata:type_name -> sf.cardano.type.v1.Cip68Metadata
+	86,  // 151: sf.cardano.type.v1.Cip68Metadata.files:type_name -> sf.cardano.type.v1.Cip68File
+	65,  // 152: sf.cardano.type.v1.Cip68Metadata.properties:type_name -> sf.cardano.type.v1.PlutusDataPair
+	66,  // 153: sf.cardano.type.v1.Cip68Metadata.extra:type_name -> sf.cardano.type.v1.PlutusData
+	65,  // 154: sf.cardano.type.v1.Cip68File.properties:type_name -> sf.cardano.type.v1.PlutusDataPair
+	87,  // 155: sf.cardano.type.v1.Certificate.stake_registration:type_name -> sf.cardano.type.v1.StakeCredential
+	87,  // 156: sf.cardano.type.v1.Certificate.stake_deregistration:type_name -> sf.cardano.type.v1.StakeCredential
+	92,  // 157: sf.cardano.type.v1.Certificate.stake_delegation:type_name -> sf.cardano.type.v1.StakeDelegationCert
+	93,  // 158: sf.cardano.type.v1.Certificate.pool_registration:type_name -> sf.cardano.type.v1.PoolRegistrationCert
+	94,  // 159: sf.cardano.type.v1.Certificate.pool_retirement:type_name -> sf.cardano.type.v1.PoolRetirementCert
+	95,  // 160: sf.cardano.type.v1.Certificate.genesis_key_delegation:type_name -> sf.cardano.type.v1.GenesisKeyDelegationCert
+	97,  // 161: sf.cardano.type.v1.Certificate.mir_cert:type_name -> sf.cardano.type.v1.MirCert
+	98,  // 162: sf.cardano.type.v1.Certificate.reg_cert:type_name -> sf.cardano.type.v1.RegCert
+	99,  // 163: sf.cardano.type.v1.Certificate.unreg_cert:type_name -> sf.cardano.type.v1.UnRegCert
+	101, // 164: sf.cardano.type.v1.Certificate.vote_deleg_cert:type_name -> sf.cardano.type.v1.VoteDelegCert
+	102, // 165: sf.cardano.type.v1.Certificate.stake_vote_deleg_cert:type_name -> sf.cardano.type.v1.StakeVoteDelegCert
+	103, // 166: sf.cardano.type.v1.Certificate.stake_reg_deleg_cert:type_name -> sf.cardano.type.v1.StakeRegDelegCert
+	104, // 167: sf.cardano.type.v1.Certificate.vote_reg_deleg_cert:type_name -> sf.cardano.type.v1.VoteRegDelegCert
+	105, // 168: sf.cardano.type.v1.Certificate.stake_vote_reg_deleg_cert:type_name -> sf.cardano.type.v1.StakeVoteRegDelegCert
+	106, // 169: sf.cardano.type.v1.Certificate.auth_committee_hot_cert:type_name -> sf.cardano.type.v1.AuthCommitteeHotCert
+	108, // 170: sf.cardano.type.v1.Certificate.resign_committee_cold_cert:type_name -> sf.cardano.type.v1.ResignCommitteeColdCert
+	109, // 171: sf.cardano.type.v1.Certificate.reg_drep_cert:type_name -> sf.cardano.type.v1.RegDRepCert
+	110, // 172: sf.cardano.type.v1.Certificate.unreg_drep_cert:type_name -> sf.cardano.type.v1.UnRegDRepCert
+	111, // 173: sf.cardano.type.v1.Certificate.update_drep_cert:type_name -> sf.cardano.type.v1.UpdateDRepCert
+	6,   // 174: sf.cardano.type.v1.Certificate.redeemer:type_name -> sf.cardano.type.v1.Redeemer
+	87,  // 175: sf.cardano.type.v1.StakeDelegationCert.stake_credential:type_name -> sf.cardano.type.v1.StakeCredential
+	88,  // 176: sf.cardano.type.v1.PoolRegistrationCert.margin:type_name -> sf.cardano.type.v1.RationalNumber
+	89,  // 177: sf.cardano.type.v1.PoolRegistrationCert.relays:type_name -> sf.cardano.type.v1.Relay
+	90,  // 178: sf.cardano.type.v1.PoolRegistrationCert.pool_metadata:type_name -> sf.cardano.type.v1.PoolMetadata
+	87,  // 179: sf.cardano.type.v1.MirTarget.stake_credential:type_name -> sf.cardano.type.v1.StakeCredential
+	5,   // 180: sf.cardano.type.v1.MirCert.from:type_name -> sf.cardano.type.v1.MirSource
+	96,  // 181: sf.cardano.type.v1.MirCert.to:type_name -> sf.cardano.type.v1.MirTarget
+	87,  // 182: sf.cardano.type.v1.RegCert.stake_credential:type_name -> sf.cardano.type.v1.StakeCredential
+	87,  // 183: sf.cardano.type.v1.UnRegCert.stake_credential:type_name -> sf.cardano.type.v1.StakeCredential
+	87,  // 184: sf.cardano.type.v1.VoteDelegCert.stake_credential:type_name -> sf.cardano.type.v1.StakeCredential
+	100, // 185: sf.cardano.type.v1.VoteDelegCert.drep:type_name -> sf.cardano.type.v1.DRep
+	87,  // 186: sf.cardano.type.v1.StakeVoteDelegCert.stake_credential:type_name -> sf.cardano.type.v1.StakeCredential
+	100, // 187: sf.cardano.type.v1.StakeVoteDelegCert.drep:type_name -> sf.cardano.type.v1.DRep
+	87,  // 188: sf.cardano.type.v1.StakeRegDelegCert.stake_credential:type_name -> sf.cardano.type.v1.StakeCredential
+	87,  // 189: sf.cardano.type.v1.VoteRegDelegCert.stake_credential:type_name -> sf.cardano.type.v1.StakeCredential
+	100, // 190: sf.cardano.type.v1.VoteRegDelegCert.drep:type_name -> sf.cardano.type.v1.DRep
+	87,  // 191: sf.cardano.type.v1.StakeVoteRegDelegCert.stake_credential:type_name -> sf.cardano.type.v1.StakeCredential
+	100, // 192: sf.cardano.type.v1.StakeVoteRegDelegCert.drep:type_name -> sf.cardano.type.v1.DRep
+	87,  // 193: sf.cardano.type.v1.AuthCommitteeHotCert.committee_cold_credential:type_name -> sf.cardano.type.v1.StakeCredential
+	87,  // 194: sf.cardano.type.v1.AuthCommitteeHotCert.committee_hot_credential:type_name -> sf.cardano.type.v1.StakeCredential
+	87,  // 195: sf.cardano.type.v1.ResignCommitteeColdCert.committee_cold_credential:type_name -> sf.cardano.type.v1.StakeCredential
+	107, // 196: sf.cardano.type.v1.ResignCommitteeColdCert.anchor:type_name -> sf.cardano.type.v1.Anchor
+	87,  // 197: sf.cardano.type.v1.RegDRepCert.drep_credential:type_name -> sf.cardano.type.v1.StakeCredential
+	107, // 198: sf.cardano.type.v1.RegDRepCert.anchor:type_name -> sf.cardano.type.v1.Anchor
+	87,  // 199: sf.cardano.type.v1.UnRegDRepCert.drep_credential:type_name -> sf.cardano.type.v1.StakeCredential
+	87,  // 200: sf.cardano.type.v1.UpdateDRepCert.drep_credential:type_name -> sf.cardano.type.v1.StakeCredential
+	107, // 201: sf.cardano.type.v1.UpdateDRepCert.anchor:type_name -> sf.cardano.type.v1.Anchor
+	112, // 202: sf.cardano.type.v1.TxOutputPattern.address:type_name -> sf.cardano.type.v1.AddressPattern
+	113, // 203: sf.cardano.type.v1.TxOutputPattern.asset:type_name -> sf.cardano.type.v1.AssetPattern
+	114, // 204: sf.cardano.type.v1.TxPattern.consumes:type_name -> sf.cardano.type.v1.TxOutputPattern
+	114, // 205: sf.cardano.type.v1.TxPattern.produces:type_name -> sf.cardano.type.v1.TxOutputPattern
+	112, // 206: sf.cardano.type.v1.TxPattern.has_address:type_name -> sf.cardano.type.v1.AddressPattern
+	113, // 207: sf.cardano.type.v1.TxPattern.moves_asset:type_name -> sf.cardano.type.v1.AssetPattern
+	113, // 208: sf.cardano.type.v1.TxPattern.mints_asset:type_name -> sf.cardano.type.v1.AssetPattern
+	88,  // 209: sf.cardano.type.v1.ExPrices.steps:type_name -> sf.cardano.type.v1.RationalNumber
+	88,  // 210: sf.cardano.type.v1.ExPrices.memory:type_name -> sf.cardano.type.v1.RationalNumber
+	119, // 211: sf.cardano.type.v1.CostModels.plutus_v1:type_name -> sf.cardano.type.v1.CostModel
+	119, // 212: sf.cardano.type.v1.CostModels.plutus_v2:type_name -> sf.cardano.type.v1.CostModel
+	119, // 213: sf.cardano.type.v1.CostModels.plutus_v3:type_name -> sf.cardano.type.v1.CostModel
+	88,  // 214: sf.cardano.type.v1.VotingThresholds.thresholds:type_name -> sf.cardano.type.v1.RationalNumber
+	88,  // 215: sf.cardano.type.v1.PParams.pool_influence:type_name -> sf.cardano.type.v1.RationalNumber
+	88,  // 216: sf.cardano.type.v1.PParams.monetary_expansion:type_name -> sf.cardano.type.v1.RationalNumber
+	88,  // 217: sf.cardano.type.v1.PParams.treasury_expansion:type_name -> sf.cardano.type.v1.RationalNumber
+	118, // 218: sf.cardano.type.v1.PParams.protocol_version:type_name -> sf.cardano.type.v1.ProtocolVersion
+	120, // 219: sf.cardano.type.v1.PParams.cost_models:type_name -> sf.cardano.type.v1.CostModels
+	117, // 220: sf.cardano.type.v1.PParams.prices:type_name -> sf.cardano.type.v1.ExPrices
+	116, // 221: sf.cardano.type.v1.PParams.max_execution_units_per_transaction:type_name -> sf.cardano.type.v1.ExUnits
+	116, // 222: sf.cardano.type.v1.PParams.max_execution_units_per_block:type_name -> sf.cardano.type.v1.ExUnits
+	88,  // 223: sf.cardano.type.v1.PParams.min_fee_script_ref_cost_per_byte:type_name -> sf.cardano.type.v1.RationalNumber
+	121, // 224: sf.cardano.type.v1.PParams.pool_voting_thresholds:type_name -> sf.cardano.type.v1.VotingThresholds
+	121, // 225: sf.cardano.type.v1.PParams.drep_voting_thresholds:type_name -> sf.cardano.type.v1.VotingThresholds
+	88,  // 226: sf.cardano.type.v1.PParams.decentralization:type_name -> sf.cardano.type.v1.RationalNumber
+	123, // 227: sf.cardano.type.v1.EraSummary.start:type_name -> sf.cardano.type.v1.EraBoundary
+	123, // 228: sf.cardano.type.v1.EraSummary.end:type_name -> sf.cardano.type.v1.EraBoundary
+	122, // 229: sf.cardano.type.v1.EraSummary.protocol_params:type_name -> sf.cardano.type.v1.PParams
+	124, // 230: sf.cardano.type.v1.EraSummaries.summaries:type_name -> sf.cardano.type.v1.EraSummary
+	116, // 231: sf.cardano.type.v1.TxEval.ex_units:type_name -> sf.cardano.type.v1.ExUnits
+	126, // 232: sf.cardano.type.v1.TxEval.errors:type_name -> sf.cardano.type.v1.EvalError
+	127, // 233: sf.cardano.type.v1.TxEval.traces:type_name -> sf.cardano.type.v1.EvalTrace
+	6,   // 234: sf.cardano.type.v1.TxEval.redeemers:type_name -> sf.cardano.type.v1.Redeemer
+	131, // 235: sf.cardano.type.v1.BlockVersionData.softfork_rule:type_name -> sf.cardano.type.v1.SoftforkRule
+	132, // 236: sf.cardano.type.v1.BlockVersionData.tx_fee_policy:type_name -> sf.cardano.type.v1.TxFeePolicy
+	88,  // 237: sf.cardano.type.v1.PoolVotingThresholds.motion_no_confidence:type_name -> sf.cardano.type.v1.RationalNumber
+	88,  // 238: sf.cardano.type.v1.PoolVotingThresholds.committee_normal:type_name -> sf.cardano.type.v1.RationalNumber
+	88,  // 239: sf.cardano.type.v1.PoolVotingThresholds.committee_no_confidence:type_name -> sf.cardano.type.v1.RationalNumber
+	88,  // 240: sf.cardano.type.v1.PoolVotingThresholds.hard_fork_initiation:type_name -> sf.cardano.type.v1.RationalNumber
+	88,  // 241: sf.cardano.type.v1.PoolVotingThresholds.pp_security_group:type_name -> sf.cardano.type.v1.RationalNumber
+	88,  // 242: sf.cardano.type.v1.DRepVotingThresholds.motion_no_confidence:type_name -> sf.cardano.type.v1.RationalNumber
+	88,  // 243: sf.cardano.type.v1.DRepVotingThresholds.committee_normal:type_name -> sf.cardano.type.v1.RationalNumber
+	88,  // 244: sf.cardano.type.v1.DRepVotingThresholds.committee_no_confidence:type_name -> sf.cardano.type.v1.RationalNumber
+	88,  // 245: sf.cardano.type.v1.DRepVotingThresholds.update_to_constitution:type_name -> sf.cardano.type.v1.RationalNumber
+	88,  // 246: sf.cardano.type.v1.DRepVotingThresholds.hard_fork_initiation:type_name -> sf.cardano.type.v1.RationalNumber
+	88,  // 247: sf.cardano.type.v1.DRepVotingThresholds.pp_network_group:type_name -> sf.cardano.type.v1.RationalNumber
+	88,  // 248: sf.cardano.type.v1.DRepVotingThresholds.pp_economic_group:type_name -> sf.cardano.type.v1.RationalNumber
+	88,  // 249: sf.cardano.type.v1.DRepVotingThresholds.pp_technical_group:type_name -> sf.cardano.type.v1.RationalNumber
+	88,  // 250: sf.cardano.type.v1.DRepVotingThresholds.pp_gov_group:type_name -> sf.cardano.type.v1.RationalNumber
+	88,  // 251: sf.cardano.type.v1.DRepVotingThresholds.treasury_withdrawal:type_name -> sf.cardano.type.v1.RationalNumber
+	143, // 252: sf.cardano.type.v1.Committee.members:type_name -> sf.cardano.type.v1.Committee.MembersEntry
+	88,  // 253: sf.cardano.type.v1.Committee.threshold:type_name -> sf.cardano.type.v1.RationalNumber
+	119, // 254: sf.cardano.type.v1.CostModelMap.plutus_v1:type_name -> sf.cardano.type.v1.CostModel
+	119, // 255: sf.cardano.type.v1.CostModelMap.plutus_v2:type_name -> sf.cardano.type.v1.CostModel
+	119, // 256: sf.cardano.type.v1.CostModelMap.plutus_v3:type_name -> sf.cardano.type.v1.CostModel
+	144, // 257: sf.cardano.type.v1.Genesis.avvm_distr:type_name -> sf.cardano.type.v1.Genesis.AvvmDistrEntry
+	130, // 258: sf.cardano.type.v1.Genesis.block_version_data:type_name -> sf.cardano.type.v1.BlockVersionData
+	133, // 259: sf.cardano.type.v1.Genesis.protocol_consts:type_name -> sf.cardano.type.v1.ProtocolConsts
+	145, // 260: sf.cardano.type.v1.Genesis.boot_stakeholders:type_name -> sf.cardano.type.v1.Genesis.BootStakeholdersEntry
+	146, // 261: sf.cardano.type.v1.Genesis.heavy_delegation:type_name -> sf.cardano.type.v1.Genesis.HeavyDelegationEntry
+	147, // 262: sf.cardano.type.v1.Genesis.non_avvm_balances:type_name -> sf.cardano.type.v1.Genesis.NonAvvmBalancesEntry
+	148, // 263: sf.cardano.type.v1.Genesis.vss_certs:type_name -> sf.cardano.type.v1.Genesis.VssCertsEntry
+	88,  // 264: sf.cardano.type.v1.Genesis.active_slots_coeff:type_name -> sf.cardano.type.v1.RationalNumber
+	149, // 265: sf.cardano.type.v1.Genesis.gen_delegs:type_name -> sf.cardano.type.v1.Genesis.GenDelegsEntry
+	150, // 266: sf.cardano.type.v1.Genesis.initial_funds:type_name -> sf.cardano.type.v1.Genesis.InitialFundsEntry
+	122, // 267: sf.cardano.type.v1.Genesis.protocol_params:type_name -> sf.cardano.type.v1.PParams
+	117, // 268: sf.cardano.type.v1.Genesis.execution_prices:type_name -> sf.cardano.type.v1.ExPrices
+	116, // 269: sf.cardano.type.v1.Genesis.max_tx_ex_units:type_name -> sf.cardano.type.v1.ExUnits
+	116, // 270: sf.cardano.type.v1.Genesis.max_block_ex_units:type_name -> sf.cardano.type.v1.ExUnits
+	140, // 271: sf.cardano.type.v1.Genesis.cost_models:type_name -> sf.cardano.type.v1.CostModelMap
+	139, // 272: sf.cardano.type.v1.Genesis.committee:type_name -> sf.cardano.type.v1.Committee
+	37,  // 273: sf.cardano.type.v1.Genesis.constitution:type_name -> sf.cardano.type.v1.Constitution
+	88,  // 274: sf.cardano.type.v1.Genesis.min_fee_ref_script_cost_per_byte:type_name -> sf.cardano.type.v1.RationalNumber
+	138, // 275: sf.cardano.type.v1.Genesis.drep_voting_thresholds:type_name -> sf.cardano.type.v1.DRepVotingThresholds
+	137, // 276: sf.cardano.type.v1.Genesis.pool_voting_thresholds:type_name -> sf.cardano.type.v1.PoolVotingThresholds
+	134, // 277: sf.cardano.type.v1.Genesis.HeavyDelegationEntry.value:type_name -> sf.cardano.type.v1.HeavyDelegation
+	135, // 278: sf.cardano.type.v1.Genesis.VssCertsEntry.value:type_name -> sf.cardano.type.v1.VssCert
+	136, // 279: sf.cardano.type.v1.Genesis.GenDelegsEntry.value:type_name -> sf.cardano.type.v1.GenDelegs
+	280, // [280:280] is the sub-list for method output_type
+	280, // [280:280] is the sub-list for method input_type
+	280, // [280:280] is the sub-list for extension type_name
+	280, // [280:280] is the sub-list for extension extendee
+	0,   // [0:280] is the sub-list for field type_name
 }
 
 func init() { file_sf_cardano_type_v1_type_proto_init() }
@@ -8270,7 +12567,12 @@ func file_sf_cardano_type_v1_type_proto_init() {
 	if File_sf_cardano_type_v1_type_proto != nil {
 		return
 	}
-	file_sf_cardano_type_v1_type_proto_msgTypes[13].OneofWrappers = []any{
+	file_sf_cardano_type_v1_type_proto_msgTypes[6].OneofWrappers = []any{}
+	file_sf_cardano_type_v1_type_proto_msgTypes[7].OneofWrappers = []any{}
+	file_sf_cardano_type_v1_type_proto_msgTypes[12].OneofWrappers = []any{}
+	file_sf_cardano_type_v1_type_proto_msgTypes[13].OneofWrappers = []any{}
+	file_sf_cardano_type_v1_type_proto_msgTypes[18].OneofWrappers = []any{}
+	file_sf_cardano_type_v1_type_proto_msgTypes[20].OneofWrappers = []any{
 		(*GovernanceAction_ParameterChangeAction)(nil),
 		(*GovernanceAction_HardForkInitiationAction)(nil),
 		(*GovernanceAction_TreasuryWithdrawalsAction)(nil),
@@ -8279,7 +12581,10 @@ func file_sf_cardano_type_v1_type_proto_init() {
 		(*GovernanceAction_NewConstitutionAction)(nil),
 		(*GovernanceAction_InfoAction)(nil),
 	}
-	file_sf_cardano_type_v1_type_proto_msgTypes[28].OneofWrappers = []any{
+	file_sf_cardano_type_v1_type_proto_msgTypes[41].OneofWrappers = []any{}
+	file_sf_cardano_type_v1_type_proto_msgTypes[46].OneofWrappers = []any{}
+	file_sf_cardano_type_v1_type_proto_msgTypes[49].OneofWrappers = []any{}
+	file_sf_cardano_type_v1_type_proto_msgTypes[54].OneofWrappers = []any{
 		(*NativeScript_ScriptPubkey)(nil),
 		(*NativeScript_ScriptAll)(nil),
 		(*NativeScript_ScriptAny)(nil),
@@ -8287,36 +12592,42 @@ func file_sf_cardano_type_v1_type_proto_init() {
 		(*NativeScript_InvalidBefore)(nil),
 		(*NativeScript_InvalidHereafter)(nil),
 	}
-	file_sf_cardano_type_v1_type_proto_msgTypes[32].OneofWrappers = []any{
+	file_sf_cardano_type_v1_type_proto_msgTypes[58].OneofWrappers = []any{
 		(*BigInt_Int)(nil),
 		(*BigInt_BigUInt)(nil),
 		(*BigInt_BigNInt)(nil),
 	}
-	file_sf_cardano_type_v1_type_proto_msgTypes[34].OneofWrappers = []any{
+	file_sf_cardano_type_v1_type_proto_msgTypes[60].OneofWrappers = []any{
 		(*PlutusData_Constr)(nil),
 		(*PlutusData_Map)(nil),
 		(*PlutusData_BigInt)(nil),
 		(*PlutusData_BoundedBytes)(nil),
 		(*PlutusData_Array)(nil),
 	}
-	file_sf_cardano_type_v1_type_proto_msgTypes[37].OneofWrappers = []any{
+	file_sf_cardano_type_v1_type_proto_msgTypes[63].OneofWrappers = []any{
 		(*Script_Native)(nil),
 		(*Script_PlutusV1)(nil),
 		(*Script_PlutusV2)(nil),
 		(*Script_PlutusV3)(nil),
 	}
-	file_sf_cardano_type_v1_type_proto_msgTypes[38].OneofWrappers = []any{
+	file_sf_cardano_type_v1_type_proto_msgTypes[65].OneofWrappers = []any{
 		(*Metadatum_Int)(nil),
 		(*Metadatum_Bytes)(nil),
 		(*Metadatum_Text)(nil),
 		(*Metadatum_Array)(nil),
 		(*Metadatum_Map)(nil),
+		(*Metadatum_BigInt)(nil),
+	}
+	file_sf_cardano_type_v1_type_proto_msgTypes[70].OneofWrappers = []any{
+		(*DecodedMetadata_Cip25)(nil),
+		(*DecodedMetadata_Cip20)(nil),
+		(*DecodedMetadata_Cip36)(nil),
 	}
-	file_sf_cardano_type_v1_type_proto_msgTypes[43].OneofWrappers = []any{
+	file_sf_cardano_type_v1_type_proto_msgTypes[81].OneofWrappers = []any{
 		(*StakeCredential_AddrKeyHash)(nil),
 		(*StakeCredential_ScriptHash)(nil),
 	}
-	file_sf_cardano_type_v1_type_proto_msgTypes[47].OneofWrappers = []any{
+	file_sf_cardano_type_v1_type_proto_msgTypes[85].OneofWrappers = []any{
 		(*Certificate_StakeRegistration)(nil),
 		(*Certificate_StakeDeregistration)(nil),
 		(*Certificate_StakeDelegation)(nil),
@@ -8337,7 +12648,7 @@ func file_sf_cardano_type_v1_type_proto_init() {
 		(*Certificate_UnregDrepCert)(nil),
 		(*Certificate_UpdateDrepCert)(nil),
 	}
-	file_sf_cardano_type_v1_type_proto_msgTypes[56].OneofWrappers = []any{
+	file_sf_cardano_type_v1_type_proto_msgTypes[94].OneofWrappers = []any{
 		(*DRep_AddrKeyHash)(nil),
 		(*DRep_ScriptHash)(nil),
 		(*DRep_Abstain)(nil),
@@ -8348,8 +12659,8 @@ func file_sf_cardano_type_v1_type_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_sf_cardano_type_v1_type_proto_rawDesc), len(file_sf_cardano_type_v1_type_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   106,
+			NumEnums:      6,
+			NumMessages:   145,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,292 @@
+// Package utxo keeps the unspent transaction outputs of the chain in a local
+// database so that transaction inputs can be resolved to the outputs they
+// spend.
+//
+// The store follows the chain block by block. For each applied block it keeps
+// an undo record of the outputs it spent and produced, the last undoDepth of
+// which are retained so that chain-sync rollbacks can be reverted.
+//
+// The store also indexes datums and scripts by hash. A hash always maps to
+// the same datum or script, so both are kept across rollbacks.
+package utxo
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/blinklabs-io/gouroboros/ledger"
+	lcommon "github.com/blinklabs-io/gouroboros/ledger/common"
+	"github.com/blinklabs-io/gouroboros/protocol/common"
+	"github.com/fxamacker/cbor/v2"
+	"github.com/no-witness-labs/firehose-cardano/internal/undolog"
+	"github.com/no-witness-labs/firehose-cardano/plutusdata"
+	pbcardano "github.com/no-witness-labs/firehose-cardano/types/pb/sf/cardano/type/v1"
+	bolt "go.etcd.io/bbolt"
+	"google.golang.org/protobuf/proto"
+)
+
+// DefaultUndoDepth is the mainnet security parameter k, the deepest rollback
+// the node can send.
+const DefaultUndoDepth = 2160
+
+var (
+	utxoBucket   = []byte("utxo")
+	datumBucket  = []byte("datum")
+	scriptBucket = []byte("script")
+)
+
+// ErrRollbackTooDeep is returned when a rollback goes past the retained undo
+// records. The store is then unusable and has to be rebuilt.
+var ErrRollbackTooDeep = undolog.ErrRollbackTooDeep
+
+// Store is a persistent UTxO set.
+type Store struct {
+	db  *bolt.DB
+	log *undolog.Log
+}
+
+type entry struct {
+	Key   []byte `cbor:"0,keyasint"`
+	Value []byte `cbor:"1,keyasint"`
+}
+
+// undoRecord holds the outputs a block spent and produced.
+type undoRecord struct {
+	Spent    []entry  `cbor:"0,keyasint"`
+	Produced [][]byte `cbor:"1,keyasint"`
+}
+
+// Open opens, or creates, the store at path. undoDepth is the number of
+// blocks that can be rolled back.
+func Open(path string, undoDepth uint64) (*Store, error) {
+	db, err := bolt.Open(path, 0600, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open UTxO store %s: %w", path, err)
+	}
+
+	log := undolog.New("UTxO store", func(uint64) uint64 { return undoDepth })
+	err = db.Update(func(tx *bolt.Tx) error {
+		for _, name := range [][]byte{utxoBucket, datumBucket, scriptBucket} {
+			if _, err := tx.CreateBucketIfNotExists(name); err != nil {
+				return err
+			}
+		}
+		return log.Init(tx)
+	})
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to initialize UTxO store %s: %w", path, err)
+	}
+
+	return &Store{db: db, log: log}, nil
+}
+
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// Tip returns the point of the last applied block, nil for an empty store.
+func (s *Store) Tip() (*common.Point, error) {
+	var point *common.Point
+	err := s.db.View(func(tx *bolt.Tx) error {
+		var err error
+		point, err = s.log.Tip(tx)
+		return err
+	})
+	return point, err
+}
+
+// ApplyBlock applies a block to the UTxO set: the outputs consumed by its
+// transactions are spent and the ones they produce are added, as converted in
+// out when there is one.
+func (s *Store) ApplyBlock(block ledger.Block, out *pbcardano.Block) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		tip, err := s.log.Tip(tx)
+		if err != nil {
+			return err
+		}
+		if tip != nil && block.SlotNumber() < tip.Slot {
+			return fmt.Errorf("block at slot %d does not extend UTxO store tip at slot %d", block.SlotNumber(), tip.Slot)
+		}
+
+		utxos := tx.Bucket(utxoBucket)
+		undo := &undoRecord{}
+
+		pbTxs := out.GetBody().GetTx()
+		for i, ledgerTx := range block.Transactions() {
+			var pbTx *pbcardano.Tx
+			if i < len(pbTxs) {
+				pbTx = pbTxs[i]
+			}
+
+			for _, input := range ledgerTx.Consumed() {
+				key := inputKey(input.Id().Bytes(), input.Index())
+				if value := utxos.Get(key); value != nil {
+					undo.Spent = append(undo.Spent, entry{Key: key, Value: bytes.Clone(value)})
+					if err := utxos.Delete(key); err != nil {
+						return err
+					}
+				}
+			}
+
+			for _, utxo := range ledgerTx.Produced() {
+				value, err := producedOutput(pbTx, utxo)
+				if err != nil {
+					return fmt.Errorf("failed to encode output %s: %w", utxo.Id, err)
+				}
+				key := inputKey(utxo.Id.Id().Bytes(), utxo.Id.Index())
+				if err := utxos.Put(key, value); err != nil {
+					return err
+				}
+				undo.Produced = append(undo.Produced, key)
+			}
+		}
+
+		data, err := cbor.Marshal(undo)
+		if err != nil {
+			return fmt.Errorf("failed to encode undo record: %w", err)
+		}
+		return s.log.Push(tx, common.NewPoint(block.SlotNumber(), block.Hash().Bytes()), data)
+	})
+}
+
+// Rollback reverts the blocks applied after point.
+func (s *Store) Rollback(point common.Point) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		utxos := tx.Bucket(utxoBucket)
+		return s.log.Rollback(tx, point, func(data []byte) error {
+			record := &undoRecord{}
+			if err := cbor.Unmarshal(data, record); err != nil {
+				return fmt.Errorf("failed to decode undo record: %w", err)
+			}
+
+			// Spent outputs are restored before produced ones are removed so
+			// that outputs produced and spent within the block end up absent.
+			for _, spent := range record.Spent {
+				if err := utxos.Put(spent.Key, spent.Value); err != nil {
+					return err
+				}
+			}
+			for _, key := range record.Produced {
+				if err := utxos.Delete(key); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	})
+}
+
+// Datum returns the CBOR of the datum with the given hash, nil when it is
+// unknown.
+func (s *Store) Datum(hash []byte) ([]byte, error) {
+	var data []byte
+	err := s.db.View(func(tx *bolt.Tx) error {
+		data = bytes.Clone(tx.Bucket(datumBucket).Get(hash))
+		return nil
+	})
+	return data, err
+}
+
+// Output returns the unspent output index of transaction txHash, nil when it
+// is unknown. A datum the output only carries the hash of is filled from the
+// datum index.
+func (s *Store) Output(txHash []byte, index uint32) (*pbcardano.TxOutput, error) {
+	var output *pbcardano.TxOutput
+	err := s.db.View(func(tx *bolt.Tx) error {
+		value := tx.Bucket(utxoBucket).Get(inputKey(txHash, index))
+		if value == nil {
+			return nil
+		}
+		output = &pbcardano.TxOutput{}
+		if err := proto.Unmarshal(value, output); err != nil {
+			return fmt.Errorf("failed to decode output %x#%d: %w", txHash, index, err)
+		}
+
+		datum := output.Datum
+		if datum == nil || datum.OriginalCbor != nil {
+			return nil
+		}
+		if data := tx.Bucket(datumBucket).Get(datum.Hash); data != nil {
+			payload, err := plutusdata.Decode(data)
+			if err != nil {
+				return fmt.Errorf("failed to decode datum %x: %w", datum.Hash, err)
+			}
+			datum.Payload = payload
+			datum.OriginalCbor = bytes.Clone(data)
+		}
+		return nil
+	})
+	return output, err
+}
+
+// AddDatums indexes CBOR-encoded datums by hash.
+func (s *Store) AddDatums(datums [][]byte) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(datumBucket)
+		for _, data := range datums {
+			if err := bucket.Put(plutusdata.Hash(data), data); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Script returns the script with the given hash, nil when it is unknown.
+func (s *Store) Script(hash []byte) (*pbcardano.Script, error) {
+	var script *pbcardano.Script
+	err := s.db.View(func(tx *bolt.Tx) error {
+		value := tx.Bucket(scriptBucket).Get(hash)
+		if value == nil {
+			return nil
+		}
+		script = &pbcardano.Script{}
+		if err := proto.Unmarshal(value, script); err != nil {
+			return fmt.Errorf("failed to decode script %x: %w", hash, err)
+		}
+		return nil
+	})
+	return script, err
+}
+
+// AddScripts registers scripts by hash.
+func (s *Store) AddScripts(scripts []*pbcardano.Script) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(scriptBucket)
+		for _, script := range scripts {
+			value, err := proto.Marshal(script)
+			if err != nil {
+				return fmt.Errorf("failed to encode script %x: %w", script.Hash, err)
+			}
+			if err := bucket.Put(script.Hash, value); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// producedOutput returns the serialized sf.cardano.type.v1.TxOutput of a
+// produced output, taken from the converted transaction when there is one.
+func producedOutput(tx *pbcardano.Tx, utxo lcommon.Utxo) ([]byte, error) {
+	var output proto.Message
+	if created := tx.CreatedOutput(utxo.Id.Index()); created != nil {
+		output = created
+	} else {
+		utxoOutput, err := utxo.Output.Utxorpc()
+		if err != nil {
+			return nil, err
+		}
+		output = utxoOutput
+	}
+	return proto.Marshal(output)
+}
+
+func inputKey(txHash []byte, index uint32) []byte {
+	key := make([]byte, len(txHash)+4)
+	copy(key, txHash)
+	binary.BigEndian.PutUint32(key[len(txHash):], index)
+	return key
+}
@@ -0,0 +1,226 @@
+package utxo
+
+import (
+	"errors"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/blinklabs-io/gouroboros/cbor"
+	"github.com/blinklabs-io/gouroboros/ledger"
+	lcommon "github.com/blinklabs-io/gouroboros/ledger/common"
+	"github.com/blinklabs-io/gouroboros/protocol/common"
+	"github.com/no-witness-labs/firehose-cardano/internal/nodetest"
+	pbcardano "github.com/no-witness-labs/firehose-cardano/types/pb/sf/cardano/type/v1"
+	bolt "go.etcd.io/bbolt"
+	"google.golang.org/protobuf/proto"
+)
+
+func openStore(t *testing.T, undoDepth uint64) *Store {
+	t.Helper()
+	s, err := Open(filepath.Join(t.TempDir(), "utxo.db"), undoDepth)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { s.Close() })
+	return s
+}
+
+// emptyBlock is a block without transactions at slot, its hash made of the
+// slot.
+type emptyBlock struct {
+	ledger.Block
+	slot uint64
+}
+
+func (b emptyBlock) SlotNumber() uint64                  { return b.slot }
+func (b emptyBlock) Hash() lcommon.Blake2b256            { return lcommon.NewBlake2b256(slotHash(b.slot)) }
+func (b emptyBlock) Transactions() []lcommon.Transaction { return nil }
+
+func slotHash(slot uint64) []byte {
+	hash := make([]byte, 32)
+	hash[0] = byte(slot)
+	return hash
+}
+
+// invalidTx returns block with the transaction at index flagged as failing
+// its scripts.
+func invalidTx(t *testing.T, block ledger.Block, index uint64) ledger.Block {
+	t.Helper()
+	var segments []cbor.RawMessage
+	if _, err := cbor.Decode(block.Cbor(), &segments); err != nil {
+		t.Fatal(err)
+	}
+	invalid, err := cbor.Encode([]uint64{index})
+	if err != nil {
+		t.Fatal(err)
+	}
+	segments[len(segments)-1] = invalid
+	data, err := cbor.Encode(segments)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tampered, err := ledger.NewBlockFromCbor(ledger.BlockTypeConway, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tampered
+}
+
+func TestApplyBlock(t *testing.T) {
+	block := nodetest.ConwayBlock(t)
+	// The fifth transaction of the block has collateral and a collateral
+	// return.
+	const txIndex = 4
+	tx := block.Transactions()[txIndex]
+	outputs := uint32(len(tx.Outputs()))
+
+	for _, tc := range []struct {
+		name    string
+		block   ledger.Block
+		spent   []lcommon.TransactionInput
+		unspent []lcommon.TransactionInput
+		created []uint32
+		absent  []uint32
+	}{
+		{
+			name:    "valid",
+			block:   block,
+			spent:   tx.Inputs(),
+			unspent: tx.Collateral(),
+			created: []uint32{0, 1, 2},
+			absent:  []uint32{outputs},
+		},
+		{
+			name:    "phase-2 invalid",
+			block:   invalidTx(t, block, txIndex),
+			spent:   tx.Collateral(),
+			unspent: tx.Inputs(),
+			created: []uint32{outputs},
+			absent:  []uint32{0, 1, 2},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			s := openStore(t, DefaultUndoDepth)
+
+			// The outputs the transaction may spend, made up.
+			placeholder := &pbcardano.TxOutput{Address: []byte{0x61}, Coin: 1}
+			value, err := proto.Marshal(placeholder)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = s.db.Update(func(btx *bolt.Tx) error {
+				for _, input := range append(tc.spent, tc.unspent...) {
+					if err := btx.Bucket(utxoBucket).Put(inputKey(input.Id().Bytes(), input.Index()), value); err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := s.ApplyBlock(tc.block, nil); err != nil {
+				t.Fatal(err)
+			}
+			hash := tx.Hash().Bytes()
+			for _, index := range tc.created {
+				if output, err := s.Output(hash, index); err != nil || output == nil {
+					t.Errorf("output #%d not created: %v", index, err)
+				}
+			}
+			for _, index := range tc.absent {
+				if output, err := s.Output(hash, index); err != nil || output != nil {
+					t.Errorf("output #%d created: %v", index, err)
+				}
+			}
+			for _, input := range tc.spent {
+				if output, err := s.Output(input.Id().Bytes(), input.Index()); err != nil || output != nil {
+					t.Errorf("input %s not spent: %v", input, err)
+				}
+			}
+			for _, input := range tc.unspent {
+				if slices.ContainsFunc(tc.spent, func(spent lcommon.TransactionInput) bool { return spent.String() == input.String() }) {
+					continue // Also an input of the other kind
+				}
+				if output, err := s.Output(input.Id().Bytes(), input.Index()); err != nil || output == nil {
+					t.Errorf("input %s spent: %v", input, err)
+				}
+			}
+
+			// Rolling the block back restores the spent outputs and removes
+			// the created ones.
+			if err := s.Rollback(common.NewPointOrigin()); err != nil {
+				t.Fatal(err)
+			}
+			for _, index := range tc.created {
+				if output, err := s.Output(hash, index); err != nil || output != nil {
+					t.Errorf("output #%d still there after rollback: %v", index, err)
+				}
+			}
+			for _, input := range tc.spent {
+				if output, err := s.Output(input.Id().Bytes(), input.Index()); err != nil || !proto.Equal(output, placeholder) {
+					t.Errorf("input %s not restored: %v, %v", input, output, err)
+				}
+			}
+		})
+	}
+}
+
+func TestRollback(t *testing.T) {
+	block := nodetest.ConwayBlock(t)
+
+	for _, tc := range []struct {
+		name  string
+		point common.Point
+		tip   uint64
+		err   error
+		chain bool // The point is off the chain
+	}{
+		{name: "last block", point: common.NewPoint(30, slotHash(30)), tip: 30},
+		{name: "one block", point: common.NewPoint(20, slotHash(20)), tip: 20},
+		{name: "to the undo depth", point: common.NewPoint(10, slotHash(10)), tip: 10},
+		{name: "past the tip", point: common.NewPoint(40, slotHash(40)), tip: 30},
+		{name: "beyond the undo depth", point: common.NewPointOrigin(), err: ErrRollbackTooDeep},
+		{name: "other chain", point: common.NewPoint(20, []byte{0xff}), chain: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			s := openStore(t, 2)
+			for _, slot := range []uint64{10, 20, 30} {
+				if err := s.ApplyBlock(emptyBlock{Block: block, slot: slot}, nil); err != nil {
+					t.Fatal(err)
+				}
+			}
+			err := s.db.View(func(tx *bolt.Tx) error {
+				if count := s.log.Len(tx); count != 2 {
+					t.Errorf("%d undo records, expected 2", count)
+				}
+				return nil
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = s.Rollback(tc.point)
+			switch {
+			case tc.err != nil:
+				if !errors.Is(err, tc.err) {
+					t.Fatalf("rollback: %v, expected %v", err, tc.err)
+				}
+				return
+			case tc.chain:
+				if err == nil || !strings.Contains(err.Error(), "not on the UTxO store chain") {
+					t.Fatalf("rollback: %v, expected the point not to be on the chain", err)
+				}
+				return
+			case err != nil:
+				t.Fatal(err)
+			}
+			if tip, err := s.Tip(); err != nil || tip.Slot != tc.tip {
+				t.Errorf("tip %v, %v, expected slot %d", tip, err, tc.tip)
+			}
+		})
+	}
+}
@@ -0,0 +1,175 @@
+package utxorpc
+
+import (
+	"bytes"
+
+	"github.com/blinklabs-io/gouroboros/ledger/common"
+	pbcardano "github.com/no-witness-labs/firehose-cardano/types/pb/sf/cardano/type/v1"
+)
+
+// matchTx tells whether a transaction exhibits every part of a pattern.
+// Inputs and outputs are the ones the ledger applies: the collateral ones
+// when the scripts of the transaction failed, whose mint is then void.
+// Inputs match on the outputs they spend, so only resolved inputs can.
+func matchTx(pattern *pbcardano.TxPattern, tx *pbcardano.Tx) bool {
+	spent, created := spentOutputs(spentInputs(tx)), createdOutputs(tx)
+
+	if pattern.Consumes != nil && !anyOutput(spent, func(o *pbcardano.TxOutput) bool { return matchOutput(pattern.Consumes, o) }) {
+		return false
+	}
+	if pattern.Produces != nil && !anyOutput(created, func(o *pbcardano.TxOutput) bool { return matchOutput(pattern.Produces, o) }) {
+		return false
+	}
+
+	moved := append(spent[:len(spent):len(spent)], created...)
+	if pattern.HasAddress != nil && !anyOutput(moved, func(o *pbcardano.TxOutput) bool { return matchAddress(pattern.HasAddress, o.Address) }) {
+		return false
+	}
+	if pattern.MovesAsset != nil && !anyOutput(moved, func(o *pbcardano.TxOutput) bool { return matchAssets(pattern.MovesAsset, o.Assets) }) {
+		return false
+	}
+	if pattern.MintsAsset != nil && (!bodyApplied(tx) || !matchAssets(pattern.MintsAsset, tx.Mint)) {
+		return false
+	}
+	return true
+}
+
+// spentInputs returns the inputs the ledger spends, from the ledger effect
+// when the fetcher computed it.
+func spentInputs(tx *pbcardano.Tx) []*pbcardano.TxInput {
+	if tx.Effect == nil {
+		if tx.Successful {
+			return tx.Inputs
+		}
+		return tx.GetCollateral().GetCollateral()
+	}
+	out := make([]*pbcardano.TxInput, 0, len(tx.Effect.Spent))
+	for _, ref := range tx.Effect.Spent {
+		inputs := tx.Inputs
+		if ref.Collateral {
+			inputs = tx.GetCollateral().GetCollateral()
+		}
+		if int(ref.Index) < len(inputs) {
+			out = append(out, inputs[ref.Index])
+		}
+	}
+	return out
+}
+
+// createdOutputs returns the outputs the ledger creates, from the ledger
+// effect when the fetcher computed it.
+func createdOutputs(tx *pbcardano.Tx) []*pbcardano.TxOutput {
+	if tx.Effect == nil {
+		if tx.Successful {
+			return tx.Outputs
+		}
+		if ret := tx.GetCollateral().GetCollateralReturn(); ret != nil {
+			return []*pbcardano.TxOutput{ret}
+		}
+		return nil
+	}
+	out := make([]*pbcardano.TxOutput, 0, len(tx.Effect.Created))
+	for _, ref := range tx.Effect.Created {
+		switch {
+		case ref.CollateralReturn:
+			if ret := tx.GetCollateral().GetCollateralReturn(); ret != nil {
+				out = append(out, ret)
+			}
+		case int(ref.Index) < len(tx.Outputs):
+			out = append(out, tx.Outputs[ref.Index])
+		}
+	}
+	return out
+}
+
+func bodyApplied(tx *pbcardano.Tx) bool {
+	if tx.Effect == nil {
+		return tx.Successful
+	}
+	return tx.Effect.BodyApplied
+}
+
+// spentOutputs returns the resolved outputs of inputs.
+func spentOutputs(inputs []*pbcardano.TxInput) []*pbcardano.TxOutput {
+	out := make([]*pbcardano.TxOutput, 0, len(inputs))
+	for _, input := range inputs {
+		if input.AsOutput != nil {
+			out = append(out, input.AsOutput)
+		}
+	}
+	return out
+}
+
+func anyOutput(outputs []*pbcardano.TxOutput, match func(*pbcardano.TxOutput) bool) bool {
+	for _, output := range outputs {
+		if match(output) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchOutput(pattern *pbcardano.TxOutputPattern, output *pbcardano.TxOutput) bool {
+	if pattern.Address != nil && !matchAddress(pattern.Address, output.Address) {
+		return false
+	}
+	if pattern.Asset != nil && !matchAssets(pattern.Asset, output.Assets) {
+		return false
+	}
+	return true
+}
+
+func matchAddress(pattern *pbcardano.AddressPattern, address []byte) bool {
+	if len(pattern.ExactAddress) > 0 && !bytes.Equal(pattern.ExactAddress, address) {
+		return false
+	}
+	payment, delegation := addressParts(address)
+	if len(pattern.PaymentPart) > 0 && !bytes.Equal(pattern.PaymentPart, payment) {
+		return false
+	}
+	if len(pattern.DelegationPart) > 0 && !bytes.Equal(pattern.DelegationPart, delegation) {
+		return false
+	}
+	return true
+}
+
+// addressParts returns the payment and delegation parts of a Shelley
+// address: credential hashes, or the pointer bytes of a pointer address.
+// Byron and unknown addresses have none.
+func addressParts(address []byte) (payment, delegation []byte) {
+	if len(address) < 29 {
+		return nil, nil
+	}
+	switch address[0] >> 4 {
+	case common.AddressTypeKeyKey, common.AddressTypeScriptKey, common.AddressTypeKeyScript, common.AddressTypeScriptScript:
+		if len(address) < 57 {
+			return address[1:29], nil
+		}
+		return address[1:29], address[29:57]
+	case common.AddressTypeKeyPointer, common.AddressTypeScriptPointer:
+		return address[1:29], address[29:]
+	case common.AddressTypeKeyNone, common.AddressTypeScriptNone:
+		return address[1:29], nil
+	case common.AddressTypeNoneKey, common.AddressTypeNoneScript:
+		return nil, address[1:29]
+	}
+	return nil, nil
+}
+
+// matchAssets tells whether one of the assets exhibits the pattern.
+func matchAssets(pattern *pbcardano.AssetPattern, assets []*pbcardano.Multiasset) bool {
+	for _, multiasset := range assets {
+		if len(pattern.PolicyId) > 0 && !bytes.Equal(pattern.PolicyId, multiasset.PolicyId) {
+			continue
+		}
+		if len(pattern.AssetName) == 0 && len(multiasset.Assets) > 0 {
+			return true
+		}
+		for _, asset := range multiasset.Assets {
+			if bytes.Equal(pattern.AssetName, asset.Name) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,239 @@
+// Package utxorpc serves the UTxO RPC sync and watch services from the blocks
+// of a Firehose deployment: merged blocks for the history, the relayer for
+// the live segment.
+//
+// Blocks are numbered by height. A block reference giving a height is looked
+// up directly, one giving only a slot is searched for, block slots increasing
+// with their height. Merged blocks are not indexed by hash: a reference giving
+// only a hash is looked up in the live segment only. Blocks and transactions
+// are sent as their UTxO RPC counterparts, which sf.cardano.type.v1 extends:
+// the fields specific to this project are dropped. Field masks are not
+// applied, full blocks and transactions are sent.
+package utxorpc
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	pbcardano "github.com/no-witness-labs/firehose-cardano/types/pb/sf/cardano/type/v1"
+	"github.com/streamingfast/bstream"
+	"github.com/streamingfast/bstream/hub"
+	pbbstream "github.com/streamingfast/bstream/pb/sf/bstream/v1"
+	"github.com/streamingfast/bstream/stream"
+	"github.com/streamingfast/bstream/transform"
+	"github.com/streamingfast/dstore"
+	firecore "github.com/streamingfast/firehose-core"
+	"github.com/streamingfast/firehose-core/firehose"
+	pbfirehose "github.com/streamingfast/pbgo/sf/firehose/v2"
+	utxorpccardano "github.com/utxorpc/go-codegen/utxorpc/v1alpha/cardano"
+	utxorpcsync "github.com/utxorpc/go-codegen/utxorpc/v1alpha/sync"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// Source reads the blocks of a Firehose deployment.
+type Source struct {
+	streams    *firecore.StreamFactory
+	blocks     *firehose.BlockGetter
+	hub        *hub.ForkableHub
+	firstBlock uint64
+	logger     *zap.Logger
+}
+
+// NewSource returns a source reading the merged blocks from mergedBlocksStore
+// and the live ones from hub. firstBlock is the first streamable block.
+func NewSource(mergedBlocksStore, forkedBlocksStore dstore.Store, hub *hub.ForkableHub, firstBlock uint64, logger *zap.Logger) *Source {
+	return &Source{
+		streams:    firecore.NewStreamFactory(mergedBlocksStore, forkedBlocksStore, hub, transform.NewRegistry()),
+		blocks:     firehose.NewBlockGetter(mergedBlocksStore, forkedBlocksStore, hub),
+		hub:        hub,
+		firstBlock: firstBlock,
+		logger:     logger,
+	}
+}
+
+// Block returns the block numbered num, which must have the hash id when id
+// is not empty.
+func (s *Source) Block(ctx context.Context, num uint64, id string) (*pbcardano.Block, error) {
+	block, err := s.blocks.Get(ctx, num, id, s.logger)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	return decodeBlock(block)
+}
+
+// Resolve returns the block a reference points to. The hash and the slot of
+// the reference, when given, must match the block.
+func (s *Source) Resolve(ctx context.Context, ref *utxorpcsync.BlockRef) (*pbcardano.Block, error) {
+	var id string
+	if len(ref.Hash) > 0 {
+		id = hex.EncodeToString(ref.Hash)
+	}
+
+	if ref.Height == 0 && ref.Slot == 0 && id != "" {
+		if block := s.hub.GetBlockByHash(id); block != nil {
+			return decodeBlock(block)
+		}
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("block %s is not in the live segment, a reference to an older block needs its height or slot", id))
+	}
+
+	// A reference without height is located by its slot, the zero reference
+	// being the first block of the chain.
+	if ref.Height == 0 && ref.Slot != 0 {
+		num, err := s.searchSlot(ctx, ref.Slot)
+		if err != nil {
+			return nil, err
+		}
+		return s.Block(ctx, num, id)
+	}
+
+	block, err := s.Block(ctx, ref.Height, id)
+	if err != nil {
+		return nil, err
+	}
+	if ref.Slot != 0 && block.GetHeader().GetSlot() != ref.Slot {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("block %d is at slot %d, not %d", ref.Height, block.GetHeader().GetSlot(), ref.Slot))
+	}
+	return block, nil
+}
+
+// searchSlot returns the number of the block at slot, by bisection between
+// the first streamable block and the head.
+func (s *Source) searchSlot(ctx context.Context, slot uint64) (uint64, error) {
+	low, high := s.firstBlock, s.hub.HeadNum()
+	for low <= high {
+		mid := low + (high-low)/2
+		block, err := s.Block(ctx, mid, "")
+		if err != nil {
+			return 0, err
+		}
+		switch blockSlot := block.GetHeader().GetSlot(); {
+		case blockSlot == slot:
+			return mid, nil
+		case blockSlot < slot:
+			low = mid + 1
+		default:
+			if mid == 0 {
+				return 0, connect.NewError(connect.CodeNotFound, fmt.Errorf("no block at slot %d", slot))
+			}
+			high = mid - 1
+		}
+	}
+	return 0, connect.NewError(connect.CodeNotFound, fmt.Errorf("no block at slot %d", slot))
+}
+
+// Tip returns the head block of the live segment.
+func (s *Source) Tip() (*pbcardano.Block, error) {
+	num, id, _, _, err := s.hub.HeadInfo()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnavailable, fmt.Errorf("no live block yet: %w", err))
+	}
+	block := s.hub.GetBlock(num, id)
+	if block == nil {
+		return nil, connect.NewError(connect.CodeUnavailable, fmt.Errorf("head block %d not in the live segment", num))
+	}
+	return decodeBlock(block)
+}
+
+// Final returns the number of the last final block.
+func (s *Source) Final() (uint64, error) {
+	_, _, _, libNum, err := s.hub.HeadInfo()
+	if err != nil {
+		return 0, connect.NewError(connect.CodeUnavailable, fmt.Errorf("no live block yet: %w", err))
+	}
+	return libNum, nil
+}
+
+// Stream passes the blocks from start up to stop, inclusive, to handler:
+// applied blocks, then the ones undone by forks with undo set. A zero stop
+// streams until ctx is done. With finalOnly, only final blocks are passed.
+func (s *Source) Stream(ctx context.Context, start, stop uint64, finalOnly bool, handler func(block *pbcardano.Block, undo bool) error) error {
+	str, err := s.streams.New(ctx, bstream.HandlerFunc(func(block *pbbstream.Block, obj any) error {
+		step := obj.(bstream.Stepable).Step()
+		undo := step.Matches(bstream.StepUndo)
+		switch {
+		case finalOnly && !step.Matches(bstream.StepIrreversible):
+			return nil
+		case !finalOnly && !step.Matches(bstream.StepNew) && !undo:
+			return nil
+		}
+
+		decoded, err := decodeBlock(block)
+		if err != nil {
+			return err
+		}
+		return handler(decoded, undo)
+	}), &pbfirehose.Request{
+		StartBlockNum:   int64(start),
+		StopBlockNum:    stop,
+		FinalBlocksOnly: finalOnly,
+	}, s.logger)
+	if err != nil {
+		return connectError(err)
+	}
+
+	err = str.Run(ctx)
+	if errors.Is(err, stream.ErrStopBlockReached) {
+		return nil
+	}
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return err
+}
+
+func decodeBlock(block *pbbstream.Block) (*pbcardano.Block, error) {
+	out := &pbcardano.Block{}
+	if err := block.Payload.UnmarshalTo(out); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to decode block %d: %w", block.Number, err))
+	}
+	return out, nil
+}
+
+// connectError turns the gRPC status errors of firehose into connect ones,
+// both sharing their codes.
+func connectError(err error) error {
+	if st, ok := status.FromError(err); ok {
+		return connect.NewError(connect.Code(st.Code()), errors.New(st.Message()))
+	}
+	return err
+}
+
+// blockRef returns the reference of a block.
+func blockRef(block *pbcardano.Block) *utxorpcsync.BlockRef {
+	return &utxorpcsync.BlockRef{
+		Slot:   block.GetHeader().GetSlot(),
+		Hash:   block.GetHeader().GetHash(),
+		Height: block.GetHeader().GetHeight(),
+	}
+}
+
+// copyMessage copies a message into its wire compatible counterpart, between
+// sf.cardano.type.v1 and UTxO RPC, dropping the fields out does not know.
+func copyMessage(in, out proto.Message) error {
+	data, err := proto.Marshal(in)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s: %w", in.ProtoReflect().Descriptor().Name(), err)
+	}
+	if err := (proto.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(data, out); err != nil {
+		return fmt.Errorf("failed to unmarshal %s: %w", out.ProtoReflect().Descriptor().Name(), err)
+	}
+	return nil
+}
+
+// anyChainBlock returns the UTxO RPC block of block, with its original CBOR
+// when the fetcher kept it.
+func anyChainBlock(block *pbcardano.Block) (*utxorpcsync.AnyChainBlock, error) {
+	out := &utxorpccardano.Block{}
+	if err := copyMessage(block, out); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return &utxorpcsync.AnyChainBlock{
+		NativeBytes: block.OriginalCbor,
+		Chain:       &utxorpcsync.AnyChainBlock_Cardano{Cardano: out},
+	}, nil
+}
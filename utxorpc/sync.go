@@ -0,0 +1,153 @@
+package utxorpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	pbcardano "github.com/no-witness-labs/firehose-cardano/types/pb/sf/cardano/type/v1"
+	utxorpcsync "github.com/utxorpc/go-codegen/utxorpc/v1alpha/sync"
+	"github.com/utxorpc/go-codegen/utxorpc/v1alpha/sync/syncconnect"
+)
+
+// defaultMaxItems is the number of blocks DumpHistory returns when the
+// request does not tell, a merged bundle.
+const defaultMaxItems = 100
+
+// errPageFull stops the stream of a DumpHistory page.
+var errPageFull = errors.New("page full")
+
+// SyncServer implements the utxorpc.v1alpha.sync.SyncService.
+type SyncServer struct {
+	syncconnect.UnimplementedSyncServiceHandler
+	source *Source
+}
+
+func NewSyncServer(source *Source) *SyncServer {
+	return &SyncServer{source: source}
+}
+
+func (s *SyncServer) FetchBlock(ctx context.Context, req *connect.Request[utxorpcsync.FetchBlockRequest]) (*connect.Response[utxorpcsync.FetchBlockResponse], error) {
+	out := &utxorpcsync.FetchBlockResponse{}
+	for _, ref := range req.Msg.Ref {
+		block, err := s.source.Resolve(ctx, ref)
+		if err != nil {
+			return nil, err
+		}
+		anyBlock, err := anyChainBlock(block)
+		if err != nil {
+			return nil, err
+		}
+		out.Block = append(out.Block, anyBlock)
+	}
+	return connect.NewResponse(out), nil
+}
+
+// DumpHistory returns final blocks only, from the start token or the first
+// streamable block. The next token is only set when the following block is
+// final too.
+func (s *SyncServer) DumpHistory(ctx context.Context, req *connect.Request[utxorpcsync.DumpHistoryRequest]) (*connect.Response[utxorpcsync.DumpHistoryResponse], error) {
+	start := s.source.firstBlock
+	if req.Msg.StartToken != nil {
+		block, err := s.source.Resolve(ctx, req.Msg.StartToken)
+		if err != nil {
+			return nil, err
+		}
+		start = block.GetHeader().GetHeight()
+	}
+	maxItems := uint64(req.Msg.MaxItems)
+	if maxItems == 0 {
+		maxItems = defaultMaxItems
+	}
+
+	out := &utxorpcsync.DumpHistoryResponse{}
+	final, err := s.source.Final()
+	if err != nil {
+		return nil, err
+	}
+	if start > final {
+		return connect.NewResponse(out), nil
+	}
+
+	// One block past the page gives the next token.
+	stop := min(start+maxItems, final)
+	err = s.source.Stream(ctx, start, stop, true, func(block *pbcardano.Block, _ bool) error {
+		if uint64(len(out.Block)) == maxItems {
+			out.NextToken = blockRef(block)
+			return errPageFull
+		}
+		anyBlock, err := anyChainBlock(block)
+		if err != nil {
+			return err
+		}
+		out.Block = append(out.Block, anyBlock)
+		return nil
+	})
+	if err != nil && !errors.Is(err, errPageFull) {
+		return nil, err
+	}
+	return connect.NewResponse(out), nil
+}
+
+// FollowTip starts after the first intersect block found, with a Reset to
+// it, or at the head block without intersect.
+func (s *SyncServer) FollowTip(ctx context.Context, req *connect.Request[utxorpcsync.FollowTipRequest], stream *connect.ServerStream[utxorpcsync.FollowTipResponse]) error {
+	start, intersect, err := s.source.intersect(ctx, req.Msg.Intersect)
+	if err != nil {
+		return err
+	}
+	if intersect != nil {
+		if err := stream.Send(&utxorpcsync.FollowTipResponse{Action: &utxorpcsync.FollowTipResponse_Reset_{Reset_: intersect}}); err != nil {
+			return err
+		}
+	}
+
+	return s.source.Stream(ctx, start, 0, false, func(block *pbcardano.Block, undo bool) error {
+		anyBlock, err := anyChainBlock(block)
+		if err != nil {
+			return err
+		}
+		resp := &utxorpcsync.FollowTipResponse{Action: &utxorpcsync.FollowTipResponse_Apply{Apply: anyBlock}}
+		if undo {
+			resp.Action = &utxorpcsync.FollowTipResponse_Undo{Undo: anyBlock}
+		}
+		return stream.Send(resp)
+	})
+}
+
+func (s *SyncServer) ReadTip(ctx context.Context, req *connect.Request[utxorpcsync.ReadTipRequest]) (*connect.Response[utxorpcsync.ReadTipResponse], error) {
+	tip, err := s.source.Tip()
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&utxorpcsync.ReadTipResponse{
+		Tip:       blockRef(tip),
+		Timestamp: tip.Timestamp,
+	}), nil
+}
+
+// intersect returns the block to stream from: the one following the first
+// intersect block found, along with its reference, or the head block when
+// there is no intersect.
+func (s *Source) intersect(ctx context.Context, refs []*utxorpcsync.BlockRef) (uint64, *utxorpcsync.BlockRef, error) {
+	if len(refs) == 0 {
+		tip, err := s.Tip()
+		if err != nil {
+			return 0, nil, err
+		}
+		return tip.GetHeader().GetHeight(), nil, nil
+	}
+
+	for _, ref := range refs {
+		block, err := s.Resolve(ctx, ref)
+		if connect.CodeOf(err) == connect.CodeNotFound {
+			continue
+		}
+		if err != nil {
+			return 0, nil, err
+		}
+		return block.GetHeader().GetHeight() + 1, blockRef(block), nil
+	}
+	return 0, nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("none of the %d intersect blocks was found", len(refs)))
+}
@@ -0,0 +1,147 @@
+package utxorpc
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	pbcardano "github.com/no-witness-labs/firehose-cardano/types/pb/sf/cardano/type/v1"
+	utxorpccardano "github.com/utxorpc/go-codegen/utxorpc/v1alpha/cardano"
+	utxorpcsync "github.com/utxorpc/go-codegen/utxorpc/v1alpha/sync"
+	utxorpcwatch "github.com/utxorpc/go-codegen/utxorpc/v1alpha/watch"
+	"github.com/utxorpc/go-codegen/utxorpc/v1alpha/watch/watchconnect"
+)
+
+// WatchServer implements the utxorpc.v1alpha.watch.WatchService.
+type WatchServer struct {
+	watchconnect.UnimplementedWatchServiceHandler
+	source *Source
+}
+
+func NewWatchServer(source *Source) *WatchServer {
+	return &WatchServer{source: source}
+}
+
+// WatchTx streams the transactions matching the predicate of the request,
+// from the same starting point as FollowTip. Transactions of blocks undone
+// by forks are sent again as undone. Transactions come with the header of
+// their block.
+func (s *WatchServer) WatchTx(ctx context.Context, req *connect.Request[utxorpcwatch.WatchTxRequest], stream *connect.ServerStream[utxorpcwatch.WatchTxResponse]) error {
+	pred, err := compilePredicate(req.Msg.Predicate)
+	if err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	refs := make([]*utxorpcsync.BlockRef, 0, len(req.Msg.Intersect))
+	for _, ref := range req.Msg.Intersect {
+		refs = append(refs, &utxorpcsync.BlockRef{Slot: ref.Slot, Hash: ref.Hash, Height: ref.Height})
+	}
+	start, _, err := s.source.intersect(ctx, refs)
+	if err != nil {
+		return err
+	}
+
+	return s.source.Stream(ctx, start, 0, false, func(block *pbcardano.Block, undo bool) error {
+		var header *utxorpcwatch.AnyChainBlock
+		for _, tx := range block.GetBody().GetTx() {
+			if !pred.matches(tx) {
+				continue
+			}
+			if header == nil {
+				header = &utxorpcwatch.AnyChainBlock{Chain: &utxorpcwatch.AnyChainBlock_Cardano{Cardano: &utxorpccardano.Block{
+					Header:    &utxorpccardano.BlockHeader{Slot: block.GetHeader().GetSlot(), Hash: block.GetHeader().GetHash(), Height: block.GetHeader().GetHeight()},
+					Timestamp: block.Timestamp,
+				}}}
+			}
+
+			out := &utxorpccardano.Tx{}
+			if err := copyMessage(tx, out); err != nil {
+				return connect.NewError(connect.CodeInternal, err)
+			}
+			anyTx := &utxorpcwatch.AnyChainTx{Chain: &utxorpcwatch.AnyChainTx_Cardano{Cardano: out}, Block: header}
+			resp := &utxorpcwatch.WatchTxResponse{Action: &utxorpcwatch.WatchTxResponse_Apply{Apply: anyTx}}
+			if undo {
+				resp.Action = &utxorpcwatch.WatchTxResponse_Undo{Undo: anyTx}
+			}
+			if err := stream.Send(resp); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// predicate is a TxPredicate whose Cardano pattern was converted.
+type predicate struct {
+	match *pbcardano.TxPattern // nil matches any transaction
+	not   []*predicate
+	allOf []*predicate
+	anyOf []*predicate
+}
+
+func compilePredicate(in *utxorpcwatch.TxPredicate) (*predicate, error) {
+	out := &predicate{}
+	if in == nil {
+		return out, nil
+	}
+	if in.Match != nil {
+		pattern := in.Match.GetCardano()
+		if pattern == nil {
+			return nil, errors.New("only Cardano patterns are supported")
+		}
+		out.match = &pbcardano.TxPattern{}
+		if err := copyMessage(pattern, out.match); err != nil {
+			return nil, err
+		}
+	}
+
+	var err error
+	if out.not, err = compilePredicates(in.Not); err != nil {
+		return nil, err
+	}
+	if out.allOf, err = compilePredicates(in.AllOf); err != nil {
+		return nil, err
+	}
+	if out.anyOf, err = compilePredicates(in.AnyOf); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func compilePredicates(in []*utxorpcwatch.TxPredicate) ([]*predicate, error) {
+	out := make([]*predicate, 0, len(in))
+	for _, p := range in {
+		compiled, err := compilePredicate(p)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, compiled)
+	}
+	return out, nil
+}
+
+// matches tells whether tx matches the pattern, none of the not predicates,
+// all the allOf ones and, when there are some, one of the anyOf ones.
+func (p *predicate) matches(tx *pbcardano.Tx) bool {
+	if p.match != nil && !matchTx(p.match, tx) {
+		return false
+	}
+	for _, not := range p.not {
+		if not.matches(tx) {
+			return false
+		}
+	}
+	for _, all := range p.allOf {
+		if !all.matches(tx) {
+			return false
+		}
+	}
+	if len(p.anyOf) == 0 {
+		return true
+	}
+	for _, one := range p.anyOf {
+		if one.matches(tx) {
+			return true
+		}
+	}
+	return false
+}
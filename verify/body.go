@@ -0,0 +1,107 @@
+// Package verify checks that the blocks served by a peer are the ones their
+// headers commit to, before the fetcher emits them.
+//
+// The header of a Shelley and later block carries the hash of its body,
+// computed over the CBOR of the body segments as the peer sent them:
+// transaction bodies, witness sets, auxiliary data and, from Alonzo, the
+// indexes of the transactions whose scripts failed. Recomputing it catches
+// relays serving corrupted or forged bodies under a genuine header; the
+// transaction hashes, computed from the transaction bodies it covers, need no
+// check of their own. Byron bodies commit to their header through a different
+// proof and are not checked.
+package verify
+
+import (
+	"fmt"
+
+	"github.com/blinklabs-io/gouroboros/cbor"
+	"github.com/blinklabs-io/gouroboros/ledger"
+	"github.com/blinklabs-io/gouroboros/ledger/allegra"
+	"github.com/blinklabs-io/gouroboros/ledger/common"
+	"golang.org/x/crypto/blake2b"
+)
+
+// MismatchError is returned when a block does not match what its header, or
+// the point it was fetched for, commits to.
+type MismatchError struct {
+	Slot     uint64
+	Hash     common.Blake2b256
+	Field    string
+	Expected string
+	Actual   string
+}
+
+func (e *MismatchError) Error() string {
+	return fmt.Sprintf("block %s at slot %d: %s mismatch, expected %s, got %s", e.Hash, e.Slot, e.Field, e.Expected, e.Actual)
+}
+
+// Body recomputes the body hash of block from the CBOR of the block.
+func Body(block ledger.Block) error {
+	expected, ok := bodyHash(block.Header())
+	if !ok {
+		return nil
+	}
+
+	// A Shelley and later block is [header, segment...].
+	var segments []cbor.RawMessage
+	if _, err := cbor.Decode(block.Cbor(), &segments); err != nil {
+		return fmt.Errorf("failed to decode block %s: %w", block.Hash(), err)
+	}
+	if len(segments) < 2 {
+		return fmt.Errorf("failed to decode block %s: %d segments", block.Hash(), len(segments))
+	}
+
+	hashes := make([]byte, 0, blake2b.Size256*(len(segments)-1))
+	for _, segment := range segments[1:] {
+		sum := blake2b.Sum256(segment)
+		hashes = append(hashes, sum[:]...)
+	}
+	if actual := common.Blake2b256Hash(hashes); actual != expected {
+		return mismatch(block, "body hash", expected.String(), actual.String())
+	}
+	return nil
+}
+
+// Fetched checks that block is the one of the header it was fetched for.
+func Fetched(header ledger.BlockHeader, block ledger.Block) error {
+	if expected, actual := header.Hash(), block.Hash(); actual != expected {
+		return &MismatchError{
+			Slot:     header.SlotNumber(),
+			Hash:     expected,
+			Field:    "fetched block hash",
+			Expected: expected.String(),
+			Actual:   actual.String(),
+		}
+	}
+	return nil
+}
+
+func mismatch(block ledger.Block, field, expected, actual string) *MismatchError {
+	return &MismatchError{
+		Slot:     block.SlotNumber(),
+		Hash:     block.Hash(),
+		Field:    field,
+		Expected: expected,
+		Actual:   actual,
+	}
+}
+
+// bodyHash returns the body hash a header commits to, Byron headers having
+// none.
+func bodyHash(header ledger.BlockHeader) (common.Blake2b256, bool) {
+	switch h := header.(type) {
+	case *ledger.ShelleyBlockHeader:
+		return h.Body.BlockBodyHash, true
+	case *allegra.AllegraBlockHeader:
+		return h.Body.BlockBodyHash, true
+	case *ledger.MaryBlockHeader:
+		return h.Body.BlockBodyHash, true
+	case *ledger.AlonzoBlockHeader:
+		return h.Body.BlockBodyHash, true
+	case *ledger.BabbageBlockHeader:
+		return h.Body.BlockBodyHash, true
+	case *ledger.ConwayBlockHeader:
+		return h.Body.BlockBodyHash, true
+	}
+	return common.Blake2b256{}, false
+}
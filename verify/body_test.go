@@ -0,0 +1,41 @@
+package verify
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/blinklabs-io/gouroboros/cbor"
+	"github.com/blinklabs-io/gouroboros/ledger"
+	"github.com/no-witness-labs/firehose-cardano/internal/nodetest"
+)
+
+func TestBody(t *testing.T) {
+	block := nodetest.ConwayBlock(t)
+	if err := Body(block); err != nil {
+		t.Fatalf("genuine block: %v", err)
+	}
+
+	// Flag the first transaction as failed: the block still decodes, under
+	// the same header.
+	var segments []cbor.RawMessage
+	if _, err := cbor.Decode(block.Cbor(), &segments); err != nil {
+		t.Fatal(err)
+	}
+	segments[len(segments)-1] = cbor.RawMessage{0x81, 0x00}
+	data, err := cbor.Encode(segments)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tampered, err := ledger.NewBlockFromCbor(ledger.BlockTypeConway, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tampered.Hash() != block.Hash() {
+		t.Fatalf("tampered block hash %s, expected %s", tampered.Hash(), block.Hash())
+	}
+
+	var mismatch *MismatchError
+	if err := Body(tampered); !errors.As(err, &mismatch) || mismatch.Field != "body hash" {
+		t.Errorf("tampered block: %v, expected a body hash mismatch", err)
+	}
+}